@@ -5,6 +5,7 @@ package config
 import (
 	"encoding/json"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/parameter/defaultparameters"
@@ -23,6 +24,13 @@ type validatedArguments struct {
 	baseDirectory    string
 	serverInstanceID string
 
+	// Transport
+	transport         entities.Transport
+	httpListenAddress string
+	httpTLSCertFile   string
+	httpTLSKeyFile    string
+	httpAuthToken     string
+
 	// Logger
 	logLevel              entities.LogLevel
 	duplicateLogsToStderr bool
@@ -34,11 +42,20 @@ type validatedArguments struct {
 	preferredMATLABStartingDirectory string
 	displayMode                      entities.DisplayMode
 	matlabSessionMode                entities.MATLABSessionMode
+	matlabSessionSelector            string
 	matlabSessionConnectionDetails   string
 	matlabSessionConnectionTimeout   time.Duration
 	matlabSessionDiscoveryTimeout    time.Duration
 	embeddedConnectorDetailsTimeout  time.Duration
-	extensionFile                    string
+	defaultEvalTimeout               time.Duration
+	matlabSessionIdleTimeout         time.Duration
+	maxMATLABSessions                int
+	evictIdleMATLABSessions          bool
+	matlabPoolSize                   int
+	recoverSessionState              bool
+	recoverSessionWorkspace          bool
+	extensionFiles                   []string
+	extensionDirs                    []string
 
 	// Telemetry
 	disableTelemetry                   bool
@@ -120,6 +137,26 @@ func (c *config) SetupMATLABMode() bool {
 	return c.setupMATLABMode
 }
 
+func (c *config) Transport() entities.Transport {
+	return c.transport
+}
+
+func (c *config) HTTPListenAddress() string {
+	return c.httpListenAddress
+}
+
+func (c *config) HTTPTLSCertFile() string {
+	return c.httpTLSCertFile
+}
+
+func (c *config) HTTPTLSKeyFile() string {
+	return c.httpTLSKeyFile
+}
+
+func (c *config) HTTPAuthToken() string {
+	return c.httpAuthToken
+}
+
 func (c *config) UseSingleMATLABSession() bool {
 	return c.useSingleMATLABSession
 }
@@ -151,6 +188,10 @@ func (c *config) MATLABSessionMode() entities.MATLABSessionMode {
 	return c.matlabSessionMode
 }
 
+func (c *config) MATLABSessionSelector() string {
+	return c.matlabSessionSelector
+}
+
 func (c *config) MATLABSessionConnectionDetails() string {
 	return c.matlabSessionConnectionDetails
 }
@@ -163,8 +204,40 @@ func (c *config) MATLABSessionDiscoveryTimeout() time.Duration {
 	return c.matlabSessionDiscoveryTimeout
 }
 
-func (c *config) ExtensionFile() string {
-	return c.extensionFile
+func (c *config) DefaultEvalTimeout() time.Duration {
+	return c.defaultEvalTimeout
+}
+
+func (c *config) MATLABSessionIdleTimeout() time.Duration {
+	return c.matlabSessionIdleTimeout
+}
+
+func (c *config) MaxMATLABSessions() int {
+	return c.maxMATLABSessions
+}
+
+func (c *config) EvictIdleMATLABSessions() bool {
+	return c.evictIdleMATLABSessions
+}
+
+func (c *config) MATLABPoolSize() int {
+	return c.matlabPoolSize
+}
+
+func (c *config) RecoverSessionState() bool {
+	return c.recoverSessionState
+}
+
+func (c *config) RecoverSessionWorkspace() bool {
+	return c.recoverSessionWorkspace
+}
+
+func (c *config) ExtensionFiles() []string {
+	return slices.Clone(c.extensionFiles)
+}
+
+func (c *config) ExtensionDirs() []string {
+	return slices.Clone(c.extensionDirs)
 }
 
 func (c *config) BaseDir() string {
@@ -278,6 +351,44 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		return validatedArguments{}, err
 	}
 
+	transport, err := get(rawCfg, defaultparameters.Transport())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	switch transport {
+	case string(entities.TransportStdio), string(entities.TransportHTTP):
+	default:
+		return validatedArguments{}, messages.New_StartupErrors_InvalidTransport_Error(transport)
+	}
+
+	httpListenAddress, err := get(rawCfg, defaultparameters.HTTPListenAddress())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	httpTLSCertFile, err := get(rawCfg, defaultparameters.HTTPTLSCertFile())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	httpTLSKeyFile, err := get(rawCfg, defaultparameters.HTTPTLSKeyFile())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if (httpTLSCertFile == "") != (httpTLSKeyFile == "") {
+		return validatedArguments{}, messages.New_StartupErrors_IncompleteTLSConfiguration_Error(
+			defaultparameters.HTTPTLSCertFile().GetFlagName(),
+			defaultparameters.HTTPTLSKeyFile().GetFlagName(),
+		)
+	}
+
+	httpAuthToken, err := get(rawCfg, defaultparameters.HTTPAuthToken())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
 	useSingleMATLABSession, err := get(rawCfg, defaultparameters.UseSingleMATLABSession())
 	if err != nil {
 		return validatedArguments{}, err
@@ -314,7 +425,12 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		return validatedArguments{}, messages.New_StartupErrors_InvalidDisplayMode_Error(displayMode)
 	}
 
-	extensionFile, err := get(rawCfg, defaultparameters.ExtensionFile())
+	extensionFiles, err := get(rawCfg, defaultparameters.ExtensionFile())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	extensionDirs, err := get(rawCfg, defaultparameters.ExtensionDir())
 	if err != nil {
 		return validatedArguments{}, err
 	}
@@ -330,6 +446,16 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABSessionMode_Error(matlabSessionMode)
 	}
 
+	matlabSessionSelector, err := get(rawCfg, defaultparameters.MATLABSessionSelector())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	matlabSessionSelector = strings.TrimSpace(matlabSessionSelector)
+	if matlabSessionSelector == "" {
+		matlabSessionSelector = defaultparameters.MATLABSessionSelector().GetTypedDefaultValue()
+	}
+
 	matlabSessionConnectionDetails, err := get(rawCfg, defaultparameters.MATLABSessionConnectionDetails())
 	if err != nil {
 		return validatedArguments{}, err
@@ -367,6 +493,57 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		embeddedConnectorDetailsTimeout = defaultparameters.EmbeddedConnectorDetailsTimeout().GetTypedDefaultValue()
 	}
 
+	defaultEvalTimeout, err := get(rawCfg, defaultparameters.DefaultEvalTimeout())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if defaultEvalTimeout < 0 {
+		defaultEvalTimeout = defaultparameters.DefaultEvalTimeout().GetTypedDefaultValue()
+	}
+
+	matlabSessionIdleTimeout, err := get(rawCfg, defaultparameters.MATLABSessionIdleTimeout())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabSessionIdleTimeout < 0 {
+		matlabSessionIdleTimeout = defaultparameters.MATLABSessionIdleTimeout().GetTypedDefaultValue()
+	}
+
+	maxMATLABSessions, err := get(rawCfg, defaultparameters.MaxMATLABSessions())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if maxMATLABSessions < 0 {
+		maxMATLABSessions = defaultparameters.MaxMATLABSessions().GetTypedDefaultValue()
+	}
+
+	evictIdleMATLABSessions, err := get(rawCfg, defaultparameters.EvictIdleMATLABSessions())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	matlabPoolSize, err := get(rawCfg, defaultparameters.MATLABPoolSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabPoolSize < 0 {
+		matlabPoolSize = defaultparameters.MATLABPoolSize().GetTypedDefaultValue()
+	}
+
+	recoverSessionState, err := get(rawCfg, defaultparameters.RecoverSessionState())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	recoverSessionWorkspace, err := get(rawCfg, defaultparameters.RecoverSessionWorkspace())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
 	telemetryCollectorEndpoint, err := get(rawCfg, defaultparameters.TelemetryCollectorEndpoint())
 	if err != nil {
 		return validatedArguments{}, err
@@ -395,6 +572,13 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		baseDirectory:    baseDirectory,
 		serverInstanceID: serverInstanceID,
 
+		// Transport
+		transport:         entities.Transport(transport),
+		httpListenAddress: httpListenAddress,
+		httpTLSCertFile:   httpTLSCertFile,
+		httpTLSKeyFile:    httpTLSKeyFile,
+		httpAuthToken:     httpAuthToken,
+
 		// Logger
 		logLevel:              entities.LogLevel(logLevel),
 		duplicateLogsToStderr: duplicateLogsToStderr,
@@ -406,11 +590,20 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		preferredMATLABStartingDirectory: preferredMATLABStartingDirectory,
 		displayMode:                      entities.DisplayMode(displayMode),
 		matlabSessionMode:                entities.MATLABSessionMode(matlabSessionMode),
+		matlabSessionSelector:            matlabSessionSelector,
 		matlabSessionConnectionDetails:   matlabSessionConnectionDetails,
 		matlabSessionConnectionTimeout:   matlabSessionConnectionTimeout,
 		matlabSessionDiscoveryTimeout:    matlabSessionDiscoveryTimeout,
 		embeddedConnectorDetailsTimeout:  embeddedConnectorDetailsTimeout,
-		extensionFile:                    extensionFile,
+		defaultEvalTimeout:               defaultEvalTimeout,
+		matlabSessionIdleTimeout:         matlabSessionIdleTimeout,
+		maxMATLABSessions:                maxMATLABSessions,
+		evictIdleMATLABSessions:          evictIdleMATLABSessions,
+		matlabPoolSize:                   matlabPoolSize,
+		recoverSessionState:              recoverSessionState,
+		recoverSessionWorkspace:          recoverSessionWorkspace,
+		extensionFiles:                   extensionFiles,
+		extensionDirs:                    extensionDirs,
 
 		// Telemetry
 		disableTelemetry:                   disableTelemetry,
@@ -434,6 +627,21 @@ func checkArgumentCompatibilityAndAdjustDefaults(args validatedArguments, specif
 		args.displayMode = entities.DisplayModeNoDesktop
 	}
 
+	// The HTTP specific flags have no meaning when serving over stdio
+	if args.transport == entities.TransportStdio {
+		disallowedParametersWithStdioTransport := []entities.Parameter{
+			defaultparameters.HTTPListenAddress(),
+			defaultparameters.HTTPTLSCertFile(),
+			defaultparameters.HTTPTLSKeyFile(),
+			defaultparameters.HTTPAuthToken(),
+		}
+		for _, parameter := range disallowedParametersWithStdioTransport {
+			if slices.Contains(specifiedParameters, parameter.GetID()) {
+				return validatedArguments{}, messages.New_StartupErrors_ArgumentNotAllowedWithTransport_Error(parameter.GetFlagName(), string(entities.TransportStdio))
+			}
+		}
+	}
+
 	// If using MATLAB Session Mode `existing`, most of the MATLAB flags are unsupported
 	if args.matlabSessionMode == entities.MATLABSessionModeExisting {
 		disallowedParametersInExistingSessionMode := []entities.Parameter{
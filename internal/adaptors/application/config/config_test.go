@@ -31,19 +31,34 @@ func defaultParameters() []entities.Parameter {
 		defaultparameters.LogLevel(),
 		defaultparameters.DuplicateLogsToStderr(),
 
+		defaultparameters.Transport(),
+		defaultparameters.HTTPListenAddress(),
+		defaultparameters.HTTPTLSCertFile(),
+		defaultparameters.HTTPTLSKeyFile(),
+		defaultparameters.HTTPAuthToken(),
+
 		defaultparameters.UseSingleMATLABSession(),
 		defaultparameters.PreferredLocalMATLABRoot(),
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.InitializeMATLABOnStartup(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
+		defaultparameters.MATLABSessionSelector(),
 		defaultparameters.MATLABSessionConnectionDetails(),
 		defaultparameters.MATLABSessionConnectionTimeout(),
 		defaultparameters.MATLABSessionDiscoveryTimeout(),
 		defaultparameters.EmbeddedConnectorDetailsTimeout(),
+		defaultparameters.DefaultEvalTimeout(),
+		defaultparameters.MATLABSessionIdleTimeout(),
+		defaultparameters.MaxMATLABSessions(),
+		defaultparameters.EvictIdleMATLABSessions(),
+		defaultparameters.MATLABPoolSize(),
+		defaultparameters.RecoverSessionState(),
+		defaultparameters.RecoverSessionWorkspace(),
 
 		defaultparameters.DisableTelemetry(),
 		defaultparameters.ExtensionFile(),
+		defaultparameters.ExtensionDir(),
 		defaultparameters.TelemetryCollectorEndpoint(),
 		defaultparameters.TelemetryCollectionInterval(),
 		defaultparameters.TelemetryCollectorEndpointInsecure(),
@@ -81,11 +96,20 @@ func TestNewConfig_InvalidParameterType(t *testing.T) {
 		{key: defaultparameters.PreferredMATLABStartingDirectory().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABDisplayMode().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABSessionMode().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.MATLABSessionSelector().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABSessionConnectionDetails().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABSessionConnectionTimeout().GetID(), invalidValue: "5s", expectedType: "time.Duration"},
 		{key: defaultparameters.MATLABSessionDiscoveryTimeout().GetID(), invalidValue: "30s", expectedType: "time.Duration"},
 		{key: defaultparameters.EmbeddedConnectorDetailsTimeout().GetID(), invalidValue: "1m", expectedType: "time.Duration"},
-		{key: defaultparameters.ExtensionFile().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.DefaultEvalTimeout().GetID(), invalidValue: "30s", expectedType: "time.Duration"},
+		{key: defaultparameters.MATLABSessionIdleTimeout().GetID(), invalidValue: "30m", expectedType: "time.Duration"},
+		{key: defaultparameters.MaxMATLABSessions().GetID(), invalidValue: "4", expectedType: "int"},
+		{key: defaultparameters.EvictIdleMATLABSessions().GetID(), invalidValue: "true", expectedType: "bool"},
+		{key: defaultparameters.MATLABPoolSize().GetID(), invalidValue: "2", expectedType: "int"},
+		{key: defaultparameters.RecoverSessionState().GetID(), invalidValue: "true", expectedType: "bool"},
+		{key: defaultparameters.RecoverSessionWorkspace().GetID(), invalidValue: "true", expectedType: "bool"},
+		{key: defaultparameters.ExtensionFile().GetID(), invalidValue: "tools.json", expectedType: "[]string"},
+		{key: defaultparameters.ExtensionDir().GetID(), invalidValue: "tools", expectedType: "[]string"},
 
 		{key: defaultparameters.DisableTelemetry().GetID(), invalidValue: "false", expectedType: "bool"},
 		{key: defaultparameters.TelemetryCollectorEndpoint().GetID(), invalidValue: 123, expectedType: "string"},
@@ -149,11 +173,20 @@ func TestNewConfig_MissingParameter(t *testing.T) {
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
+		defaultparameters.MATLABSessionSelector(),
 		defaultparameters.MATLABSessionConnectionDetails(),
 		defaultparameters.MATLABSessionConnectionTimeout(),
 		defaultparameters.MATLABSessionDiscoveryTimeout(),
 		defaultparameters.EmbeddedConnectorDetailsTimeout(),
+		defaultparameters.DefaultEvalTimeout(),
+		defaultparameters.MATLABSessionIdleTimeout(),
+		defaultparameters.MaxMATLABSessions(),
+		defaultparameters.EvictIdleMATLABSessions(),
+		defaultparameters.MATLABPoolSize(),
+		defaultparameters.RecoverSessionState(),
+		defaultparameters.RecoverSessionWorkspace(),
 		defaultparameters.ExtensionFile(),
+		defaultparameters.ExtensionDir(),
 		defaultparameters.DisableTelemetry(),
 		defaultparameters.TelemetryCollectorEndpoint(),
 		defaultparameters.TelemetryCollectionInterval(),
@@ -346,6 +379,213 @@ func TestNewConfig_InvalidMATLABSessionMode(t *testing.T) {
 	assert.Nil(t, cfg, "Config should be nil")
 }
 
+func TestNewConfig_InvalidTransport(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidTransport := "invalid-transport"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.Transport().GetID()] = invalidTransport
+
+	expectedError := messages.New_StartupErrors_InvalidTransport_Error(invalidTransport)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestNewConfig_HTTPTransport_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	expectedListenAddress := "0.0.0.0:9000"
+	expectedCertFile := filepath.Join("path", "to", "cert.pem")
+	expectedKeyFile := filepath.Join("path", "to", "key.pem")
+	expectedAuthToken := "secret-token"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.Transport().GetID()] = string(entities.TransportHTTP)
+	parsedArgs[defaultparameters.HTTPListenAddress().GetID()] = expectedListenAddress
+	parsedArgs[defaultparameters.HTTPTLSCertFile().GetID()] = expectedCertFile
+	parsedArgs[defaultparameters.HTTPTLSKeyFile().GetID()] = expectedKeyFile
+	parsedArgs[defaultparameters.HTTPAuthToken().GetID()] = expectedAuthToken
+
+	specifiedParameters := []string{
+		defaultparameters.Transport().GetID(),
+		defaultparameters.HTTPListenAddress().GetID(),
+		defaultparameters.HTTPTLSCertFile().GetID(),
+		defaultparameters.HTTPTLSKeyFile().GetID(),
+		defaultparameters.HTTPAuthToken().GetID(),
+	}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, specifiedParameters, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.TransportHTTP, cfg.Transport())
+	assert.Equal(t, expectedListenAddress, cfg.HTTPListenAddress())
+	assert.Equal(t, expectedCertFile, cfg.HTTPTLSCertFile())
+	assert.Equal(t, expectedKeyFile, cfg.HTTPTLSKeyFile())
+	assert.Equal(t, expectedAuthToken, cfg.HTTPAuthToken())
+}
+
+func TestNewConfig_HTTPTransport_IncompleteTLSConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		certFile string
+		keyFile  string
+	}{
+		{
+			name:     "missing key file",
+			certFile: "cert.pem",
+		},
+		{
+			name:    "missing cert file",
+			keyFile: "key.pem",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.Transport().GetID()] = string(entities.TransportHTTP)
+			parsedArgs[defaultparameters.HTTPTLSCertFile().GetID()] = tc.certFile
+			parsedArgs[defaultparameters.HTTPTLSKeyFile().GetID()] = tc.keyFile
+
+			expectedError := messages.New_StartupErrors_IncompleteTLSConfiguration_Error(
+				defaultparameters.HTTPTLSCertFile().GetFlagName(),
+				defaultparameters.HTTPTLSKeyFile().GetFlagName(),
+			)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_StdioTransport_DisallowedParameter(t *testing.T) {
+	disallowedParameters := []entities.Parameter{
+		defaultparameters.HTTPListenAddress(),
+		defaultparameters.HTTPTLSCertFile(),
+		defaultparameters.HTTPTLSKeyFile(),
+		defaultparameters.HTTPAuthToken(),
+	}
+
+	for _, param := range disallowedParameters {
+		t.Run(param.GetID(), func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+
+			specifiedParameters := []string{param.GetID()}
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, specifiedParameters, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_ArgumentNotAllowedWithTransport_Error(
+				param.GetFlagName(),
+				string(entities.TransportStdio),
+			)
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
 func TestConfig_Version_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
@@ -458,6 +698,55 @@ func TestConfig_InitializeMATLABOnStartup_DisabledWhenNotSingleSession(t *testin
 	assert.False(t, cfg.InitializeMATLABOnStartup(), "InitializeMATLABOnStartup should be false when UseSingleMATLABSession is false")
 }
 
+func TestConfig_MATLABSessionSelector_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name             string
+		selector         string
+		expectedSelector string
+	}{
+		{name: "by name", selector: "analysis", expectedSelector: "analysis"},
+		{name: "surrounding whitespace is trimmed", selector: "  12345 ", expectedSelector: "12345"},
+		{name: "empty falls back to latest", selector: "  ", expectedSelector: entities.MATLABSessionSelectorLatest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABSessionSelector().GetID()] = tc.selector
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSelector, cfg.MATLABSessionSelector())
+		})
+	}
+}
+
 func TestConfig_ShouldShowMATLABDesktop_DefaultsToNoDesktopInInstallAddOnMode(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
@@ -637,6 +926,276 @@ func TestNewConfig_EmbeddedConnectorDetailsTimeout_FallsBackToDefaultWhenNotPosi
 	}
 }
 
+func TestNewConfig_DefaultEvalTimeout(t *testing.T) {
+	testCases := []struct {
+		name            string
+		timeout         time.Duration
+		expectedTimeout time.Duration
+	}{
+		{name: "positive timeout", timeout: 30 * time.Second, expectedTimeout: 30 * time.Second},
+		{name: "zero timeout", timeout: 0, expectedTimeout: 0},
+		{name: "negative timeout", timeout: -time.Minute, expectedTimeout: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.DefaultEvalTimeout().GetID()] = tc.timeout
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTimeout, cfg.DefaultEvalTimeout())
+		})
+	}
+}
+
+func TestNewConfig_MATLABSessionIdleTimeout(t *testing.T) {
+	testCases := []struct {
+		name            string
+		timeout         time.Duration
+		expectedTimeout time.Duration
+	}{
+		{name: "positive timeout", timeout: 30 * time.Minute, expectedTimeout: 30 * time.Minute},
+		{name: "zero timeout", timeout: 0, expectedTimeout: 0},
+		{name: "negative timeout", timeout: -time.Minute, expectedTimeout: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABSessionIdleTimeout().GetID()] = tc.timeout
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTimeout, cfg.MATLABSessionIdleTimeout())
+		})
+	}
+}
+
+func TestNewConfig_MaxMATLABSessions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		maxSessions         int
+		expectedMaxSessions int
+	}{
+		{name: "positive limit", maxSessions: 4, expectedMaxSessions: 4},
+		{name: "zero limit", maxSessions: 0, expectedMaxSessions: 0},
+		{name: "negative limit", maxSessions: -1, expectedMaxSessions: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MaxMATLABSessions().GetID()] = tc.maxSessions
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMaxSessions, cfg.MaxMATLABSessions())
+		})
+	}
+}
+
+func TestNewConfig_MATLABPoolSize(t *testing.T) {
+	testCases := []struct {
+		name             string
+		poolSize         int
+		expectedPoolSize int
+	}{
+		{name: "positive pool size", poolSize: 4, expectedPoolSize: 4},
+		{name: "zero pool size", poolSize: 0, expectedPoolSize: 0},
+		{name: "negative pool size", poolSize: -1, expectedPoolSize: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABPoolSize().GetID()] = tc.poolSize
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPoolSize, cfg.MATLABPoolSize())
+		})
+	}
+}
+
+func TestNewConfig_RecoverSessionState(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.RecoverSessionState().GetID()] = true
+	parsedArgs[defaultparameters.RecoverSessionWorkspace().GetID()] = true
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cfg.RecoverSessionState())
+	assert.True(t, cfg.RecoverSessionWorkspace())
+}
+
+func TestNewConfig_ExtensionFilesAndDirs(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ExtensionFile().GetID()] = []string{"/path/to/first.json", "/path/to/second.json"}
+	parsedArgs[defaultparameters.ExtensionDir().GetID()] = []string{"/path/to/tools"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/path/to/first.json", "/path/to/second.json"}, cfg.ExtensionFiles())
+	assert.Equal(t, []string{"/path/to/tools"}, cfg.ExtensionDirs())
+}
+
 func TestNewConfig_TelemetryCollectionInterval_FallsBackToDefaultWhenNotPositive(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -910,6 +1469,7 @@ func TestConfig_AsPIISafeJSONString_HappyPath(t *testing.T) {
 		defaultparameters.TelemetryCollectionInterval(),
 		defaultparameters.TelemetryCollectorEndpointInsecure(),
 		defaultparameters.DuplicateLogsToStderr(),
+		defaultparameters.Transport(),
 	}
 	for _, param := range piiSafeParams {
 		var expected any
@@ -924,7 +1484,12 @@ func TestConfig_AsPIISafeJSONString_HappyPath(t *testing.T) {
 		defaultparameters.BaseDir(),
 		defaultparameters.ServerInstanceID(),
 		defaultparameters.MATLABSessionConnectionDetails(),
+		defaultparameters.MATLABSessionSelector(),
 		defaultparameters.TelemetryCollectorEndpoint(),
+		defaultparameters.HTTPListenAddress(),
+		defaultparameters.HTTPTLSCertFile(),
+		defaultparameters.HTTPTLSKeyFile(),
+		defaultparameters.HTTPAuthToken(),
 	}
 	for _, param := range redactedParams {
 		assert.Equal(t, config.RedactedValue, parsed[param.GetID()], "%s should be redacted", param.GetID())
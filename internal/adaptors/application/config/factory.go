@@ -38,6 +38,13 @@ type Config interface {
 	BaseDir() string
 	ServerInstanceID() string
 
+	// Transport
+	Transport() entities.Transport
+	HTTPListenAddress() string
+	HTTPTLSCertFile() string
+	HTTPTLSKeyFile() string
+	HTTPAuthToken() string
+
 	// Logger
 	LogLevel() entities.LogLevel
 	DuplicateLogsToStderr() bool
@@ -50,11 +57,20 @@ type Config interface {
 	PreferredMATLABStartingDirectory() string
 	ShouldShowMATLABDesktop() bool
 	MATLABSessionMode() entities.MATLABSessionMode
+	MATLABSessionSelector() string
 	MATLABSessionConnectionDetails() string
 	MATLABSessionConnectionTimeout() time.Duration
 	MATLABSessionDiscoveryTimeout() time.Duration
 	EmbeddedConnectorDetailsTimeout() time.Duration
-	ExtensionFile() string
+	DefaultEvalTimeout() time.Duration
+	MATLABSessionIdleTimeout() time.Duration
+	MaxMATLABSessions() int
+	EvictIdleMATLABSessions() bool
+	MATLABPoolSize() int
+	RecoverSessionState() bool
+	RecoverSessionWorkspace() bool
+	ExtensionFiles() []string
+	ExtensionDirs() []string
 
 	// Telemetry
 	DisableTelemetry() bool
@@ -16,6 +16,7 @@ type DependenciesProviderResources struct {
 	Config         config.GenericConfig
 	MessageCatalog MessageCatalog
 	Watchdog       Watchdog
+	GlobalMATLAB   GlobalMATLAB
 }
 
 type DependenciesProvider func(resources DependenciesProviderResources) (any, error)
@@ -25,11 +26,13 @@ func NewDependenciesProviderResources(
 	config config.GenericConfig,
 	messageCatalog MessageCatalog,
 	watchdog Watchdog,
+	globalMATLAB GlobalMATLAB,
 ) DependenciesProviderResources {
 	return DependenciesProviderResources{
 		Logger:         logger,
 		Config:         config,
 		MessageCatalog: messageCatalog,
 		Watchdog:       watchdog,
+		GlobalMATLAB:   globalMATLAB,
 	}
 }
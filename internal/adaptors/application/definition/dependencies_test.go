@@ -25,12 +25,16 @@ func TestNewDependenciesProviderResources_HappyPath(t *testing.T) {
 	mockWatchdog := &definitionmocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	// Act
 	result := definition.NewDependenciesProviderResources(
 		mockLogger,
 		mockConfig,
 		mockMessageCatalog,
 		mockWatchdog,
+		mockGlobalMATLAB,
 	)
 
 	// Assert
@@ -38,4 +42,5 @@ func TestNewDependenciesProviderResources_HappyPath(t *testing.T) {
 	require.Equal(t, mockConfig, result.Config)
 	require.Equal(t, mockMessageCatalog, result.MessageCatalog)
 	require.Equal(t, mockWatchdog, result.Watchdog)
+	require.Equal(t, mockGlobalMATLAB, result.GlobalMATLAB)
 }
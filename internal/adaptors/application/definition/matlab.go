@@ -0,0 +1,13 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package definition
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type GlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+}
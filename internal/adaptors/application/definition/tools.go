@@ -13,6 +13,7 @@ type ToolsProviderResources struct {
 	Logger         entities.Logger
 	Config         config.GenericConfig
 	MessageCatalog MessageCatalog
+	GlobalMATLAB   GlobalMATLAB
 
 	Dependencies any
 
@@ -26,6 +27,7 @@ func NewToolsProviderResources(
 	logger entities.Logger,
 	config config.GenericConfig,
 	messageCatalog MessageCatalog,
+	globalMATLAB GlobalMATLAB,
 	dependencies any,
 	loggerFactory basetool.LoggerFactory,
 ) ToolsProviderResources {
@@ -33,6 +35,7 @@ func NewToolsProviderResources(
 		Logger:         logger,
 		Config:         config,
 		MessageCatalog: messageCatalog,
+		GlobalMATLAB:   globalMATLAB,
 
 		Dependencies: dependencies,
 
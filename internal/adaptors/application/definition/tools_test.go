@@ -23,6 +23,9 @@ func TestNewToolsProviderResources_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -33,6 +36,7 @@ func TestNewToolsProviderResources_HappyPath(t *testing.T) {
 		mockLogger,
 		mockConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		dependencies,
 		mockLoggerFactory,
 	)
@@ -41,6 +45,7 @@ func TestNewToolsProviderResources_HappyPath(t *testing.T) {
 	require.Equal(t, mockLogger, result.Logger)
 	require.Equal(t, mockConfig, result.Config)
 	require.Equal(t, mockMessageCatalog, result.MessageCatalog)
+	require.Equal(t, mockGlobalMATLAB, result.GlobalMATLAB)
 	require.Equal(t, dependencies, result.Dependencies)
 	require.Equal(t, mockLoggerFactory, result.LoggerFactory)
 }
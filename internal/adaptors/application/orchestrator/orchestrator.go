@@ -57,6 +57,10 @@ type DirectoryFactory interface {
 	Directory() (directory.Directory, messages.Error)
 }
 
+type GlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+}
+
 type ResourceLimitManager interface {
 	CapOpenFilesLimit(limit uint64) (func() error, error)
 }
@@ -75,6 +79,7 @@ type Orchestrator struct {
 	osSignaler            OSSignaler
 	directoryFactory      DirectoryFactory
 	resourceLimitManager  ResourceLimitManager
+	globalMATLAB          GlobalMATLAB
 }
 
 func New(
@@ -88,6 +93,7 @@ func New(
 	osSignaler OSSignaler,
 	directoryFactory DirectoryFactory,
 	resourceLimitManager ResourceLimitManager,
+	globalMATLAB GlobalMATLAB,
 ) *Orchestrator {
 	orchestrator := &Orchestrator{
 		messageCatalog:        messageCatalog,
@@ -100,6 +106,7 @@ func New(
 		osSignaler:            osSignaler,
 		directoryFactory:      directoryFactory,
 		resourceLimitManager:  resourceLimitManager,
+		globalMATLAB:          globalMATLAB,
 	}
 	return orchestrator
 }
@@ -160,12 +167,19 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		return err
 	}
 
+	// SDK tools only get access to MATLAB when the application asks for it
+	var globalMATLAB definition.GlobalMATLAB
+	if o.applicationDefinition.Features().MATLAB.Enabled {
+		globalMATLAB = o.globalMATLAB
+	}
+
 	logger.Debug("Building SDK dependencies")
 	dependencies, err := o.applicationDefinition.Dependencies(definition.NewDependenciesProviderResources(
 		logger,
 		config,
 		o.messageCatalog,
 		o.watchdogClient,
+		globalMATLAB,
 	))
 	if err != nil {
 		return err
@@ -176,6 +190,7 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		logger,
 		config,
 		o.messageCatalog,
+		globalMATLAB,
 		dependencies,
 		o.loggerFactory,
 	))
@@ -49,6 +49,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
@@ -64,6 +67,7 @@ func TestNew_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Assert
@@ -99,6 +103,9 @@ func TestOrchestrator_StartAndWaitForCompletion_ConfigError(t *testing.T) {
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
@@ -121,6 +128,7 @@ func TestOrchestrator_StartAndWaitForCompletion_ConfigError(t *testing.T) {
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -162,6 +170,9 @@ func TestOrchestrator_StartAndWaitForCompletion_GetGlobalLoggerError(t *testing.
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
@@ -189,6 +200,7 @@ func TestOrchestrator_StartAndWaitForCompletion_GetGlobalLoggerError(t *testing.
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -230,6 +242,9 @@ func TestOrchestrator_StartAndWaitForCompletion_CapOpenFilesLimitError(t *testin
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
@@ -263,6 +278,7 @@ func TestOrchestrator_StartAndWaitForCompletion_CapOpenFilesLimitError(t *testin
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -304,6 +320,9 @@ func TestOrchestrator_StartAndWaitForCompletion_DirectoryError(t *testing.T) {
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
@@ -367,6 +386,7 @@ func TestOrchestrator_StartAndWaitForCompletion_DirectoryError(t *testing.T) {
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -408,6 +428,9 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStartError(t *testing.T)
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -484,6 +507,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStartError(t *testing.T)
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -525,6 +549,9 @@ func TestOrchestrator_StartAndWaitForCompletion_DependenciesError(t *testing.T)
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -534,7 +561,7 @@ func TestOrchestrator_StartAndWaitForCompletion_DependenciesError(t *testing.T)
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	expectedError := assert.AnError
-	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, mockGlobalMATLAB)
 
 	mockConfigFactory.EXPECT().
 		Config().
@@ -576,6 +603,11 @@ func TestOrchestrator_StartAndWaitForCompletion_DependenciesError(t *testing.T)
 		Return(nil).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
 	mockApplicationDefinition.EXPECT().
 		Dependencies(expectedDependenciesProviderResources).
 		Return(nil, expectedError).
@@ -607,6 +639,7 @@ func TestOrchestrator_StartAndWaitForCompletion_DependenciesError(t *testing.T)
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -616,6 +649,138 @@ func TestOrchestrator_StartAndWaitForCompletion_DependenciesError(t *testing.T)
 	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Dependencies")
 }
 
+func TestOrchestrator_StartAndWaitForCompletion_MATLABFeatureDisabled_NoGlobalMATLABForSDK(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, nil)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: false}}).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(nil, expectedError).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+		mockGlobalMATLAB,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Dependencies should be called without access to MATLAB")
+}
+
 func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	// Arrange
 	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
@@ -648,6 +813,9 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -665,8 +833,8 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	defer close(stopServer)
 
 	expectedDependencies := &struct{}{}
-	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
-	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, mockGlobalMATLAB)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockGlobalMATLAB, expectedDependencies, mockLoggerFactory)
 	expectedTools := []tools.Tool{mockTool}
 	expectedVersion := "test-version"
 
@@ -714,6 +882,11 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
 	mockApplicationDefinition.EXPECT().
 		Dependencies(expectedDependenciesProviderResources).
 		Return(expectedDependencies, nil).
@@ -765,6 +938,7 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -819,6 +993,9 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -830,8 +1007,8 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	interruptC := getInterruptChannel()
 	expectedError := assert.AnError
 	var expectedDependencies any
-	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
-	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, mockGlobalMATLAB)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockGlobalMATLAB, expectedDependencies, mockLoggerFactory)
 	var expectedTools []tools.Tool
 
 	mockLoggerFactory.EXPECT().
@@ -874,6 +1051,11 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
 	mockApplicationDefinition.EXPECT().
 		Dependencies(expectedDependenciesProviderResources).
 		Return(expectedDependencies, nil).
@@ -920,6 +1102,7 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -961,6 +1144,9 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -972,8 +1158,8 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	interruptC := getInterruptChannel()
 	expectedError := assert.AnError
 	var expectedDependencies any
-	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
-	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, mockGlobalMATLAB)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockGlobalMATLAB, expectedDependencies, mockLoggerFactory)
 	var expectedTools []tools.Tool
 
 	mockLoggerFactory.EXPECT().
@@ -1016,6 +1202,11 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		Return(nil).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
 	mockApplicationDefinition.EXPECT().
 		Dependencies(expectedDependenciesProviderResources).
 		Return(expectedDependencies, nil).
@@ -1062,6 +1253,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -1121,6 +1313,9 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
 	defer mockResourceLimitManager.AssertExpectations(t)
 
+	mockGlobalMATLAB := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -1131,8 +1326,8 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	interruptC := getInterruptChannel()
 	expectedError := assert.AnError
 	var expectedDependencies any
-	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
-	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient, mockGlobalMATLAB)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockGlobalMATLAB, expectedDependencies, mockLoggerFactory)
 	var expectedTools []tools.Tool
 
 	mockLoggerFactory.EXPECT().
@@ -1179,6 +1374,11 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	stopServer := make(chan struct{})
 	defer close(stopServer)
 
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
 	mockApplicationDefinition.EXPECT().
 		Dependencies(expectedDependenciesProviderResources).
 		Return(expectedDependencies, nil).
@@ -1232,6 +1432,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 		mockSignalLayer,
 		mockDirectoryFactory,
 		mockResourceLimitManager,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -51,6 +51,71 @@ func SetupMATLABMode() *parameter.Parameter[bool] {
 	)
 }
 
+func Transport() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "Transport",
+		/* flagName */ "transport",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"TRANSPORT",
+		/* descriptionKey */ messages.CLIMessages_TransportDescription,
+		/* defaultValue */ string(entities.TransportStdio),
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func HTTPListenAddress() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "HTTPListenAddress",
+		/* flagName */ "http-listen-address",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"HTTP_LISTEN_ADDRESS",
+		/* descriptionKey */ messages.CLIMessages_HTTPListenAddressDescription,
+		/* defaultValue */ "127.0.0.1:8080",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func HTTPTLSCertFile() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "HTTPTLSCertFile",
+		/* flagName */ "http-tls-cert-file",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"HTTP_TLS_CERT_FILE",
+		/* descriptionKey */ messages.CLIMessages_HTTPTLSCertFileDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func HTTPTLSKeyFile() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "HTTPTLSKeyFile",
+		/* flagName */ "http-tls-key-file",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"HTTP_TLS_KEY_FILE",
+		/* descriptionKey */ messages.CLIMessages_HTTPTLSKeyFileDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func HTTPAuthToken() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "HTTPAuthToken",
+		/* flagName */ "http-auth-token",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"HTTP_AUTH_TOKEN",
+		/* descriptionKey */ messages.CLIMessages_HTTPAuthTokenDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ false,
+		/* piiSafe */ false,
+	)
+}
+
 func PreferredLocalMATLABRoot() *parameter.Parameter[string] {
 	return parameter.NewParameter(
 		/* id */ "PreferredLocalMATLABRoot",
@@ -194,6 +259,19 @@ func MATLABSessionMode() *parameter.Parameter[string] {
 	)
 }
 
+func MATLABSessionSelector() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABSessionSelector",
+		/* flagName */ "matlab-session-selector",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_SESSION_SELECTOR",
+		/* descriptionKey */ messages.CLIMessages_MATLABSessionSelectorDescription,
+		/* defaultValue */ entities.MATLABSessionSelectorLatest,
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
 func MATLABSessionConnectionDetails() *parameter.Parameter[string] {
 	return parameter.NewParameter(
 		/* id */ "MATLABSessionConnectionDetails",
@@ -298,14 +376,118 @@ func EmbeddedConnectorDetailsTimeout() *parameter.Parameter[time.Duration] {
 	)
 }
 
-func ExtensionFile() *parameter.Parameter[string] {
+func DefaultEvalTimeout() *parameter.Parameter[time.Duration] {
+	return parameter.NewParameter(
+		/* id */ "DefaultEvalTimeout",
+		/* flagName */ "default-eval-timeout",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"DEFAULT_EVAL_TIMEOUT",
+		/* descriptionKey */ messages.CLIMessages_DefaultEvalTimeoutDescription,
+		/* defaultValue */ time.Duration(0),
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABSessionIdleTimeout() *parameter.Parameter[time.Duration] {
+	return parameter.NewParameter(
+		/* id */ "MATLABSessionIdleTimeout",
+		/* flagName */ "matlab-session-idle-timeout",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_SESSION_IDLE_TIMEOUT",
+		/* descriptionKey */ messages.CLIMessages_MATLABSessionIdleTimeoutDescription,
+		/* defaultValue */ time.Duration(0),
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MaxMATLABSessions() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "MaxMATLABSessions",
+		/* flagName */ "max-matlab-sessions",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MAX_MATLAB_SESSIONS",
+		/* descriptionKey */ messages.CLIMessages_MaxMATLABSessionsDescription,
+		/* defaultValue */ 0,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func EvictIdleMATLABSessions() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "EvictIdleMATLABSessions",
+		/* flagName */ "evict-idle-matlab-sessions",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"EVICT_IDLE_MATLAB_SESSIONS",
+		/* descriptionKey */ messages.CLIMessages_EvictIdleMATLABSessionsDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABPoolSize() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "MATLABPoolSize",
+		/* flagName */ "matlab-pool-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_POOL_SIZE",
+		/* descriptionKey */ messages.CLIMessages_MATLABPoolSizeDescription,
+		/* defaultValue */ 0,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func RecoverSessionState() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "RecoverSessionState",
+		/* flagName */ "recover-session-state",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"RECOVER_SESSION_STATE",
+		/* descriptionKey */ messages.CLIMessages_RecoverSessionStateDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func RecoverSessionWorkspace() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "RecoverSessionWorkspace",
+		/* flagName */ "recover-session-workspace",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"RECOVER_SESSION_WORKSPACE",
+		/* descriptionKey */ messages.CLIMessages_RecoverSessionWorkspaceDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func ExtensionFile() *parameter.Parameter[[]string] {
 	return parameter.NewParameter(
 		/* id */ "ExtensionFile",
 		/* flagName */ "extension-file",
 		/* hiddenFlag */ false,
 		/* envVarName */ envVarNamePrefix+"EXTENSION_FILE",
 		/* descriptionKey */ messages.CLIMessages_ExtensionFileDescription,
-		/* defaultValue */ "",
+		/* defaultValue */ []string{},
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func ExtensionDir() *parameter.Parameter[[]string] {
+	return parameter.NewParameter(
+		/* id */ "ExtensionDir",
+		/* flagName */ "extension-dir",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"EXTENSION_DIR",
+		/* descriptionKey */ messages.CLIMessages_ExtensionDirDescription,
+		/* defaultValue */ []string{},
 		/* recordToLog */ true,
 		/* piiSafe */ false,
 	)
@@ -41,6 +41,11 @@ func (s *Selector) DefaultParameters() []entities.Parameter {
 		defaultparameters.BaseDir(),
 		defaultparameters.LogLevel(),
 		defaultparameters.DuplicateLogsToStderr(),
+		defaultparameters.Transport(),
+		defaultparameters.HTTPListenAddress(),
+		defaultparameters.HTTPTLSCertFile(),
+		defaultparameters.HTTPTLSKeyFile(),
+		defaultparameters.HTTPAuthToken(),
 		defaultparameters.WatchdogMode(),
 		defaultparameters.ServerInstanceID(),
 		defaultparameters.DisableTelemetry(),
@@ -56,11 +61,20 @@ func (s *Selector) DefaultParameters() []entities.Parameter {
 		defaultparameters.InitializeMATLABOnStartup(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
+		defaultparameters.MATLABSessionSelector(),
 		defaultparameters.MATLABSessionConnectionDetails(),
 		defaultparameters.MATLABSessionConnectionTimeout(),
 		defaultparameters.MATLABSessionDiscoveryTimeout(),
 		defaultparameters.EmbeddedConnectorDetailsTimeout(),
+		defaultparameters.DefaultEvalTimeout(),
+		defaultparameters.MATLABSessionIdleTimeout(),
+		defaultparameters.MaxMATLABSessions(),
+		defaultparameters.EvictIdleMATLABSessions(),
+		defaultparameters.MATLABPoolSize(),
+		defaultparameters.RecoverSessionState(),
+		defaultparameters.RecoverSessionWorkspace(),
 		defaultparameters.ExtensionFile(),
+		defaultparameters.ExtensionDir(),
 	}
 
 	matlabFeature := s.applicationDefinition.Features().MATLAB
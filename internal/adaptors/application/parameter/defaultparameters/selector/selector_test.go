@@ -43,6 +43,21 @@ func TestSelector_DefaultParameters_DescriptionsResolvedForVisibleParameters(t *
 		messages.CLIMessages_LogLevelDescription: {
 			description: "Log level description",
 		},
+		messages.CLIMessages_TransportDescription: {
+			description: "Transport description",
+		},
+		messages.CLIMessages_HTTPListenAddressDescription: {
+			description: "HTTP listen address description",
+		},
+		messages.CLIMessages_HTTPTLSCertFileDescription: {
+			description: "HTTP TLS cert file description",
+		},
+		messages.CLIMessages_HTTPTLSKeyFileDescription: {
+			description: "HTTP TLS key file description",
+		},
+		messages.CLIMessages_HTTPAuthTokenDescription: {
+			description: "HTTP auth token description",
+		},
 		messages.CLIMessages_PreferredLocalMATLABRootDescription: {
 			description: "MATLAB root description",
 		},
@@ -58,9 +73,36 @@ func TestSelector_DefaultParameters_DescriptionsResolvedForVisibleParameters(t *
 		messages.CLIMessages_MATLABSessionModeDescription: {
 			description: "MATLAB session mode description",
 		},
+		messages.CLIMessages_MATLABSessionSelectorDescription: {
+			description: "MATLAB session selector description",
+		},
+		messages.CLIMessages_DefaultEvalTimeoutDescription: {
+			description: "Default eval timeout description",
+		},
+		messages.CLIMessages_MATLABSessionIdleTimeoutDescription: {
+			description: "MATLAB session idle timeout description",
+		},
+		messages.CLIMessages_MaxMATLABSessionsDescription: {
+			description: "Max MATLAB sessions description",
+		},
+		messages.CLIMessages_EvictIdleMATLABSessionsDescription: {
+			description: "Evict idle MATLAB sessions description",
+		},
+		messages.CLIMessages_MATLABPoolSizeDescription: {
+			description: "MATLAB pool size description",
+		},
+		messages.CLIMessages_RecoverSessionStateDescription: {
+			description: "Recover session state description",
+		},
+		messages.CLIMessages_RecoverSessionWorkspaceDescription: {
+			description: "Recover session workspace description",
+		},
 		messages.CLIMessages_ExtensionFileDescription: {
 			description: "Extension file description",
 		},
+		messages.CLIMessages_ExtensionDirDescription: {
+			description: "Extension folder description",
+		},
 	}
 
 	mockAppDef.EXPECT().
@@ -122,7 +164,7 @@ func TestSelector_DefaultParameters_MATLABEnabled(t *testing.T) {
 	parameters := sut.DefaultParameters()
 
 	// Assert
-	assert.Len(t, parameters, 23)
+	assert.Len(t, parameters, 37)
 
 	for _, p := range parameters {
 		assert.True(t, p.GetActive(), "parameter %s should be active", p.GetID())
@@ -145,6 +187,11 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 		"BaseDir":                            true,
 		"LogLevel":                           true,
 		"DuplicateLogsToStderr":              true,
+		"Transport":                          true,
+		"HTTPListenAddress":                  true,
+		"HTTPTLSCertFile":                    true,
+		"HTTPTLSKeyFile":                     true,
+		"HTTPAuthToken":                      true,
 		"WatchdogMode":                       true,
 		"ServerInstanceID":                   true,
 		"TelemetryCollectorEndpoint":         true,
@@ -156,11 +203,20 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 		"InitializeMATLABOnStartup":          false,
 		"MATLABDisplayMode":                  false,
 		"MATLABSessionMode":                  false,
+		"MATLABSessionSelector":              false,
 		"MATLABSessionConnectionDetails":     false,
 		"MATLABSessionConnectionTimeout":     false,
 		"MATLABSessionDiscoveryTimeout":      false,
 		"EmbeddedConnectorDetailsTimeout":    false,
+		"DefaultEvalTimeout":                 false,
+		"MATLABSessionIdleTimeout":           false,
+		"MaxMATLABSessions":                  false,
+		"EvictIdleMATLABSessions":            false,
+		"MATLABPoolSize":                     false,
+		"RecoverSessionState":                false,
+		"RecoverSessionWorkspace":            false,
 		"ExtensionFile":                      false,
+		"ExtensionDir":                       false,
 	}
 
 	mockAppDef.EXPECT().
@@ -178,7 +234,7 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 	parameters := sut.DefaultParameters()
 
 	// Assert
-	assert.Len(t, parameters, 23)
+	assert.Len(t, parameters, 37)
 
 	for _, p := range parameters {
 		expectedState, exists := expectedActiveStateByParameterID[p.GetID()]
@@ -3,6 +3,7 @@
 package parser
 
 import (
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -38,12 +39,21 @@ func (p *Parser) parseEnvVars(specifiedArgs map[string]any, specifiedParameters
 			parsedVal = boolVal
 		case string:
 			parsedVal = val
+		case int:
+			intVal, err := strconv.Atoi(val)
+			if err != nil {
+				return messages.New_StartupErrors_BadValueForEnvVar_Error(val, envVarName)
+			}
+			parsedVal = intVal
 		case time.Duration:
 			durationVal, err := time.ParseDuration(val)
 			if err != nil {
 				return messages.New_StartupErrors_BadValueForEnvVar_Error(val, envVarName)
 			}
 			parsedVal = durationVal
+		case []string:
+			// Values are separated like the entries of PATH, with ":" on Linux and macOS, and ";" on Windows.
+			parsedVal = filepath.SplitList(val)
 		default:
 			// If you hit this error, it means this switch is not implementing a supported type in `pkg/config`
 			return messages.New_StartupErrors_ParseFailed_Error("\n", internalErrorText)
@@ -3,6 +3,7 @@
 package parser_test
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -276,3 +277,163 @@ func TestParser_Parse_BadEnvVarDurationValue(t *testing.T) {
 	assert.Nil(t, parameters)
 	assert.Nil(t, specifiedParameters)
 }
+
+func TestParser_Parse_IntEnvVar(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	paramID := "int-param"
+	paramEnvVar := "INT_ENV_VAR"
+
+	mockParam := newMockParam(
+		t,
+		paramID,
+		"int-flag",
+		paramEnvVar,
+		3,
+		"Test int description",
+		false,
+		true,
+	)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(paramEnvVar).
+		Return("42", true).
+		Once()
+
+	args := []string{}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	parameters, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 42, result[paramID])
+	assert.Equal(t, []entities.Parameter{mockParam}, parameters)
+	assert.Equal(t, []string{paramID}, specifiedParameters)
+}
+
+func TestParser_Parse_StringArrayEnvVar(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	paramID := "string-array-param"
+	paramEnvVar := "STRING_ARRAY_ENV_VAR"
+
+	mockParam := newMockParam(
+		t,
+		paramID,
+		"string-array-flag",
+		paramEnvVar,
+		[]string{},
+		"Test string array description",
+		false,
+		true,
+	)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(paramEnvVar).
+		Return("first"+string(filepath.ListSeparator)+"second", true).
+		Once()
+
+	args := []string{}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	parameters, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, result[paramID])
+	assert.Equal(t, []entities.Parameter{mockParam}, parameters)
+	assert.Equal(t, []string{paramID}, specifiedParameters)
+}
+
+func TestParser_Parse_BadEnvVarIntValue(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	paramEnvVar := "INT_ENV_VAR"
+	badEnvValue := "notanint"
+
+	mockParam := newMockParam(
+		t,
+		"int-param",
+		"int-flag",
+		paramEnvVar,
+		3,
+		"Test int description",
+		false,
+		true,
+	)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(paramEnvVar).
+		Return(badEnvValue, true).
+		Once()
+
+	args := []string{}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	parameters, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	expectedError := messages.New_StartupErrors_BadValueForEnvVar_Error(badEnvValue, paramEnvVar)
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, result)
+	assert.Nil(t, parameters)
+	assert.Nil(t, specifiedParameters)
+}
@@ -21,8 +21,13 @@ func (p *Parser) setupFlags() {
 			p.flagSet.Bool(flagName, defaultValue, parameter.GetDescription())
 		case string:
 			p.flagSet.String(flagName, defaultValue, parameter.GetDescription())
+		case int:
+			p.flagSet.Int(flagName, defaultValue, parameter.GetDescription())
 		case time.Duration:
 			p.flagSet.Duration(flagName, defaultValue, parameter.GetDescription())
+		case []string:
+			// Each occurrence of the flag adds one value, so values can contain commas, for example in file paths.
+			p.flagSet.StringArray(flagName, defaultValue, parameter.GetDescription())
 		}
 		if parameter.GetHiddenFlag() {
 			_ = p.flagSet.MarkHidden(flagName) // Logically impossible to hit NotExistError
@@ -49,8 +54,12 @@ func (p *Parser) parseFlags(args []string, specifiedArgs map[string]any, specifi
 			val, err = p.flagSet.GetBool(f.Name)
 		case string:
 			val, err = p.flagSet.GetString(f.Name)
+		case int:
+			val, err = p.flagSet.GetInt(f.Name)
 		case time.Duration:
 			val, err = p.flagSet.GetDuration(f.Name)
+		case []string:
+			val, err = p.flagSet.GetStringArray(f.Name)
 		default:
 			// If you hit this error, it means this switch is not implementing a supported type in `pkg/config`
 			messagesErr = messages.New_StartupErrors_ParseFailed_Error("\n", internalErrorText)
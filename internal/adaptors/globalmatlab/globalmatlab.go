@@ -5,38 +5,64 @@ package globalmatlab
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionstate"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 )
 
 var (
-	ErrLostMATLABConnection = errors.New("lost connection to specified existing MATLAB session")
+	ErrLostMATLABConnection       = errors.New("lost connection to specified existing MATLAB session")
+	ErrLostSharedMATLABConnection = errors.New("lost connection to the attached shared MATLAB session; attach to a shared MATLAB session again")
 )
 
+const (
+	restartedNotice         = "MATLAB stopped responding and was restarted. The current folder, the MATLAB path and the workspace were reset."
+	restartedRestoredNotice = "MATLAB stopped responding and was restarted. The MATLAB session state was restored from the last checkpoint, so changes made after it were lost."
+)
+
+// restoreSessionStateTimeout bounds how long restoring the session state can hold up tool calls, as they wait for the restarted session.
+const restoreSessionStateTimeout = time.Minute
+
 type MATLABManagerAdaptor interface {
 	StartSession(ctx context.Context, logger entities.Logger) (entities.SessionID, error)
+	AttachToSharedSession(ctx context.Context, logger entities.Logger, processID int) (entities.SessionID, error)
 	ShouldRestart() (bool, messages.Error)
 	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
 }
 
+type SessionStateRecorder interface {
+	Start(provider sessionstate.SessionProvider) error
+	Restore(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (bool, error)
+}
+
 type GlobalMATLAB struct {
 	matlabManagerAdaptor MATLABManagerAdaptor
+	sessionStateRecorder SessionStateRecorder
 
 	lock              *sync.Mutex
 	startSessionError error
 
 	sessionID entities.SessionID
+	// attachedProcessID is the process ID of the shared MATLAB session attached to with AttachToSharedSession,
+	// or 0 when the global MATLAB session was not chosen that way.
+	attachedProcessID    int
+	isRecoveringSession  bool
+	pendingRestartNotice string
 }
 
 func New(
 	matlabManagerAdaptor MATLABManagerAdaptor,
+	sessionStateRecorder SessionStateRecorder,
 ) *GlobalMATLAB {
 	return &GlobalMATLAB{
 		matlabManagerAdaptor: matlabManagerAdaptor,
+		sessionStateRecorder: sessionStateRecorder,
 
 		lock: &sync.Mutex{},
 	}
@@ -50,7 +76,70 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 		return nil, g.startSessionError
 	}
 
-	return g.getOrCreateClient(ctx, logger)
+	client, err := g.getOrCreateClient(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	g.deliverPendingRestartNotice(ctx)
+
+	return client, nil
+}
+
+// CurrentClient returns the client of the running global MATLAB session, without starting or restarting MATLAB.
+// It reports false when there is no running session to use.
+func (g *GlobalMATLAB) CurrentClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool) {
+	g.lock.Lock()
+	sessionID := g.sessionID
+	g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+	if sessionID == sessionIDZeroValue {
+		return nil, false
+	}
+
+	client, err := g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, sessionID)
+	if err != nil {
+		return nil, false
+	}
+
+	return client, true
+}
+
+// SessionID returns the ID of the global MATLAB session, which may have stopped since, without starting or restarting MATLAB.
+// It reports false when no global MATLAB session was started or attached to yet.
+func (g *GlobalMATLAB) SessionID() (entities.SessionID, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+	return g.sessionID, g.sessionID != sessionIDZeroValue
+}
+
+// AttachToSharedSession switches the global MATLAB session to the shared MATLAB session with the given process ID.
+// The previously attached session is only detached from, so the MATLAB behind it keeps running.
+func (g *GlobalMATLAB) AttachToSharedSession(ctx context.Context, logger entities.Logger, processID int) error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	sessionID, err := g.matlabManagerAdaptor.AttachToSharedSession(ctx, logger, processID)
+	if err != nil {
+		return err
+	}
+
+	var sessionIDZeroValue entities.SessionID
+	if g.sessionID != sessionIDZeroValue {
+		if err := g.matlabManagerAdaptor.StopMATLABSession(ctx, logger, g.sessionID); err != nil {
+			logger.WithError(err).Warn("failed to detach from previous MATLAB session")
+		}
+	}
+
+	g.sessionID = sessionID
+	g.attachedProcessID = processID
+	g.startSessionError = nil
+	g.isRecoveringSession = false
+
+	return nil
 }
 
 func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
@@ -63,11 +152,21 @@ func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Lo
 			return nil, err
 		}
 		g.sessionID = sessionID
+
+		if err := g.sessionStateRecorder.Start(g); err != nil {
+			logger.WithError(err).Warn("failed to start recording MATLAB session state")
+		}
 	}
 
 	// Try to get the client
 	client, err := g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+	if err != nil && g.attachedProcessID != 0 {
+		return g.reattachToSharedSession(ctx, logger)
+	}
 	if err != nil {
+		// The session is lost until a restarted session is handed out, even when restarting takes several attempts
+		g.isRecoveringSession = true
+
 		// Retry: stop old session and start a new one
 		if stopErr := g.matlabManagerAdaptor.StopMATLABSession(ctx, logger, g.sessionID); stopErr != nil {
 			logger.WithError(stopErr).Warn("failed to stop MATLAB session")
@@ -80,12 +179,83 @@ func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Lo
 		}
 		g.sessionID = sessionID
 
-		return g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+		client, err = g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if g.isRecoveringSession {
+		g.isRecoveringSession = false
+
+		g.pendingRestartNotice = restartedNotice
+		if g.restoreSessionState(ctx, logger, client) {
+			g.pendingRestartNotice = restartedRestoredNotice
+		}
 	}
 
 	return client, nil
 }
 
+// reattachToSharedSession connects again to the shared MATLAB session chosen with AttachToSharedSession, instead of
+// starting another MATLAB session. The shared session belongs to the user, so it is never restarted or replaced.
+func (g *GlobalMATLAB) reattachToSharedSession(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+	var sessionIDZeroValue entities.SessionID
+
+	attachedLogger := logger.With("pid", g.attachedProcessID)
+	attachedLogger.Info("Lost connection to the attached shared MATLAB session, attaching again")
+
+	if stopErr := g.matlabManagerAdaptor.StopMATLABSession(ctx, logger, g.sessionID); stopErr != nil {
+		logger.WithError(stopErr).Warn("failed to detach from MATLAB session")
+	}
+	g.sessionID = sessionIDZeroValue
+
+	sessionID, err := g.matlabManagerAdaptor.AttachToSharedSession(ctx, logger, g.attachedProcessID)
+	if err != nil {
+		attachedLogger.WithError(err).Warn("failed to attach again to the shared MATLAB session")
+		g.startSessionError = fmt.Errorf("%w (pid %d): %w", ErrLostSharedMATLABConnection, g.attachedProcessID, err)
+		return nil, g.startSessionError
+	}
+	g.sessionID = sessionID
+
+	return g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+}
+
+// restoreSessionState is not cancelled with the tool call, as it restores the session for every later tool call,
+// but it times out, as every tool call waits for it.
+func (g *GlobalMATLAB) restoreSessionState(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) bool {
+	restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreSessionStateTimeout)
+	defer cancel()
+
+	restored, err := g.sessionStateRecorder.Restore(restoreCtx, logger, client)
+	if err != nil {
+		logger.WithError(err).Warn("failed to restore MATLAB session state")
+		return false
+	}
+
+	if restored {
+		logger.Info("Restored MATLAB session state after restart")
+	}
+
+	return restored
+}
+
+// deliverPendingRestartNotice attaches the restart notice to the current tool call.
+// The notice is kept for a later tool call when there is no tool call to attach it to.
+func (g *GlobalMATLAB) deliverPendingRestartNotice(ctx context.Context) {
+	if g.pendingRestartNotice == "" {
+		return
+	}
+
+	recorder := entities.ToolCallNoticeRecorderFromContext(ctx)
+	if recorder == nil {
+		return
+	}
+
+	recorder.AddNotice(g.pendingRestartNotice)
+	g.pendingRestartNotice = ""
+}
+
 func (g *GlobalMATLAB) restartMATLABSession(ctx context.Context, logger entities.Logger) (entities.SessionID, error) {
 	var sessionIDZeroValue entities.SessionID
 
@@ -0,0 +1,330 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_AttachToSharedSession_NoCurrentSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedSessionID := entities.SessionID(7)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	// Act
+	err := globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.NoError(t, err)
+
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_AttachToSharedSession_DetachesFromCurrentSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	firstSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer firstSessionClient.AssertExpectations(t)
+
+	secondSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer secondSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	firstSessionID := entities.SessionID(1)
+	secondSessionID := entities.SessionID(2)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(firstSessionClient, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(secondSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	err = globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.NoError(t, err)
+
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, secondSessionClient, client)
+}
+
+func TestGlobalMATLAB_AttachToSharedSession_ErrorKeepsCurrentSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedSessionID := entities.SessionID(1)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(entities.SessionID(0), assert.AnError).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	err = globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_AttachToSharedSession_ClearsCachedStartupError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedSessionID := entities.SessionID(3)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(entities.SessionID(0), assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.ErrorIs(t, err, assert.AnError)
+
+	// Act
+	err = globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.NoError(t, err)
+
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_AttachToSharedSession_LostConnection_AttachesAgain(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	lostSessionID := entities.SessionID(7)
+	expectedSessionID := entities.SessionID(8)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(lostSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), lostSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), lostSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	err := globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+	require.NoError(t, err)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_AttachToSharedSession_LostConnection_AttachAgainFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	lostSessionID := entities.SessionID(7)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(lostSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), lostSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), lostSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		AttachToSharedSession(ctx, mockLogger.AsMockArg(), processID).
+		Return(entities.SessionID(0), assert.AnError).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	err := globalMATLAB.AttachToSharedSession(ctx, mockLogger, processID)
+	require.NoError(t, err)
+
+	// Act
+	_, err = globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, globalmatlab.ErrLostSharedMATLABConnection)
+	require.ErrorIs(t, err, assert.AnError)
+
+	// A MATLAB session that the user did not choose is never started in place of the shared session.
+	_, err = globalMATLAB.Client(ctx, mockLogger)
+	require.ErrorIs(t, err, globalmatlab.ErrLostSharedMATLABConnection)
+}
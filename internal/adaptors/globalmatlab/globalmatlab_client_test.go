@@ -14,6 +14,7 @@ import (
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +25,9 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -40,7 +44,12 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -57,6 +66,9 @@ func TestGlobalMATLAB_Client_StartSessionError(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedError := assert.AnError
 
@@ -65,7 +77,7 @@ func TestGlobalMATLAB_Client_StartSessionError(t *testing.T) {
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -82,6 +94,9 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedError := assert.AnError
 
@@ -90,7 +105,7 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -111,6 +126,9 @@ func TestGlobalMATLAB_Client_DiscoveryErrorNotCached_RetrySucceeds(t *testing.T)
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -132,7 +150,12 @@ func TestGlobalMATLAB_Client_DiscoveryErrorNotCached_RetrySucceeds(t *testing.T)
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -153,6 +176,9 @@ func TestGlobalMATLAB_Client_DiscoveryErrorNotCached_RetryAlsoFails(t *testing.T
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 
 	mockMATLABManagerAdaptor.EXPECT().
@@ -160,7 +186,7 @@ func TestGlobalMATLAB_Client_DiscoveryErrorNotCached_RetryAlsoFails(t *testing.T
 		Return(entities.SessionID(0), sessionmanager.ErrFailedToAttachToMATLABSession).
 		Twice()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -181,6 +207,9 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -219,7 +248,17 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), expectedSessionClient).
+		Return(false, nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -236,6 +275,9 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	firstSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer firstSessionClient.AssertExpectations(t)
 
@@ -282,7 +324,17 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 		Return(secondSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), secondSessionClient).
+		Return(false, nil).
+		Once()
 
 	// Act
 	firstClient, firstErr := globalMATLAB.Client(ctx, mockLogger)
@@ -302,6 +354,9 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -341,7 +396,17 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), expectedSessionClient).
+		Return(false, nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -358,6 +423,9 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	firstSessionID := entities.SessionID(123)
 	getClientError := assert.AnError
@@ -388,7 +456,12 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -405,6 +478,9 @@ func TestGlobalMATLAB_Client_RestartDiscoveryErrorNotCached_RetrySucceeds(t *tes
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -450,7 +526,17 @@ func TestGlobalMATLAB_Client_RestartDiscoveryErrorNotCached_RetrySucceeds(t *tes
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Twice()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), expectedSessionClient).
+		Return(false, nil).
+		Once()
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -471,6 +557,9 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer expectedSessionClient.AssertExpectations(t)
 
@@ -487,7 +576,12 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Times(3)
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	var wg sync.WaitGroup
@@ -520,6 +614,9 @@ func TestGlobalMATLAB_Client_LostConnectionToSpecifiedMATLAB(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	sessionID := entities.SessionID(123)
 	getClientError := assert.AnError
@@ -544,7 +641,12 @@ func TestGlobalMATLAB_Client_LostConnectionToSpecifiedMATLAB(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
@@ -561,6 +663,9 @@ func TestGlobalMATLAB_Client_LostConnectionToSpecifiedMATLAB_CachedOnSubsequentC
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	sessionID := entities.SessionID(123)
 	getClientError := assert.AnError
@@ -585,7 +690,12 @@ func TestGlobalMATLAB_Client_LostConnectionToSpecifiedMATLAB_CachedOnSubsequentC
 		Return(false, nil).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -606,6 +716,9 @@ func TestGlobalMATLAB_Client_ShouldRestartError_CachedOnSubsequentCall(t *testin
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	sessionID := entities.SessionID(123)
 	getClientError := assert.AnError
@@ -630,7 +743,12 @@ func TestGlobalMATLAB_Client_ShouldRestartError_CachedOnSubsequentCall(t *testin
 		Return(false, messages.AnError).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client1, err1 := globalMATLAB.Client(ctx, mockLogger)
@@ -651,6 +769,9 @@ func TestGlobalMATLAB_Client_ShouldRestartError(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	ctx := t.Context()
 	sessionID := entities.SessionID(123)
 	getClientError := assert.AnError
@@ -675,7 +796,12 @@ func TestGlobalMATLAB_Client_ShouldRestartError(t *testing.T) {
 		Return(false, messages.AnError).
 		Once()
 
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
 
 	// Act
 	client, err := globalMATLAB.Client(ctx, mockLogger)
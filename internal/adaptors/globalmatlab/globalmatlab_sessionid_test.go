@@ -0,0 +1,77 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_SessionID_NoSession(t *testing.T) {
+	// Arrange
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	// Act
+	sessionID, found := globalMATLAB.SessionID()
+
+	// Assert
+	assert.False(t, found)
+	assert.Zero(t, sessionID)
+}
+
+func TestGlobalMATLAB_SessionID_ReturnsStartedSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	sessionID, found := globalMATLAB.SessionID()
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
@@ -0,0 +1,335 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_Client_RestartRestoresSessionStateAndAddsNotice(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	mockNoticeRecorder := &entitiesmocks.MockToolCallNoticeRecorder{}
+	defer mockNoticeRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := entities.ContextWithToolCallNoticeRecorder(t.Context(), mockNoticeRecorder)
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		ShouldRestart().
+		Return(true, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.MatchedBy(func(restoreCtx context.Context) bool {
+			_, hasDeadline := restoreCtx.Deadline()
+			return hasDeadline
+		}), mockLogger.AsMockArg(), expectedSessionClient).
+		Return(true, nil).
+		Once()
+
+	mockNoticeRecorder.EXPECT().
+		AddNotice(mock.MatchedBy(func(notice string) bool {
+			return assert.Contains(t, notice, "restored")
+		})).
+		Return().
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_Client_RestoreErrorStillAddsNotice(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	mockNoticeRecorder := &entitiesmocks.MockToolCallNoticeRecorder{}
+	defer mockNoticeRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := entities.ContextWithToolCallNoticeRecorder(t.Context(), mockNoticeRecorder)
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		ShouldRestart().
+		Return(true, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), expectedSessionClient).
+		Return(false, assert.AnError).
+		Once()
+
+	mockNoticeRecorder.EXPECT().
+		AddNotice(mock.MatchedBy(func(notice string) bool {
+			return assert.Contains(t, notice, "reset")
+		})).
+		Return().
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+
+	logs := mockLogger.WarnLogs()
+	require.Contains(t, logs, "failed to restore MATLAB session state")
+	assert.Equal(t, assert.AnError, logs["failed to restore MATLAB session state"]["error"])
+}
+
+func TestGlobalMATLAB_Client_RestartNoticeKeptForNextToolCall(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	mockNoticeRecorder := &entitiesmocks.MockToolCallNoticeRecorder{}
+	defer mockNoticeRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	restartCtx := t.Context()
+	toolCallCtx := entities.ContextWithToolCallNoticeRecorder(restartCtx, mockNoticeRecorder)
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(restartCtx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(restartCtx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(restartCtx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		ShouldRestart().
+		Return(true, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(restartCtx, mockLogger.AsMockArg()).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(restartCtx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(toolCallCtx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	mockSessionStateRecorder.EXPECT().
+		Restore(mock.Anything, mockLogger.AsMockArg(), expectedSessionClient).
+		Return(false, nil).
+		Once()
+
+	mockNoticeRecorder.EXPECT().
+		AddNotice(mock.AnythingOfType("string")).
+		Return().
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	// Act
+	_, restartErr := globalMATLAB.Client(restartCtx, mockLogger)
+	_, firstToolCallErr := globalMATLAB.Client(toolCallCtx, mockLogger)
+	_, secondToolCallErr := globalMATLAB.Client(toolCallCtx, mockLogger)
+
+	// Assert
+	require.NoError(t, restartErr)
+	require.NoError(t, firstToolCallErr)
+	require.NoError(t, secondToolCallErr)
+}
+
+func TestGlobalMATLAB_CurrentClient_NoSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	// Act
+	client, found := globalMATLAB.CurrentClient(t.Context(), mockLogger)
+
+	// Assert
+	assert.False(t, found)
+	assert.Nil(t, client)
+}
+
+func TestGlobalMATLAB_CurrentClient_DoesNotRestartLostSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	sessionID := entities.SessionID(123)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(sessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), sessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), sessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
+
+	mockSessionStateRecorder.EXPECT().
+		Start(globalMATLAB).
+		Return(nil).
+		Once()
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	currentClient, found := globalMATLAB.CurrentClient(ctx, mockLogger)
+	lostClient, lostFound := globalMATLAB.CurrentClient(ctx, mockLogger)
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, expectedSessionClient, currentClient)
+
+	assert.False(t, lostFound)
+	assert.Nil(t, lostClient)
+}
@@ -15,8 +15,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
 	defer mockMATLABManagerAdaptor.AssertExpectations(t)
 
+	mockSessionStateRecorder := &mocks.MockSessionStateRecorder{}
+	defer mockSessionStateRecorder.AssertExpectations(t)
+
 	// Act
-	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor, mockSessionStateRecorder)
 
 	// Assert
 	assert.NotNil(t, globalMATLAB)
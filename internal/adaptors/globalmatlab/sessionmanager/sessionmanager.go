@@ -93,6 +93,11 @@ func (s *SessionManager) StartSession(ctx context.Context, logger entities.Logge
 	return sessionID, nil
 }
 
+// AttachToSharedSession attaches to the shared MATLAB session with the given process ID.
+func (s *SessionManager) AttachToSharedSession(ctx context.Context, logger entities.Logger, processID int) (entities.SessionID, error) {
+	return s.matlabManager.StartMATLABSession(ctx, logger, entities.AttachToExistingSession{ProcessID: processID})
+}
+
 func (s *SessionManager) ShouldRestart() (bool, messages.Error) {
 	cfg, err := s.configFactory.Config()
 	if err != nil {
@@ -289,6 +289,86 @@ func TestSessionManager_StopMATLABSession_Error(t *testing.T) {
 	require.ErrorIs(t, err, expectedErr)
 }
 
+func TestSessionManager_AttachToSharedSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.AttachToExistingSession{ProcessID: processID}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	starter := sessionmanager.New(
+		mockMATLABManager,
+		mockConfigFactory,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	sessionID, err := starter.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestSessionManager_AttachToSharedSession_Error(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedErr := assert.AnError
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.AttachToExistingSession{ProcessID: processID}).
+		Return(entities.SessionID(0), expectedErr).
+		Once()
+
+	starter := sessionmanager.New(
+		mockMATLABManager,
+		mockConfigFactory,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	sessionID, err := starter.AttachToSharedSession(ctx, mockLogger, processID)
+
+	// Assert
+	require.ErrorIs(t, err, expectedErr)
+	assert.Zero(t, sessionID)
+}
+
 func TestSessionManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
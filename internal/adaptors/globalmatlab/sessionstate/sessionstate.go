@@ -0,0 +1,292 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionstate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	applicationdirectory "github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
+)
+
+const (
+	defaultCheckpointInterval = time.Minute
+	defaultShutdownTimeout    = 5 * time.Second
+
+	stateDirPattern   = "matlab-session-state-"
+	stateFileName     = "session-state.mat"
+	workspaceFileName = "workspace.mat"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type ApplicationDirectoryFactory interface {
+	Directory() (applicationdirectory.Directory, messages.Error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// SessionProvider gives access to the MATLAB session whose state is recorded.
+// It reports false when there is no MATLAB session to checkpoint right now.
+type SessionProvider interface {
+	CurrentClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool)
+}
+
+// usageReporter is implemented by MATLAB session clients that know whether a request is in progress.
+type usageReporter interface {
+	Usage() matlabsessionstore.Usage
+}
+
+// Recorder periodically saves the current folder, the MATLAB path and, optionally, the workspace of the global MATLAB session
+// to files in the application directory, so that they can be restored when MATLAB has to be restarted.
+// The files are not kept in the MATLAB session directory, as it is deleted with the session they are needed to restore.
+type Recorder struct {
+	configFactory               ConfigFactory
+	loggerFactory               LoggerFactory
+	applicationDirectoryFactory ApplicationDirectoryFactory
+	lifecycleSignaler           LifecycleSignaler
+
+	startOnce *sync.Once
+	startErr  error
+
+	l                 *sync.Mutex
+	stateDir          string
+	hasCheckpoint     bool
+	includesWorkspace bool
+
+	checkpointInterval time.Duration
+	shutdownTimeout    time.Duration
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	applicationDirectoryFactory ApplicationDirectoryFactory,
+	lifecycleSignaler LifecycleSignaler,
+) *Recorder {
+	return &Recorder{
+		configFactory:               configFactory,
+		loggerFactory:               loggerFactory,
+		applicationDirectoryFactory: applicationDirectoryFactory,
+		lifecycleSignaler:           lifecycleSignaler,
+
+		startOnce: new(sync.Once),
+
+		l: new(sync.Mutex),
+
+		checkpointInterval: defaultCheckpointInterval,
+		shutdownTimeout:    defaultShutdownTimeout,
+	}
+}
+
+// Start begins checkpointing the session given by provider in the background, until the application shuts down.
+// Nothing is recorded unless session state recovery is enabled, or when attached to an existing MATLAB session,
+// whose state belongs to the user. Calling Start more than once has no further effect.
+func (r *Recorder) Start(provider SessionProvider) error {
+	r.startOnce.Do(func() {
+		r.startErr = r.start(provider)
+	})
+	return r.startErr
+}
+
+func (r *Recorder) start(provider SessionProvider) error {
+	config, messagesErr := r.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	if !config.RecoverSessionState() || config.MATLABSessionMode() == entities.MATLABSessionModeExisting {
+		return nil
+	}
+
+	logger, messagesErr := r.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	logger.With("checkpoint-interval", r.checkpointInterval.String()).Info("Started recording MATLAB session state")
+
+	// Checkpoints are only cancelled on shutdown, as cancelling an evaluation interrupts MATLAB.
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC := make(chan struct{})
+	r.lifecycleSignaler.AddShutdownFunction(func() error {
+		cancel()
+
+		// MATLAB may not stop an evaluation straight away, so shutdown does not wait long for the checkpoint in progress.
+		select {
+		case <-doneC:
+		case <-time.After(r.shutdownTimeout):
+			logger.Warn("Stopped waiting for the MATLAB session state to be recorded")
+		}
+
+		return nil
+	})
+
+	go func() {
+		defer close(doneC)
+
+		ticker := time.NewTicker(r.checkpointInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// A tick can be ready together with the shutdown, which must not start another checkpoint.
+				if ctx.Err() != nil {
+					return
+				}
+				r.checkpointCurrentSession(ctx, logger, provider)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Checkpoint saves the state of the MATLAB session behind client, replacing the previous checkpoint.
+func (r *Recorder) Checkpoint(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
+	config, messagesErr := r.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	stateDir, err := r.getOrCreateStateDir()
+	if err != nil {
+		return err
+	}
+
+	includeWorkspace := config.RecoverSessionWorkspace()
+	if _, err := client.Eval(ctx, logger, entities.EvalRequest{Code: checkpointCode(stateDir, includeWorkspace)}); err != nil {
+		return err
+	}
+
+	r.l.Lock()
+	r.hasCheckpoint = true
+	r.includesWorkspace = includeWorkspace
+	r.l.Unlock()
+
+	return nil
+}
+
+// Restore brings the MATLAB session behind client back to the last checkpoint.
+// It returns false when there is no checkpoint to restore.
+func (r *Recorder) Restore(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (bool, error) {
+	r.l.Lock()
+	stateDir := r.stateDir
+	hasCheckpoint := r.hasCheckpoint
+	includesWorkspace := r.includesWorkspace
+	r.l.Unlock()
+
+	if !hasCheckpoint {
+		return false, nil
+	}
+
+	if _, err := client.Eval(ctx, logger, entities.EvalRequest{Code: restoreCode(stateDir, includesWorkspace)}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Recorder) checkpointCurrentSession(ctx context.Context, logger entities.Logger, provider SessionProvider) {
+	client, found := provider.CurrentClient(ctx, logger)
+	if !found {
+		return
+	}
+
+	// Recording the state of a busy session would wait for the request in progress, and would record the state in the middle of it.
+	if reporter, ok := client.(usageReporter); ok && reporter.Usage().IsBusy {
+		logger.Debug("Skipped recording MATLAB session state, as MATLAB is busy")
+		return
+	}
+
+	if err := r.Checkpoint(ctx, logger, client); err != nil {
+		logger.WithError(err).Warn("Failed to record MATLAB session state")
+		return
+	}
+
+	logger.Debug("Recorded MATLAB session state")
+}
+
+func (r *Recorder) getOrCreateStateDir() (string, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if r.stateDir != "" {
+		return r.stateDir, nil
+	}
+
+	applicationDirectory, messagesErr := r.applicationDirectoryFactory.Directory()
+	if messagesErr != nil {
+		return "", messagesErr
+	}
+
+	stateDir, messagesErr := applicationDirectory.CreateSubDir(stateDirPattern)
+	if messagesErr != nil {
+		return "", messagesErr
+	}
+
+	r.stateDir = stateDir
+	return stateDir, nil
+}
+
+// checkpointCode saves to temporary files first, so that MATLAB crashing while saving does not corrupt the previous checkpoint.
+// The session state is saved from the workspace of an anonymous function, so that no variable of the user is overwritten or cleared.
+func checkpointCode(stateDir string, includeWorkspace bool) string {
+	var code strings.Builder
+
+	if includeWorkspace {
+		code.WriteString(saveAndReplaceCode(filepath.Join(stateDir, workspaceFileName), "save('%s');"))
+	}
+
+	code.WriteString(saveAndReplaceCode(
+		filepath.Join(stateDir, stateFileName),
+		"feval(@(mcpSessionState) save('%s', '-struct', 'mcpSessionState'), struct('folder', pwd, 'path', path));",
+	))
+
+	return code.String()
+}
+
+func saveAndReplaceCode(file string, saveFormat string) string {
+	tempFile := strings.TrimSuffix(file, ".mat") + ".tmp.mat"
+	return fmt.Sprintf(saveFormat, matlabstring.EscapeSingleQuotes(tempFile)) +
+		fmt.Sprintf("movefile('%s', '%s', 'f');", matlabstring.EscapeSingleQuotes(tempFile), matlabstring.EscapeSingleQuotes(file))
+}
+
+// restoreCode changes back to the recorded folder, and adds back the recorded path entries that are missing and still exist.
+func restoreCode(stateDir string, includesWorkspace bool) string {
+	var code strings.Builder
+
+	code.WriteString(fmt.Sprintf("mcpSessionState = load('%s');", matlabstring.EscapeSingleQuotes(filepath.Join(stateDir, stateFileName))))
+	code.WriteString("if isfolder(mcpSessionState.folder), cd(mcpSessionState.folder); end;")
+	code.WriteString("mcpSessionState.missing = setdiff(strsplit(mcpSessionState.path, pathsep), strsplit(path, pathsep), 'stable');")
+	code.WriteString("mcpSessionState.missing = mcpSessionState.missing(cellfun(@isfolder, mcpSessionState.missing));")
+	code.WriteString("if ~isempty(mcpSessionState.missing), addpath(mcpSessionState.missing{:}); end;")
+	code.WriteString("clear mcpSessionState;")
+
+	if includesWorkspace {
+		workspaceFile := matlabstring.EscapeSingleQuotes(filepath.Join(stateDir, workspaceFileName))
+		code.WriteString(fmt.Sprintf("if isfile('%s'), load('%s'); end;", workspaceFile, workspaceFile))
+	}
+
+	return code.String()
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionstate
+
+import "time"
+
+func (r *Recorder) SetCheckpointInterval(checkpointInterval time.Duration) {
+	r.checkpointInterval = checkpointInterval
+}
+
+func (r *Recorder) SetShutdownTimeout(shutdownTimeout time.Duration) {
+	r.shutdownTimeout = shutdownTimeout
+}
@@ -0,0 +1,717 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionstate_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionstate"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/directory"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab/sessionstate"
+	matlabsessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Act
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, recorder)
+}
+
+func TestRecorder_Start_Disabled(t *testing.T) {
+	testCases := []struct {
+		name                string
+		recoverSessionState bool
+		sessionMode         entities.MATLABSessionMode
+	}{
+		{name: "recovery not enabled", recoverSessionState: false, sessionMode: entities.MATLABSessionModeNew},
+		{name: "existing MATLAB session", recoverSessionState: true, sessionMode: entities.MATLABSessionModeExisting},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+			defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockSessionProvider := &mocks.MockSessionProvider{}
+			defer mockSessionProvider.AssertExpectations(t)
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				RecoverSessionState().
+				Return(tc.recoverSessionState).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionMode().
+				Return(tc.sessionMode).
+				Maybe()
+
+			recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+			// Act
+			err := recorder.Start(mockSessionProvider)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRecorder_Start_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSessionProvider := &mocks.MockSessionProvider{}
+	defer mockSessionProvider.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+	// Act
+	firstErr := recorder.Start(mockSessionProvider)
+	secondErr := recorder.Start(mockSessionProvider)
+
+	// Assert
+	require.ErrorIs(t, firstErr, expectedError)
+	require.ErrorIs(t, secondErr, expectedError)
+}
+
+func TestRecorder_Start_CheckpointsCurrentSessionUntilShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSessionProvider := &mocks.MockSessionProvider{}
+	defer mockSessionProvider.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	stateDir := filepath.Join("tmp", "matlab-session-state-123")
+
+	var capturedShutdownFunc func() error
+	checkpointedC := make(chan struct{})
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		RecoverSessionState().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Once()
+
+	mockConfig.EXPECT().
+		RecoverSessionWorkspace().
+		Return(false)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-state-").
+		Return(stateDir, nil).
+		Once()
+
+	mockSessionProvider.EXPECT().
+		CurrentClient(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, true)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			select {
+			case <-checkpointedC:
+			default:
+				close(checkpointedC)
+			}
+		}).
+		Return(entities.EvalResponse{}, nil)
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+	recorder.SetCheckpointInterval(time.Millisecond)
+
+	// Act
+	err := recorder.Start(mockSessionProvider)
+
+	// Assert
+	require.NoError(t, err)
+
+	select {
+	case <-checkpointedC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "MATLAB session state was not recorded")
+	}
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestRecorder_Start_SkipsCheckpointWhileSessionIsBusy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSessionProvider := &mocks.MockSessionProvider{}
+	defer mockSessionProvider.AssertExpectations(t)
+
+	mockClient := &matlabsessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	var capturedShutdownFunc func() error
+	usageCheckedC := make(chan struct{})
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		RecoverSessionState().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionProvider.EXPECT().
+		CurrentClient(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, true)
+
+	mockClient.EXPECT().
+		Usage().
+		Run(func() {
+			select {
+			case <-usageCheckedC:
+			default:
+				close(usageCheckedC)
+			}
+		}).
+		Return(matlabsessionstore.Usage{IsBusy: true})
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+	recorder.SetCheckpointInterval(time.Millisecond)
+
+	// Act
+	err := recorder.Start(mockSessionProvider)
+
+	// Assert
+	require.NoError(t, err)
+
+	select {
+	case <-usageCheckedC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "MATLAB session usage was not checked")
+	}
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+
+	mockClient.AssertNotCalled(t, "Eval", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecorder_Start_ShutdownCancelsCheckpointAndDoesNotWaitForIt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSessionProvider := &mocks.MockSessionProvider{}
+	defer mockSessionProvider.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	stateDir := filepath.Join("tmp", "matlab-session-state-123")
+
+	var capturedShutdownFunc func() error
+	evalStartedC := make(chan struct{})
+	evalCancelledC := make(chan struct{})
+	releaseEvalC := make(chan struct{})
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		RecoverSessionState().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Once()
+
+	mockConfig.EXPECT().
+		RecoverSessionWorkspace().
+		Return(false).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-state-").
+		Return(stateDir, nil).
+		Once()
+
+	mockSessionProvider.EXPECT().
+		CurrentClient(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, true).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+		Run(func(ctx context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			close(evalStartedC)
+			<-ctx.Done()
+			close(evalCancelledC)
+			<-releaseEvalC
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+	recorder.SetCheckpointInterval(time.Millisecond)
+	recorder.SetShutdownTimeout(10 * time.Millisecond)
+
+	require.NoError(t, recorder.Start(mockSessionProvider))
+
+	select {
+	case <-evalStartedC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "MATLAB session state was not recorded")
+	}
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+
+	select {
+	case <-evalCancelledC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "Checkpoint in progress was not cancelled")
+	}
+
+	assert.Contains(t, mockLogger.WarnLogs(), "Stopped waiting for the MATLAB session state to be recorded")
+
+	// Let the checkpoint finish, and wait for it, before the mocks are checked.
+	close(releaseEvalC)
+	recorder.SetShutdownTimeout(5 * time.Second)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestRecorder_Restore_NoCheckpoint(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+	// Act
+	restored, err := recorder.Restore(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, restored)
+}
+
+func TestRecorder_CheckpointThenRestore(t *testing.T) {
+	testCases := []struct {
+		name             string
+		includeWorkspace bool
+	}{
+		{name: "without workspace", includeWorkspace: false},
+		{name: "with workspace", includeWorkspace: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+			defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+			mockDirectory := &directorymocks.MockDirectory{}
+			defer mockDirectory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			stateDir := filepath.Join("tmp", "matlab-session-state-123")
+			stateFile := filepath.Join(stateDir, "session-state.mat")
+			workspaceFile := filepath.Join(stateDir, "workspace.mat")
+
+			var checkpointCode, restoreCode string
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Twice()
+
+			mockConfig.EXPECT().
+				RecoverSessionWorkspace().
+				Return(tc.includeWorkspace).
+				Twice()
+
+			mockApplicationDirectoryFactory.EXPECT().
+				Directory().
+				Return(mockDirectory, nil).
+				Once()
+
+			mockDirectory.EXPECT().
+				CreateSubDir("matlab-session-state-").
+				Return(stateDir, nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+				Run(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) {
+					checkpointCode = request.Code
+				}).
+				Return(entities.EvalResponse{}, nil).
+				Twice()
+
+			recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+			require.NoError(t, recorder.Checkpoint(ctx, mockLogger, mockClient))
+			require.NoError(t, recorder.Checkpoint(ctx, mockLogger, mockClient))
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+				Run(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) {
+					restoreCode = request.Code
+				}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			// Act
+			restored, err := recorder.Restore(ctx, mockLogger, mockClient)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, restored)
+
+			assert.Contains(t, checkpointCode, "struct('folder', pwd, 'path', path)")
+			assert.NotContains(t, checkpointCode, "mcpSessionState =", "Checkpoint should not assign variables in the base workspace")
+			assert.NotContains(t, checkpointCode, "clear ", "Checkpoint should not clear variables in the base workspace")
+			assert.Contains(t, checkpointCode, "movefile(")
+			assert.Contains(t, checkpointCode, stateFile)
+			assert.Contains(t, restoreCode, "load('"+stateFile+"')")
+			assert.Contains(t, restoreCode, "addpath(")
+
+			if tc.includeWorkspace {
+				assert.Contains(t, checkpointCode, "save('"+filepath.Join(stateDir, "workspace.tmp.mat")+"');")
+				assert.Contains(t, restoreCode, "load('"+workspaceFile+"')")
+			} else {
+				assert.NotContains(t, checkpointCode, workspaceFile)
+				assert.NotContains(t, restoreCode, workspaceFile)
+			}
+		})
+	}
+}
+
+func TestRecorder_Checkpoint_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		RecoverSessionWorkspace().
+		Return(false).
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-state-").
+		Return(filepath.Join("tmp", "matlab-session-state-123"), nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+	// Act
+	err := recorder.Checkpoint(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+
+	restored, restoreErr := recorder.Restore(ctx, mockLogger, mockClient)
+	require.NoError(t, restoreErr)
+	assert.False(t, restored)
+}
+
+func TestRecorder_Checkpoint_CreateSubDirError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-state-").
+		Return("", expectedError).
+		Once()
+
+	recorder := sessionstate.New(mockConfigFactory, mockLoggerFactory, mockApplicationDirectoryFactory, mockLifecycleSignaler)
+
+	// Act
+	err := recorder.Checkpoint(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -16,6 +16,13 @@ type HttpServer interface {
 	Shutdown(ctx context.Context) error
 }
 
+type HttpServerOverTCP interface {
+	Listen(address string) error
+	Serve() error
+	ServeTLS(certFile string, keyFile string) error
+	Shutdown(ctx context.Context) error
+}
+
 type Factory struct {
 	osLayer OSLayer
 }
@@ -29,3 +36,7 @@ func NewFactory(osLayer OSLayer) *Factory {
 func (f *Factory) NewServerOverUDS(handlers map[string]http.HandlerFunc) (HttpServer, error) {
 	return newUDSServer(f.osLayer, handlers), nil
 }
+
+func (f *Factory) NewServerOverTCP(handler http.Handler) (HttpServerOverTCP, error) {
+	return newTCPServer(handler), nil
+}
@@ -40,3 +40,20 @@ func TestFactory_NewServerOverUDS_HappyPath(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, httpServer)
 }
+
+func TestFactory_NewServerOverTCP_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &servermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	handler := http.NewServeMux()
+
+	factory := server.NewFactory(mockOSLayer)
+
+	// Act
+	httpServer, err := factory.NewServerOverTCP(handler)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, httpServer)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+type tcpServer struct {
+	httpServer *http.Server
+	listener   net.Listener
+}
+
+func newTCPServer(handler http.Handler) *tcpServer {
+	return &tcpServer{
+		httpServer: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: defaultReadHeaderTimeout,
+		},
+	}
+}
+
+// Listen binds the address, so that failures such as a port already in use are reported before serving.
+func (s *tcpServer) Listen(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	return nil
+}
+
+func (s *tcpServer) Serve() error {
+	if err := s.httpServer.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *tcpServer) ServeTLS(certFile string, keyFile string) error {
+	if err := s.httpServer.ServeTLS(s.listener, certFile, keyFile); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *tcpServer) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import "net/http"
+
+func NewTCPServer(handler http.Handler) *tcpServer {
+	return newTCPServer(handler)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTCPServer_Serve_Shutdown_HappyPath(t *testing.T) {
+	// Arrange
+	expectedBody := "hello"
+	address := freeTCPAddress(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(expectedBody))
+	})
+
+	tcpServer := server.NewTCPServer(handler)
+	require.NoError(t, tcpServer.Listen(address))
+
+	errC := make(chan error)
+	go func() {
+		errC <- tcpServer.Serve()
+	}()
+
+	// Act
+	var response *http.Response
+	require.Eventually(t, func() bool {
+		request, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+address, nil)
+		require.NoError(t, err)
+		response, err = http.DefaultClient.Do(request)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	defer func() { _ = response.Body.Close() }()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	err = tcpServer.Shutdown(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, <-errC)
+	assert.Equal(t, expectedBody, string(body))
+}
+
+func TestTCPServer_Serve_ShutdownBeforeServe(t *testing.T) {
+	// Arrange
+	tcpServer := server.NewTCPServer(http.NewServeMux())
+	require.NoError(t, tcpServer.Listen(freeTCPAddress(t)))
+
+	require.NoError(t, tcpServer.Shutdown(t.Context()))
+
+	// Act
+	err := tcpServer.Serve()
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestTCPServer_Listen_AddressInUse(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	tcpServer := server.NewTCPServer(http.NewServeMux())
+
+	// Act
+	err = tcpServer.Listen(listener.Addr().String())
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestTCPServer_ServeTLS_MissingCertificate(t *testing.T) {
+	// Arrange
+	tcpServer := server.NewTCPServer(http.NewServeMux())
+	require.NoError(t, tcpServer.Listen(freeTCPAddress(t)))
+
+	certFile := filepath.Join(t.TempDir(), "missing-cert.pem")
+	keyFile := filepath.Join(t.TempDir(), "missing-key.pem")
+
+	// Act
+	err := tcpServer.ServeTLS(certFile, keyFile)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func freeTCPAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	return listener.Addr().String()
+}
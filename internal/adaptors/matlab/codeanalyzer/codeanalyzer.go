@@ -8,33 +8,39 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/scriptcleanup"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
 )
 
 const (
 	codeIssuesMethodName    = "codeIssues"
 	checkCodeMethodName     = "checkcode"
 	minVersionForCodeIssues = "R2022b"
+	minVersionForFix        = "R2023a"
 )
 
 // matlabIssue represents a single issue from codeIssues function
 type matlabIssue struct {
-	Description string `json:"Description"`
-	LineStart   int    `json:"LineStart"`
-	ColumnStart int    `json:"ColumnStart"`
-	ColumnEnd   int    `json:"ColumnEnd"`
-	Severity    string `json:"Severity"`
-	Fixability  string `json:"Fixability"`
+	FullFilename string `json:"FullFilename"`
+	CheckID      string `json:"CheckID"`
+	Description  string `json:"Description"`
+	LineStart    int    `json:"LineStart"`
+	ColumnStart  int    `json:"ColumnStart"`
+	ColumnEnd    int    `json:"ColumnEnd"`
+	Severity     string `json:"Severity"`
+	Fixability   string `json:"Fixability"`
 }
 
 // matlabCodeIssuesResponse represents the full response from codeIssues function
 type matlabCodeIssuesResponse struct {
-	Date                      string        `json:"Date"`
-	Release                   string        `json:"Release"`
-	Files                     string        `json:"Files"`
-	CodeAnalyzerConfiguration string        `json:"CodeAnalyzerConfiguration"`
-	Issues                    []matlabIssue `json:"Issues"`
+	Date                      string                `json:"Date"`
+	Release                   string                `json:"Release"`
+	Files                     jsonList[string]      `json:"Files"`
+	CodeAnalyzerConfiguration string                `json:"CodeAnalyzerConfiguration"`
+	Issues                    jsonList[matlabIssue] `json:"Issues"`
 }
 
 // matlabCheckcodeItem represents a single item from checkcode function
@@ -45,6 +51,61 @@ type matlabCheckcodeItem struct {
 	Column  []int  `json:"column"`
 }
 
+// matlabCheckcodeFile represents the checkcode results for a single file, as emitted by checkcodeScript
+type matlabCheckcodeFile struct {
+	File   string                        `json:"file"`
+	Issues jsonList[matlabCheckcodeItem] `json:"issues"`
+}
+
+// jsonList decodes a JSON array, but also accepts a single value.
+// jsonencode emits scalar MATLAB arrays (such as a 1x1 struct array) as a bare value rather than a one-element array.
+type jsonList[T any] []T
+
+func (l *jsonList[T]) UnmarshalJSON(data []byte) error {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "[") {
+		return json.Unmarshal(data, (*[]T)(l))
+	}
+
+	if trimmed == "null" {
+		*l = nil
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*l = jsonList[T]{value}
+	return nil
+}
+
+// checkcodeScript expands folders into the .m files they contain and runs checkcode over every file at once.
+const checkcodeScript = `mcpCheckPaths = %s;
+mcpCheckFiles = {};
+for mcpCheckIndex = 1:numel(mcpCheckPaths)
+    if isfolder(mcpCheckPaths{mcpCheckIndex})
+        mcpCheckListing = dir(fullfile(mcpCheckPaths{mcpCheckIndex}, '**', '*.m'));
+        if ~isempty(mcpCheckListing)
+            mcpCheckFiles = [mcpCheckFiles, fullfile({mcpCheckListing.folder}, {mcpCheckListing.name})];
+        end
+    else
+        mcpCheckFiles = [mcpCheckFiles, mcpCheckPaths(mcpCheckIndex)];
+    end
+end
+mcpCheckResults = struct('file', {}, 'issues', {});
+if ~isempty(mcpCheckFiles)
+    mcpCheckInfo = checkcode(mcpCheckFiles%s);
+    if ~iscell(mcpCheckInfo)
+        mcpCheckInfo = {mcpCheckInfo};
+    end
+    mcpCheckResults = struct('file', mcpCheckFiles, 'issues', mcpCheckInfo);
+end
+disp(jsonencode(mcpCheckResults));`
+
+// checkcodeScriptVariables lists every variable checkcodeScript creates in the user's workspace, to clear them afterwards
+var checkcodeScriptVariables = []string{"mcpCheckPaths", "mcpCheckFiles", "mcpCheckIndex", "mcpCheckListing", "mcpCheckResults", "mcpCheckInfo"}
+
 // Analyzer provides MATLAB code analysis capabilities.
 type Analyzer struct{}
 
@@ -53,16 +114,105 @@ func New() *Analyzer {
 	return &Analyzer{}
 }
 
-// AnalyzeCode runs MATLAB code analysis on the given script and returns any issues found.
-func (a *Analyzer) AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string) ([]checkmatlabcode.CodeIssue, error) {
+// AnalyzeCode runs MATLAB code analysis once over all the given files and folders and returns any issues found.
+func (a *Analyzer) AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request checkmatlabcode.CodeAnalysisRequest) (checkmatlabcode.CodeAnalysis, error) {
 	method := selectCodeCheckMethod(ctx, logger, client)
 
-	jsonOutput, err := runCodeCheck(ctx, logger, client, method, scriptPath)
+	jsonOutput, err := runCodeCheck(ctx, logger, client, method, request)
+	if err != nil {
+		return checkmatlabcode.CodeAnalysis{}, err
+	}
+
+	analysis, err := parseAnalysisOutput(method, jsonOutput)
+	if err != nil {
+		return checkmatlabcode.CodeAnalysis{}, err
+	}
+
+	if analysis.Configuration == "" {
+		analysis.Configuration = request.ConfigurationFile
+	}
+
+	return analysis, nil
+}
+
+// matlabFixResponse represents the output of fixCodeScript
+type matlabFixResponse struct {
+	Fixed int `json:"fixed"`
+}
+
+// fixCodeScript applies the auto-fixable issues reported by codeIssues, optionally narrowed to a set of check IDs.
+// The issues are checked again afterwards, so that only the selected issues that are gone are counted as fixed.
+const fixCodeScript = `mcpFixSelect = @(mcpFixReport) mcpFixReport.Issues(mcpFixReport.Issues.Fixability == "auto"%s, :);
+mcpFixIssues = codeIssues('%s');
+mcpFixSelection = mcpFixSelect(mcpFixIssues);
+mcpFixFixed = 0;
+if height(mcpFixSelection) > 0
+    fix(mcpFixIssues, mcpFixSelection);
+    mcpFixFixed = max(height(mcpFixSelection) - height(mcpFixSelect(codeIssues('%s'))), 0);
+end
+disp(jsonencode(struct('fixed', mcpFixFixed)));`
+
+// fixCodeScriptVariables lists every variable fixCodeScript creates in the user's workspace, to clear them afterwards
+var fixCodeScriptVariables = []string{"mcpFixSelect", "mcpFixIssues", "mcpFixSelection", "mcpFixFixed"}
+
+// FixCode applies Code Analyzer fixes to the given script and returns the number of selected issues that are gone afterwards.
+// When checkIDs is empty, every auto-fixable issue is fixed.
+func (a *Analyzer) FixCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string, checkIDs []string) (int, error) {
+	isOlderVersion, err := isMATLABReleaseOlderThan(ctx, logger, client, minVersionForFix)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check whether MATLAB supports applying fixes: %w", err)
+	}
+
+	if isOlderVersion {
+		return 0, fixmatlabcode.ErrCodeFixUnsupported
+	}
+
+	checkIDFilter := ""
+	if len(checkIDs) > 0 {
+		quotedCheckIDs := make([]string, len(checkIDs))
+		for i, checkID := range checkIDs {
+			quotedCheckIDs[i] = `"` + strings.ReplaceAll(checkID, `"`, `""`) + `"`
+		}
+		checkIDFilter = fmt.Sprintf(" & ismember(string(mcpFixReport.Issues.CheckID), [%s])", strings.Join(quotedCheckIDs, ", "))
+	}
+
+	escapedScriptPath := matlabstring.EscapeSingleQuotes(scriptPath)
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code: scriptcleanup.ClearAfter(fmt.Sprintf(fixCodeScript, checkIDFilter, escapedScriptPath, escapedScriptPath), fixCodeScriptVariables...),
+	})
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return parseAnalysisOutput(method, jsonOutput)
+	var fixResponse matlabFixResponse
+	if err := unmarshalJSON(response.ConsoleOutput, &fixResponse, "fix"); err != nil {
+		return 0, err
+	}
+
+	return fixResponse.Fixed, nil
+}
+
+// isMATLABReleaseOlderThan reports whether the MATLAB session is older than the given release
+func isMATLABReleaseOlderThan(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, release string) (bool, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "isMATLABReleaseOlderThan",
+		Arguments:  []string{release},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if len(response.Outputs) == 0 {
+		return false, fmt.Errorf("MATLAB version check returned no outputs")
+	}
+
+	isOlderVersion, ok := response.Outputs[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("MATLAB version check returned non-bool")
+	}
+
+	return isOlderVersion, nil
 }
 
 // selectCodeCheckMethod determines which MATLAB function to use based on version
@@ -97,9 +247,27 @@ func selectCodeCheckMethod(ctx context.Context, logger entities.Logger, client e
 }
 
 // runCodeCheck executes the MATLAB code analysis and returns JSON output
-func runCodeCheck(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, method string, scriptPath string) (string, error) {
-	escapedPath := strings.ReplaceAll(scriptPath, "'", "''")
-	matlabExpression := fmt.Sprintf("disp(jsonencode(%s('%s')))", method, escapedPath)
+func runCodeCheck(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, method string, request checkmatlabcode.CodeAnalysisRequest) (string, error) {
+	quotedPaths := make([]string, len(request.Paths))
+	for i, path := range request.Paths {
+		quotedPaths[i] = "'" + matlabstring.EscapeSingleQuotes(path) + "'"
+	}
+	paths := "{" + strings.Join(quotedPaths, ", ") + "}"
+
+	var matlabExpression string
+	if method == codeIssuesMethodName {
+		configuration := ""
+		if request.ConfigurationFile != "" {
+			configuration = fmt.Sprintf(", 'CodeAnalyzerConfiguration', '%s'", matlabstring.EscapeSingleQuotes(request.ConfigurationFile))
+		}
+		matlabExpression = fmt.Sprintf("disp(jsonencode(%s(%s%s)))", method, paths, configuration)
+	} else {
+		configuration := ""
+		if request.ConfigurationFile != "" {
+			configuration = fmt.Sprintf(", '-config=%s'", matlabstring.EscapeSingleQuotes(request.ConfigurationFile))
+		}
+		matlabExpression = scriptcleanup.ClearAfter(fmt.Sprintf(checkcodeScript, paths, configuration), checkcodeScriptVariables...)
+	}
 
 	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
 		Code: matlabExpression,
@@ -111,7 +279,7 @@ func runCodeCheck(ctx context.Context, logger entities.Logger, client entities.M
 }
 
 // parseAnalysisOutput routes to the appropriate parser based on method
-func parseAnalysisOutput(method string, jsonOutput string) ([]checkmatlabcode.CodeIssue, error) {
+func parseAnalysisOutput(method string, jsonOutput string) (checkmatlabcode.CodeAnalysis, error) {
 	if method == codeIssuesMethodName {
 		return parseCodeIssuesResponse(jsonOutput)
 	}
@@ -127,15 +295,17 @@ func unmarshalJSON(jsonOutput string, target any, methodName string) error {
 }
 
 // parseCodeIssuesResponse processes output from the codeIssues function (R2022b+)
-func parseCodeIssuesResponse(jsonOutput string) ([]checkmatlabcode.CodeIssue, error) {
+func parseCodeIssuesResponse(jsonOutput string) (checkmatlabcode.CodeAnalysis, error) {
 	var response matlabCodeIssuesResponse
 	if err := unmarshalJSON(jsonOutput, &response, codeIssuesMethodName); err != nil {
-		return nil, err
+		return checkmatlabcode.CodeAnalysis{}, err
 	}
 
 	issues := make([]checkmatlabcode.CodeIssue, 0, len(response.Issues))
 	for _, matlabIssue := range response.Issues {
 		issue := checkmatlabcode.CodeIssue{
+			File:        matlabIssue.FullFilename,
+			CheckID:     matlabIssue.CheckID,
 			Description: matlabIssue.Description,
 			Line:        matlabIssue.LineStart,
 			StartColumn: matlabIssue.ColumnStart,
@@ -146,32 +316,42 @@ func parseCodeIssuesResponse(jsonOutput string) ([]checkmatlabcode.CodeIssue, er
 		issues = append(issues, issue)
 	}
 
-	return issues, nil
+	return checkmatlabcode.CodeAnalysis{
+		CodeIssues:        issues,
+		AnalyzedFileCount: len(response.Files),
+		Configuration:     response.CodeAnalyzerConfiguration,
+	}, nil
 }
 
-// parseCheckcodeResponse processes output from the checkcode function (legacy)
-func parseCheckcodeResponse(jsonOutput string) ([]checkmatlabcode.CodeIssue, error) {
-	var items []matlabCheckcodeItem
-	if err := unmarshalJSON(jsonOutput, &items, checkCodeMethodName); err != nil {
-		return nil, err
+// parseCheckcodeResponse processes output from checkcodeScript (legacy)
+func parseCheckcodeResponse(jsonOutput string) (checkmatlabcode.CodeAnalysis, error) {
+	var files jsonList[matlabCheckcodeFile]
+	if err := unmarshalJSON(jsonOutput, &files, checkCodeMethodName); err != nil {
+		return checkmatlabcode.CodeAnalysis{}, err
 	}
 
-	issues := make([]checkmatlabcode.CodeIssue, 0, len(items))
-	for _, item := range items {
-		startColumn, endColumn := extractColumnRange(item.Column)
-
-		issue := checkmatlabcode.CodeIssue{
-			Description: item.Message,
-			Line:        item.Line,
-			StartColumn: startColumn,
-			EndColumn:   endColumn,
-			Severity:    "unknown",
-			Fixable:     item.Fix == 1,
+	issues := []checkmatlabcode.CodeIssue{}
+	for _, file := range files {
+		for _, item := range file.Issues {
+			startColumn, endColumn := extractColumnRange(item.Column)
+
+			issue := checkmatlabcode.CodeIssue{
+				File:        file.File,
+				Description: item.Message,
+				Line:        item.Line,
+				StartColumn: startColumn,
+				EndColumn:   endColumn,
+				Severity:    "unknown",
+				Fixable:     item.Fix == 1,
+			}
+			issues = append(issues, issue)
 		}
-		issues = append(issues, issue)
 	}
 
-	return issues, nil
+	return checkmatlabcode.CodeAnalysis{
+		CodeIssues:        issues,
+		AnalyzedFileCount: len(files),
+	}, nil
 }
 
 func extractColumnRange(columns []int) (startColumn int, endColumn int) {
@@ -11,8 +11,10 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,12 +33,11 @@ func TestAnalyzer_AnalyzeCode_CheckcodeMethod(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
-	checkcodeJSON := `[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[1,10]}]`
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
+	checkcodeJSON := `[{"file":"script.m","issues":[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[1,10]}]}]`
 	expectedCodeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        "script.m",
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 1,
@@ -59,11 +60,11 @@ func TestAnalyzer_AnalyzeCode_CheckcodeMethod(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Equal(t, expectedCodeIssues, issues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
 }
 
 func TestAnalyzer_AnalyzeCode_CodeIssuesMethod(t *testing.T) {
@@ -82,11 +83,13 @@ func TestAnalyzer_AnalyzeCode_CodeIssuesMethod(t *testing.T) {
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{false}}
 	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(codeIssues('" + scriptPath + "')))",
+		Code: "disp(jsonencode(codeIssues({'" + scriptPath + "'})))",
 	}
-	codeIssuesJSON := `{"Date":"2025-01-15","Release":"R2024b","Files":"script.m","CodeAnalyzerConfiguration":"active","Issues":[{"Description":"Variable 'x' might be unused.","LineStart":5,"ColumnStart":1,"ColumnEnd":10,"Severity":"warning","Fixability":"auto"}]}`
+	codeIssuesJSON := `{"Date":"2025-01-15","Release":"R2024b","Files":"script.m","CodeAnalyzerConfiguration":"active","Issues":[{"FullFilename":"script.m","CheckID":"NASGU","Description":"Variable 'x' might be unused.","LineStart":5,"ColumnStart":1,"ColumnEnd":10,"Severity":"warning","Fixability":"auto"}]}`
 	expectedCodeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        "script.m",
+			CheckID:     "NASGU",
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 1,
@@ -109,11 +112,11 @@ func TestAnalyzer_AnalyzeCode_CodeIssuesMethod(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Equal(t, expectedCodeIssues, issues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
 }
 
 func TestAnalyzer_AnalyzeCode_EmptyOutput(t *testing.T) {
@@ -131,9 +134,7 @@ func TestAnalyzer_AnalyzeCode_EmptyOutput(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 	emptyCheckcodeJSON := `[]`
 
 	mockClient.EXPECT().
@@ -149,11 +150,11 @@ func TestAnalyzer_AnalyzeCode_EmptyOutput(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Empty(t, issues, "CodeIssues should be empty")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty")
 }
 
 func TestAnalyzer_AnalyzeCode_PathWithSingleQuotes(t *testing.T) {
@@ -172,12 +173,11 @@ func TestAnalyzer_AnalyzeCode_PathWithSingleQuotes(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + escapedPath + "')))",
-	}
-	checkcodeJSON := `[{"message":"Variable 'x' might be unused.","fix":1,"line":5,"column":[1,10]}]`
+	expectedEvalRequest := checkcodeScriptFor(escapedPath)
+	checkcodeJSON := `[{"file":"script.m","issues":[{"message":"Variable 'x' might be unused.","fix":1,"line":5,"column":[1,10]}]}]`
 	expectedCodeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        "script.m",
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 1,
@@ -200,11 +200,11 @@ func TestAnalyzer_AnalyzeCode_PathWithSingleQuotes(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Equal(t, expectedCodeIssues, issues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
 }
 
 func TestAnalyzer_AnalyzeCode_VersionCheckError_DefaultsToCheckcode(t *testing.T) {
@@ -222,9 +222,7 @@ func TestAnalyzer_AnalyzeCode_VersionCheckError_DefaultsToCheckcode(t *testing.T
 		Arguments:  []string{"R2022b"},
 		NumOutputs: 1,
 	}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 	checkcodeJSON := `[]`
 
 	mockClient.EXPECT().
@@ -240,11 +238,11 @@ func TestAnalyzer_AnalyzeCode_VersionCheckError_DefaultsToCheckcode(t *testing.T
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Empty(t, issues, "CodeIssues should be empty")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty")
 }
 
 func TestAnalyzer_AnalyzeCode_EvalError(t *testing.T) {
@@ -263,9 +261,7 @@ func TestAnalyzer_AnalyzeCode_EvalError(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 
 	mockClient.EXPECT().
 		FEval(t.Context(), mockLogger.AsMockArg(), expectedVersionCheckRequest).
@@ -280,11 +276,11 @@ func TestAnalyzer_AnalyzeCode_EvalError(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.Error(t, err, "AnalyzeCode should return an error")
-	assert.Empty(t, issues, "CodeIssues should be empty when there's an error")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty when there's an error")
 }
 
 func TestAnalyzer_AnalyzeCode_InvalidJSON(t *testing.T) {
@@ -302,9 +298,7 @@ func TestAnalyzer_AnalyzeCode_InvalidJSON(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 	invalidJSON := `{invalid json}`
 
 	mockClient.EXPECT().
@@ -320,11 +314,11 @@ func TestAnalyzer_AnalyzeCode_InvalidJSON(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.Error(t, err, "AnalyzeCode should return an error for invalid JSON")
-	assert.Empty(t, issues, "CodeIssues should be empty when there's an error")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty when there's an error")
 }
 
 func TestAnalyzer_AnalyzeCode_InvalidJSON_CodeIssuesMethod(t *testing.T) {
@@ -343,7 +337,7 @@ func TestAnalyzer_AnalyzeCode_InvalidJSON_CodeIssuesMethod(t *testing.T) {
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{false}}
 	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(codeIssues('" + scriptPath + "')))",
+		Code: "disp(jsonencode(codeIssues({'" + scriptPath + "'})))",
 	}
 	invalidJSON := `{invalid json}`
 
@@ -360,11 +354,11 @@ func TestAnalyzer_AnalyzeCode_InvalidJSON_CodeIssuesMethod(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.Error(t, err, "AnalyzeCode should return an error for invalid JSON")
-	assert.Empty(t, issues, "CodeIssues should be empty when there's an error")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty when there's an error")
 }
 
 func TestAnalyzer_AnalyzeCode_VersionCheckEmptyOutputs_DefaultsToCheckcode(t *testing.T) {
@@ -382,9 +376,7 @@ func TestAnalyzer_AnalyzeCode_VersionCheckEmptyOutputs_DefaultsToCheckcode(t *te
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 	checkcodeJSON := `[]`
 
 	mockClient.EXPECT().
@@ -400,11 +392,11 @@ func TestAnalyzer_AnalyzeCode_VersionCheckEmptyOutputs_DefaultsToCheckcode(t *te
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Empty(t, issues, "CodeIssues should be empty")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty")
 }
 
 func TestAnalyzer_AnalyzeCode_VersionCheckNonBool_DefaultsToCheckcode(t *testing.T) {
@@ -422,9 +414,7 @@ func TestAnalyzer_AnalyzeCode_VersionCheckNonBool_DefaultsToCheckcode(t *testing
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{"not a bool"}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
 	checkcodeJSON := `[]`
 
 	mockClient.EXPECT().
@@ -440,11 +430,11 @@ func TestAnalyzer_AnalyzeCode_VersionCheckNonBool_DefaultsToCheckcode(t *testing
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Empty(t, issues, "CodeIssues should be empty")
+	assert.Empty(t, analysis.CodeIssues, "CodeIssues should be empty")
 }
 
 func TestAnalyzer_AnalyzeCode_CheckcodeWithSingleColumnElement(t *testing.T) {
@@ -462,12 +452,11 @@ func TestAnalyzer_AnalyzeCode_CheckcodeWithSingleColumnElement(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
-	checkcodeJSON := `[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[7]}]`
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
+	checkcodeJSON := `[{"file":"script.m","issues":[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[7]}]}]`
 	expectedCodeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        "script.m",
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 7,
@@ -490,11 +479,11 @@ func TestAnalyzer_AnalyzeCode_CheckcodeWithSingleColumnElement(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Equal(t, expectedCodeIssues, issues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
 }
 
 func TestAnalyzer_AnalyzeCode_CheckcodeWithEmptyColumnArray(t *testing.T) {
@@ -512,12 +501,11 @@ func TestAnalyzer_AnalyzeCode_CheckcodeWithEmptyColumnArray(t *testing.T) {
 		NumOutputs: 1,
 	}
 	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "disp(jsonencode(checkcode('" + scriptPath + "')))",
-	}
-	checkcodeJSON := `[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[]}]`
+	expectedEvalRequest := checkcodeScriptFor(scriptPath)
+	checkcodeJSON := `[{"file":"script.m","issues":[{"message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[]}]}]`
 	expectedCodeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        "script.m",
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 1,
@@ -540,9 +528,354 @@ func TestAnalyzer_AnalyzeCode_CheckcodeWithEmptyColumnArray(t *testing.T) {
 	analyzer := codeanalyzer.New()
 
 	// Act
-	issues, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, scriptPath)
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
+
+	// Assert
+	require.NoError(t, err, "AnalyzeCode should not return an error")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
+}
+
+func TestAnalyzer_AnalyzeCode_CodeIssuesMethod_MultiplePathsAndConfiguration(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("validated", "path", "to", "script.m")
+	folderPath := filepath.Join("validated", "path", "to", "src")
+	configurationFile := filepath.Join("validated", "path", "to", "codeAnalyzerConfiguration.json")
+
+	versionCheckResponse := entities.FEvalResponse{Outputs: []any{false}}
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "disp(jsonencode(codeIssues({'" + scriptPath + "', '" + folderPath + "'}, 'CodeAnalyzerConfiguration', '" + configurationFile + "')))",
+	}
+	codeIssuesJSON := `{"Files":["script.m","helper.m","other.m"],"CodeAnalyzerConfiguration":"custom.json","Issues":[` +
+		`{"FullFilename":"script.m","CheckID":"NOPRT","Description":"Terminate statement with semicolon.","LineStart":1,"ColumnStart":3,"ColumnEnd":3,"Severity":"warning","Fixability":"auto"},` +
+		`{"FullFilename":"helper.m","CheckID":"NASGU","Description":"Variable 'y' might be unused.","LineStart":4,"ColumnStart":1,"ColumnEnd":1,"Severity":"info","Fixability":"manual"}]}`
+	expectedAnalysis := checkmatlabcode.CodeAnalysis{
+		CodeIssues: []checkmatlabcode.CodeIssue{
+			{File: "script.m", CheckID: "NOPRT", Description: "Terminate statement with semicolon.", Line: 1, StartColumn: 3, EndColumn: 3, Severity: "warning", Fixable: true},
+			{File: "helper.m", CheckID: "NASGU", Description: "Variable 'y' might be unused.", Line: 4, StartColumn: 1, EndColumn: 1, Severity: "info", Fixable: false},
+		},
+		AnalyzedFileCount: 3,
+		Configuration:     "custom.json",
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(versionCheckResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: codeIssuesJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{
+		Paths:             []string{scriptPath, folderPath},
+		ConfigurationFile: configurationFile,
+	})
+
+	// Assert
+	require.NoError(t, err, "AnalyzeCode should not return an error")
+	assert.Equal(t, expectedAnalysis, analysis, "Analysis should match expected value")
+}
+
+func TestAnalyzer_AnalyzeCode_CheckcodeMethod_MultipleFiles(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	folderPath := filepath.Join("validated", "path", "to", "src")
+	configurationFile := filepath.Join("validated", "path", "to", "settings.txt")
+
+	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
+	// jsonencode emits a file with a single issue as an object rather than a one-element array
+	checkcodeJSON := `[{"file":"a.m","issues":{"message":"First.","fix":0,"line":2,"column":[1,4]}},{"file":"b.m","issues":[]},{"file":"c.m","issues":[{"message":"Second.","fix":1,"line":3,"column":[5,6]},{"message":"Third.","fix":0,"line":9,"column":[2]}]}]`
+	expectedAnalysis := checkmatlabcode.CodeAnalysis{
+		CodeIssues: []checkmatlabcode.CodeIssue{
+			{File: "a.m", Description: "First.", Line: 2, StartColumn: 1, EndColumn: 4, Severity: "unknown", Fixable: false},
+			{File: "c.m", Description: "Second.", Line: 3, StartColumn: 5, EndColumn: 6, Severity: "unknown", Fixable: true},
+			{File: "c.m", Description: "Third.", Line: 9, StartColumn: 2, EndColumn: 2, Severity: "unknown", Fixable: false},
+		},
+		AnalyzedFileCount: 3,
+		Configuration:     configurationFile,
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(versionCheckResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.Contains(request.Code, "mcpCheckPaths = {'"+folderPath+"'};") &&
+				strings.Contains(request.Code, "checkcode(mcpCheckFiles, '-config="+configurationFile+"')")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: checkcodeJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{
+		Paths:             []string{folderPath},
+		ConfigurationFile: configurationFile,
+	})
 
 	// Assert
 	require.NoError(t, err, "AnalyzeCode should not return an error")
-	assert.Equal(t, expectedCodeIssues, issues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedAnalysis, analysis, "Analysis should match expected value")
+}
+
+func TestAnalyzer_AnalyzeCode_CheckcodeMethod_SingleFileResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("validated", "path", "to", "script.m")
+
+	versionCheckResponse := entities.FEvalResponse{Outputs: []any{true}}
+	// jsonencode emits a 1x1 struct array as an object rather than a one-element array
+	checkcodeJSON := `{"file":"script.m","issues":[{"message":"Only.","fix":0,"line":1,"column":[1,2]}]}`
+	expectedCodeIssues := []checkmatlabcode.CodeIssue{
+		{File: "script.m", Description: "Only.", Line: 1, StartColumn: 1, EndColumn: 2, Severity: "unknown", Fixable: false},
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(versionCheckResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), checkcodeScriptFor(scriptPath)).
+		Return(entities.EvalResponse{ConsoleOutput: checkcodeJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	analysis, err := analyzer.AnalyzeCode(t.Context(), mockLogger, mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{scriptPath}})
+
+	// Assert
+	require.NoError(t, err, "AnalyzeCode should not return an error")
+	assert.Equal(t, expectedCodeIssues, analysis.CodeIssues, "CodeIssues should match expected value")
+	assert.Equal(t, 1, analysis.AnalyzedFileCount, "AnalyzedFileCount should match expected value")
+}
+
+func TestAnalyzer_FixCode_AllFixableIssues(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("validated", "path", "to", "script.m")
+
+	expectedVersionCheckRequest := entities.FEvalRequest{
+		Function:   "isMATLABReleaseOlderThan",
+		Arguments:  []string{"R2023a"},
+		NumOutputs: 1,
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), expectedVersionCheckRequest).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.Contains(request.Code, "mcpFixIssues = codeIssues('"+scriptPath+"');\n") &&
+				strings.Contains(request.Code, "fix(mcpFixIssues, mcpFixSelection);") &&
+				strings.Contains(request.Code, "height(mcpFixSelect(codeIssues('"+scriptPath+"')))") &&
+				strings.Contains(request.Code, "catch mcpScriptError\n") &&
+				strings.HasSuffix(request.Code, "\nclear mcpFixSelect mcpFixIssues mcpFixSelection mcpFixFixed") &&
+				!strings.Contains(request.Code, "ismember")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: `{"fixed":3}`}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, scriptPath, nil)
+
+	// Assert
+	require.NoError(t, err, "FixCode should not return an error")
+	assert.Equal(t, 3, fixedIssueCount, "Fixed issue count should match expected value")
+}
+
+func TestAnalyzer_FixCode_SelectedCheckIDs(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("validated", "path", "O'Brien", "script.m")
+	expectedEscapedPath := filepath.Join("validated", "path", "O''Brien", "script.m")
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.Contains(request.Code, "mcpFixIssues = codeIssues('"+expectedEscapedPath+"');\n") &&
+				strings.Contains(request.Code, `mcpFixReport.Issues.Fixability == "auto" & ismember(string(mcpFixReport.Issues.CheckID), ["NOPRT", "A""B"])`)
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: `{"fixed":1}`}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, scriptPath, []string{"NOPRT", `A"B`})
+
+	// Assert
+	require.NoError(t, err, "FixCode should not return an error")
+	assert.Equal(t, 1, fixedIssueCount, "Fixed issue count should match expected value")
+}
+
+func TestAnalyzer_FixCode_OlderRelease(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{Outputs: []any{true}}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, "script.m", nil)
+
+	// Assert
+	require.ErrorIs(t, err, fixmatlabcode.ErrCodeFixUnsupported)
+	assert.Zero(t, fixedIssueCount)
+}
+
+func TestAnalyzer_FixCode_VersionCheckError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{}, expectedError).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, "script.m", nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Zero(t, fixedIssueCount)
+}
+
+func TestAnalyzer_FixCode_VersionCheckNonBool(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{Outputs: []any{"false"}}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, "script.m", nil)
+
+	// Assert
+	require.ErrorContains(t, err, "MATLAB version check returned non-bool")
+	assert.Zero(t, fixedIssueCount)
+}
+
+func TestAnalyzer_FixCode_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, "script.m", nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Zero(t, fixedIssueCount)
+}
+
+func TestAnalyzer_FixCode_InvalidJSON(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{ConsoleOutput: "Error using fix"}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	fixedIssueCount, err := analyzer.FixCode(t.Context(), mockLogger, mockClient, "script.m", nil)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse fix output")
+	assert.Zero(t, fixedIssueCount)
+}
+
+// checkcodeScriptFor matches the legacy checkcode script for a single path
+func checkcodeScriptFor(escapedPath string) any {
+	return mock.MatchedBy(func(request entities.EvalRequest) bool {
+		return strings.Contains(request.Code, "mcpCheckPaths = {'"+escapedPath+"'};") &&
+			strings.Contains(request.Code, "checkcode(mcpCheckFiles)") &&
+			strings.Contains(request.Code, "catch mcpScriptError\n") &&
+			strings.HasSuffix(request.Code, "\nclear mcpCheckPaths mcpCheckFiles mcpCheckIndex mcpCheckListing mcpCheckResults mcpCheckInfo")
+	})
 }
@@ -0,0 +1,32 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package scriptcleanup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// errorVariable holds the error raised by the script while it is reported
+const errorVariable = "mcpScriptError"
+
+// ClearAfter returns code that runs script in the user's workspace, and then clears the given variables from it,
+// whether or not script raised an error. Only the listed variables are cleared, so that the user's variables are kept.
+// An error is printed to the error stream rather than raised again, as raising it would leave it in the workspace.
+func ClearAfter(script string, variables ...string) string {
+	var code strings.Builder
+
+	code.WriteString("try\n")
+	code.WriteString(script)
+	code.WriteString("\n")
+	fmt.Fprintf(&code, "catch %s\n", errorVariable)
+	fmt.Fprintf(&code, "    fprintf(2, '%%s\\n', getReport(%s));\n", errorVariable)
+	fmt.Fprintf(&code, "    clear %s\n", errorVariable)
+	code.WriteString("end\n")
+
+	if len(variables) > 0 {
+		fmt.Fprintf(&code, "clear %s", strings.Join(variables, " "))
+	}
+
+	return code.String()
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package scriptcleanup_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/scriptcleanup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClearAfter_HappyPath(t *testing.T) {
+	// Arrange
+	script := "mcpA = 1;\ndisp(mcpA + mcpB);"
+	expectedCode := "try\n" +
+		"mcpA = 1;\ndisp(mcpA + mcpB);\n" +
+		"catch mcpScriptError\n" +
+		"    fprintf(2, '%s\\n', getReport(mcpScriptError));\n" +
+		"    clear mcpScriptError\n" +
+		"end\n" +
+		"clear mcpA mcpB"
+
+	// Act
+	code := scriptcleanup.ClearAfter(script, "mcpA", "mcpB")
+
+	// Assert
+	assert.Equal(t, expectedCode, code)
+}
+
+func TestClearAfter_NoVariables(t *testing.T) {
+	// Arrange
+	script := "disp(1);"
+
+	// Act
+	code := scriptcleanup.ClearAfter(script)
+
+	// Assert
+	assert.True(t, strings.HasSuffix(code, "    clear mcpScriptError\nend\n"), "Only the reported error should be cleared")
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package testrunner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/scriptcleanup"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
+)
+
+// resultsMarker separates the console output of the test run from the JSON encoded results
+const resultsMarker = "<<matlab-mcp-test-results>>"
+
+// collectResultsScript encodes one JSON object per matlab.unittest.TestResult, and one per covered file when
+// code coverage was measured
+const collectResultsScript = `mcpTestSummary = cell(1, numel(mcpTestResults));
+for mcpTestIndex = 1:numel(mcpTestResults)
+    mcpTestResult = mcpTestResults(mcpTestIndex);
+    mcpTestStatus = 'passed';
+    if mcpTestResult.Failed
+        mcpTestStatus = 'failed';
+    elseif mcpTestResult.Incomplete
+        mcpTestStatus = 'incomplete';
+    end
+    mcpTestDiagnostics = {};
+    if isfield(mcpTestResult.Details, 'DiagnosticRecord')
+        for mcpTestRecord = reshape(mcpTestResult.Details.DiagnosticRecord, 1, [])
+            mcpTestFile = '';
+            mcpTestLine = 0;
+            if ~isempty(mcpTestRecord.Stack)
+                mcpTestFile = mcpTestRecord.Stack(1).file;
+                mcpTestLine = mcpTestRecord.Stack(1).line;
+            end
+            mcpTestDiagnostics{end+1} = struct('event', mcpTestRecord.Event, 'report', mcpTestRecord.Report, 'file', mcpTestFile, 'line', mcpTestLine); %#ok<AGROW>
+        end
+    end
+    mcpTestSummary{mcpTestIndex} = struct('name', mcpTestResult.Name, 'status', mcpTestStatus, 'duration', mcpTestResult.Duration, 'diagnostics', {mcpTestDiagnostics});
+end
+mcpTestCoverageSummary = {};
+if ~isempty(mcpTestCoverage)
+    for mcpTestFileCoverage = reshape(mcpTestCoverage.Result, 1, [])
+        mcpTestCoverageSummary{end+1} = struct('file', mcpTestFileCoverage.Filename, 'statements', coverageSummary(mcpTestFileCoverage, 'statement'), 'functions', coverageSummary(mcpTestFileCoverage, 'function')); %#ok<AGROW>
+    end
+end
+disp('` + resultsMarker + `');
+disp(jsonencode(struct('tests', {mcpTestSummary}, 'coverage', {mcpTestCoverageSummary})));`
+
+// scriptVariables lists every variable the test run script creates in the user's workspace, to clear them afterwards
+var scriptVariables = []string{
+	"mcpTestSuite", "mcpTestRunner", "mcpTestCoverage", "mcpTestResults",
+	"mcpTestSummary", "mcpTestIndex", "mcpTestResult", "mcpTestStatus", "mcpTestDiagnostics",
+	"mcpTestRecord", "mcpTestFile", "mcpTestLine", "mcpTestCoverageSummary", "mcpTestFileCoverage",
+}
+
+// matlabTestDiagnostic represents a single diagnostic record, as encoded by collectResultsScript
+type matlabTestDiagnostic struct {
+	Event  string `json:"event"`
+	Report string `json:"report"`
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+}
+
+// matlabTestResult represents a single test result, as encoded by collectResultsScript
+type matlabTestResult struct {
+	Name        string                 `json:"name"`
+	Status      string                 `json:"status"`
+	Duration    float64                `json:"duration"`
+	Diagnostics []matlabTestDiagnostic `json:"diagnostics"`
+}
+
+// matlabFileCoverage represents the coverage of a single file, as encoded by collectResultsScript.
+// Statements and Functions hold the [executed, total] pair returned by coverageSummary.
+type matlabFileCoverage struct {
+	File       string `json:"file"`
+	Statements []int  `json:"statements"`
+	Functions  []int  `json:"functions"`
+}
+
+// matlabTestRunResponse represents the full output of collectResultsScript
+type matlabTestRunResponse struct {
+	Tests    []matlabTestResult   `json:"tests"`
+	Coverage []matlabFileCoverage `json:"coverage"`
+}
+
+// Runner runs MATLAB tests and collects their results.
+type Runner struct{}
+
+// New creates a new Runner instance.
+func New() *Runner {
+	return &Runner{}
+}
+
+// RunTests runs the requested tests with a matlab.unittest.TestRunner and returns the result of each test.
+func (r *Runner) RunTests(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.TestRunRequest) (runmatlabtestfile.TestRun, error) {
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code:    buildRunTestsScript(request),
+		Timeout: request.Timeout,
+	})
+	if err != nil {
+		return runmatlabtestfile.TestRun{}, err
+	}
+
+	return parseTestRunOutput(response.ConsoleOutput)
+}
+
+// buildRunTestsScript creates the suite, applies the selection, configures the runner and its plugins,
+// and runs the suite, before handing over to collectResultsScript.
+// The variables of the script are cleared afterwards, even when it fails.
+func buildRunTestsScript(request runmatlabtestfile.TestRunRequest) string {
+	var script strings.Builder
+
+	switch {
+	case request.TestFolder != "":
+		fmt.Fprintf(&script, "mcpTestSuite = testsuite(%s, 'IncludeSubfolders', true);\n", quote(request.TestFolder))
+	case request.TestSuite != "":
+		fmt.Fprintf(&script, "mcpTestSuite = testsuite(%s, 'IncludeSubpackages', true);\n", quote(request.TestSuite))
+	default:
+		fmt.Fprintf(&script, "mcpTestSuite = testsuite(%s);\n", quote(request.TestFile))
+	}
+
+	if len(request.ProcedureNames) > 0 {
+		selectors := make([]string, len(request.ProcedureNames))
+		for i, procedureName := range request.ProcedureNames {
+			selectors[i] = fmt.Sprintf("matlab.unittest.selectors.HasProcedureName(matlab.unittest.constraints.Matches(%s))", quote(globToRegexp(procedureName)))
+		}
+		fmt.Fprintf(&script, "mcpTestSuite = mcpTestSuite.selectIf(%s);\n", strings.Join(selectors, " | "))
+	}
+
+	if len(request.IncludeTags) > 0 {
+		fmt.Fprintf(&script, "mcpTestSuite = mcpTestSuite.selectIf(%s);\n", hasAnyTag(request.IncludeTags))
+	}
+
+	if len(request.ExcludeTags) > 0 {
+		fmt.Fprintf(&script, "mcpTestSuite = mcpTestSuite.selectIf(~(%s));\n", hasAnyTag(request.ExcludeTags))
+	}
+
+	script.WriteString("mcpTestRunner = matlab.unittest.TestRunner.withTextOutput;\n")
+	script.WriteString("mcpTestRunner.addPlugin(matlab.unittest.plugins.DiagnosticsRecordingPlugin);\n")
+
+	if request.Strict {
+		script.WriteString("mcpTestRunner.addPlugin(matlab.unittest.plugins.FailOnWarningsPlugin);\n")
+	}
+
+	for _, reportPath := range []string{request.JUnitReportPath, request.CoberturaReportPath} {
+		if reportPath != "" {
+			fmt.Fprintf(&script, "[~, ~] = mkdir(fileparts(%s));\n", quote(reportPath))
+		}
+	}
+
+	if len(request.CoverageFolders) == 0 {
+		script.WriteString("mcpTestCoverage = [];\n")
+	} else {
+		formats := "mcpTestCoverage"
+		if request.CoberturaReportPath != "" {
+			formats += fmt.Sprintf(", matlab.unittest.plugins.codecoverage.CoberturaFormat(%s)", quote(request.CoberturaReportPath))
+		}
+
+		script.WriteString("mcpTestCoverage = matlab.unittest.plugins.codecoverage.CoverageResult;\n")
+		fmt.Fprintf(&script, "mcpTestRunner.addPlugin(matlab.unittest.plugins.CodeCoveragePlugin.forFolder(%s, 'IncludingSubfolders', true, 'Producing', [%s]));\n", cellArray(request.CoverageFolders), formats)
+	}
+
+	if request.JUnitReportPath != "" {
+		fmt.Fprintf(&script, "mcpTestRunner.addPlugin(matlab.unittest.plugins.XMLPlugin.producingJUnitFormat(%s));\n", quote(request.JUnitReportPath))
+	}
+
+	if request.UseParallel {
+		script.WriteString("mcpTestResults = mcpTestRunner.runInParallel(mcpTestSuite);\n")
+	} else {
+		script.WriteString("mcpTestResults = mcpTestRunner.run(mcpTestSuite);\n")
+	}
+
+	script.WriteString(collectResultsScript)
+
+	return scriptcleanup.ClearAfter(script.String(), scriptVariables...)
+}
+
+// quote returns value as a MATLAB character vector literal
+func quote(value string) string {
+	return "'" + matlabstring.EscapeSingleQuotes(value) + "'"
+}
+
+func cellArray(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quote(value)
+	}
+	return "{" + strings.Join(quoted, ", ") + "}"
+}
+
+func hasAnyTag(tags []string) string {
+	selectors := make([]string, len(tags))
+	for i, tag := range tags {
+		selectors[i] = fmt.Sprintf("matlab.unittest.selectors.HasTag(%s)", quote(tag))
+	}
+	return strings.Join(selectors, " | ")
+}
+
+// globToRegexp converts a glob, where * matches any sequence of characters and ? matches a single character,
+// into an anchored regular expression that MATLAB's Matches constraint understands
+func globToRegexp(glob string) string {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+	return "^" + pattern + "$"
+}
+
+// parseTestRunOutput splits the console output at resultsMarker and decodes the results that follow it
+func parseTestRunOutput(output string) (runmatlabtestfile.TestRun, error) {
+	markerIndex := strings.LastIndex(output, resultsMarker)
+	if markerIndex < 0 {
+		// The run raised an error before any test ran, e.g. because the file does not contain tests.
+		return runmatlabtestfile.TestRun{}, fmt.Errorf("MATLAB did not return any test results:\n%s", output)
+	}
+
+	consoleOutput := strings.TrimSpace(output[:markerIndex])
+	jsonOutput := strings.TrimSpace(output[markerIndex+len(resultsMarker):])
+
+	var response matlabTestRunResponse
+	if err := json.Unmarshal([]byte(jsonOutput), &response); err != nil {
+		return runmatlabtestfile.TestRun{}, fmt.Errorf("failed to parse test results: %w", err)
+	}
+
+	tests := make([]runmatlabtestfile.TestResult, 0, len(response.Tests))
+	for _, matlabResult := range response.Tests {
+		diagnostics := make([]runmatlabtestfile.TestDiagnostic, 0, len(matlabResult.Diagnostics))
+		for _, matlabDiagnostic := range matlabResult.Diagnostics {
+			diagnostics = append(diagnostics, runmatlabtestfile.TestDiagnostic(matlabDiagnostic))
+		}
+
+		tests = append(tests, runmatlabtestfile.TestResult{
+			Name:        matlabResult.Name,
+			Status:      runmatlabtestfile.TestStatus(matlabResult.Status),
+			Duration:    secondsToDuration(matlabResult.Duration),
+			Diagnostics: diagnostics,
+		})
+	}
+
+	coverage := make([]runmatlabtestfile.FileCoverage, 0, len(response.Coverage))
+	for _, matlabCoverage := range response.Coverage {
+		coveredStatements, totalStatements := extractCoverageCounts(matlabCoverage.Statements)
+		coveredFunctions, totalFunctions := extractCoverageCounts(matlabCoverage.Functions)
+
+		coverage = append(coverage, runmatlabtestfile.FileCoverage{
+			File:              matlabCoverage.File,
+			CoveredStatements: coveredStatements,
+			TotalStatements:   totalStatements,
+			CoveredFunctions:  coveredFunctions,
+			TotalFunctions:    totalFunctions,
+		})
+	}
+
+	return runmatlabtestfile.TestRun{
+		Tests:         tests,
+		Coverage:      coverage,
+		ConsoleOutput: consoleOutput,
+	}, nil
+}
+
+func extractCoverageCounts(counts []int) (covered int, total int) {
+	if len(counts) != 2 {
+		return 0, 0
+	}
+	return counts[0], counts[1]
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package testrunner_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/testrunner"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const resultsMarker = "<<matlab-mcp-test-results>>"
+
+func TestRunner_RunTests_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	testPath := filepath.Join("validated", "path", "to", "testFile.m")
+	timeout := 5 * time.Minute
+
+	consoleOutput := "Running testFile\n.\n================================================================================\nVerification failed in testFile/testSubtraction.\n================================================================================\n.\nDone testFile"
+	resultsJSON := `{"tests":[` +
+		`{"name":"testFile/testAddition","status":"passed","duration":0.25,"diagnostics":[]},` +
+		`{"name":"testFile/testSubtraction","status":"failed","duration":1.5,"diagnostics":[{"event":"VerificationFailed","report":"Verification failed.","file":"/tests/testFile.m","line":12}]},` +
+		`{"name":"testFile/testSkipped","status":"incomplete","duration":0,"diagnostics":[{"event":"AssumptionFailed","report":"Assumption failed.","file":"","line":0}]}` +
+		`],"coverage":[]}`
+
+	expectedTestRun := runmatlabtestfile.TestRun{
+		Tests: []runmatlabtestfile.TestResult{
+			{
+				Name:        "testFile/testAddition",
+				Status:      runmatlabtestfile.TestStatusPassed,
+				Duration:    250 * time.Millisecond,
+				Diagnostics: []runmatlabtestfile.TestDiagnostic{},
+			},
+			{
+				Name:     "testFile/testSubtraction",
+				Status:   runmatlabtestfile.TestStatusFailed,
+				Duration: 1500 * time.Millisecond,
+				Diagnostics: []runmatlabtestfile.TestDiagnostic{
+					{Event: "VerificationFailed", Report: "Verification failed.", File: "/tests/testFile.m", Line: 12},
+				},
+			},
+			{
+				Name:     "testFile/testSkipped",
+				Status:   runmatlabtestfile.TestStatusIncomplete,
+				Duration: 0,
+				Diagnostics: []runmatlabtestfile.TestDiagnostic{
+					{Event: "AssumptionFailed", Report: "Assumption failed."},
+				},
+			},
+		},
+		Coverage:      []runmatlabtestfile.FileCoverage{},
+		ConsoleOutput: consoleOutput,
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.HasPrefix(request.Code, "try\nmcpTestSuite = testsuite('"+testPath+"');\n") &&
+				strings.Contains(request.Code, "mcpTestRunner = matlab.unittest.TestRunner.withTextOutput;\n") &&
+				strings.Contains(request.Code, "mcpTestCoverage = [];\n") &&
+				strings.Contains(request.Code, "mcpTestResults = mcpTestRunner.run(mcpTestSuite);\n") &&
+				!strings.Contains(request.Code, "selectIf") &&
+				!strings.Contains(request.Code, "FailOnWarningsPlugin") &&
+				!strings.Contains(request.Code, "XMLPlugin") &&
+				strings.Contains(request.Code, "catch mcpScriptError\n") &&
+				strings.HasSuffix(request.Code, "\nclear mcpTestSuite mcpTestRunner mcpTestCoverage mcpTestResults "+
+					"mcpTestSummary mcpTestIndex mcpTestResult mcpTestStatus mcpTestDiagnostics "+
+					"mcpTestRecord mcpTestFile mcpTestLine mcpTestCoverageSummary mcpTestFileCoverage") &&
+				request.Timeout == timeout
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: consoleOutput + "\n" + resultsMarker + "\n" + resultsJSON + "\n"}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{
+		TestFile: testPath,
+		Timeout:  timeout,
+	})
+
+	// Assert
+	require.NoError(t, err, "RunTests should not return an error")
+	assert.Equal(t, expectedTestRun, testRun, "TestRun should match expected value")
+}
+
+func TestRunner_RunTests_EscapesSingleQuotesInPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	testPath := filepath.Join("Users", "O'Brien", "testFile.m")
+	expectedEscapedPath := "Users" + string(filepath.Separator) + "O''Brien" + string(filepath.Separator) + "testFile.m"
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.HasPrefix(request.Code, "try\nmcpTestSuite = testsuite('"+expectedEscapedPath+"');\n")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: resultsMarker + "\n" + `{"tests":[],"coverage":[]}`}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{TestFile: testPath})
+
+	// Assert
+	require.NoError(t, err, "RunTests should not return an error")
+	assert.Empty(t, testRun.Tests, "Tests should be empty")
+	assert.Empty(t, testRun.ConsoleOutput, "Console output should be empty")
+}
+
+func TestRunner_RunTests_TestRunOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := runmatlabtestfile.TestRunRequest{
+		TestFolder:          "/project/tests",
+		ProcedureNames:      []string{"testAdd*", "test.Sub?"},
+		IncludeTags:         []string{"Unit", "Fast"},
+		ExcludeTags:         []string{"Slow"},
+		UseParallel:         true,
+		Strict:              true,
+		CoverageFolders:     []string{"/project/source", "/project/O'Brien"},
+		JUnitReportPath:     "/project/artifacts/junit.xml",
+		CoberturaReportPath: "/project/artifacts/coverage.xml",
+	}
+
+	expectedStatements := []string{
+		"mcpTestSuite = testsuite('/project/tests', 'IncludeSubfolders', true);\n",
+		"mcpTestSuite = mcpTestSuite.selectIf(matlab.unittest.selectors.HasProcedureName(matlab.unittest.constraints.Matches('^testAdd.*$')) | matlab.unittest.selectors.HasProcedureName(matlab.unittest.constraints.Matches('^test\\.Sub.$')));\n",
+		"mcpTestSuite = mcpTestSuite.selectIf(matlab.unittest.selectors.HasTag('Unit') | matlab.unittest.selectors.HasTag('Fast'));\n",
+		"mcpTestSuite = mcpTestSuite.selectIf(~(matlab.unittest.selectors.HasTag('Slow')));\n",
+		"mcpTestRunner.addPlugin(matlab.unittest.plugins.FailOnWarningsPlugin);\n",
+		"[~, ~] = mkdir(fileparts('/project/artifacts/junit.xml'));\n",
+		"mcpTestCoverage = matlab.unittest.plugins.codecoverage.CoverageResult;\n",
+		"mcpTestRunner.addPlugin(matlab.unittest.plugins.CodeCoveragePlugin.forFolder({'/project/source', '/project/O''Brien'}, 'IncludingSubfolders', true, 'Producing', [mcpTestCoverage, matlab.unittest.plugins.codecoverage.CoberturaFormat('/project/artifacts/coverage.xml')]));\n",
+		"mcpTestRunner.addPlugin(matlab.unittest.plugins.XMLPlugin.producingJUnitFormat('/project/artifacts/junit.xml'));\n",
+		"mcpTestResults = mcpTestRunner.runInParallel(mcpTestSuite);\n",
+	}
+
+	resultsJSON := `{"tests":[],"coverage":[` +
+		`{"file":"/project/source/add.m","statements":[3,4],"functions":[1,1]},` +
+		`{"file":"/project/source/script.m","statements":[0,2],"functions":[]}` +
+		`]}`
+
+	expectedCoverage := []runmatlabtestfile.FileCoverage{
+		{File: "/project/source/add.m", CoveredStatements: 3, TotalStatements: 4, CoveredFunctions: 1, TotalFunctions: 1},
+		{File: "/project/source/script.m", CoveredStatements: 0, TotalStatements: 2},
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(evalRequest entities.EvalRequest) bool {
+			for _, statement := range expectedStatements {
+				if !strings.Contains(evalRequest.Code, statement) {
+					return false
+				}
+			}
+			return !strings.Contains(evalRequest.Code, "mcpTestCoverage = [];")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: resultsMarker + "\n" + resultsJSON}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, request)
+
+	// Assert
+	require.NoError(t, err, "RunTests should not return an error")
+	assert.Equal(t, expectedCoverage, testRun.Coverage, "Coverage should match expected value")
+}
+
+func TestRunner_RunTests_TestSuite(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.HasPrefix(request.Code, "try\nmcpTestSuite = testsuite('mypackage.tests', 'IncludeSubpackages', true);\n")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: resultsMarker + "\n" + `{"tests":[],"coverage":[]}`}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	_, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{TestSuite: "mypackage.tests"})
+
+	// Assert
+	require.NoError(t, err, "RunTests should not return an error")
+}
+
+func TestRunner_RunTests_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{TestFile: "testFile.m"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, testRun, "TestRun should be empty")
+}
+
+func TestRunner_RunTests_NoResults(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const matlabError = "Error using runtests\nNo tests found in testFile.m."
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{ConsoleOutput: matlabError}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{TestFile: "testFile.m"})
+
+	// Assert
+	require.ErrorContains(t, err, matlabError)
+	assert.Empty(t, testRun, "TestRun should be empty")
+}
+
+func TestRunner_RunTests_InvalidJSON(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{ConsoleOutput: resultsMarker + "\nnot json"}, nil).
+		Once()
+
+	runner := testrunner.New()
+
+	// Act
+	testRun, err := runner.RunTests(t.Context(), mockLogger, mockClient, runmatlabtestfile.TestRunRequest{TestFile: "testFile.m"})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse test results")
+	assert.Empty(t, testRun, "TestRun should be empty")
+}
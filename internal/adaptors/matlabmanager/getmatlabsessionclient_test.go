@@ -41,6 +41,15 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 	defer mockSessionClient.AssertExpectations(t)
 
@@ -67,7 +76,7 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 		Return(entities.PingResponse{IsAlive: true}).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -100,6 +109,15 @@ func TestMATLABManager_GetMATLABSessionClient_Retries(t *testing.T) {
 		mockSessionSelector := &mocks.MockSessionSelector{}
 		defer mockSessionSelector.AssertExpectations(t)
 
+		mockSessionReaper := &mocks.MockSessionReaper{}
+		defer mockSessionReaper.AssertExpectations(t)
+
+		mockSessionPool := &mocks.MockSessionPool{}
+		defer mockSessionPool.AssertExpectations(t)
+
+		mockSessionLogReader := &mocks.MockSessionLogReader{}
+		defer mockSessionLogReader.AssertExpectations(t)
+
 		mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 		defer mockSessionClient.AssertExpectations(t)
 
@@ -133,7 +151,7 @@ func TestMATLABManager_GetMATLABSessionClient_Retries(t *testing.T) {
 			Return(entities.PingResponse{IsAlive: true}).
 			Once()
 
-		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 		manager.SetMATLABSessionConnectionRetryInterval(retryInterval)
 
 		// Act
@@ -168,6 +186,15 @@ func TestMATLABManager_GetMATLABSessionClient_RetryExhausted(t *testing.T) {
 		mockSessionSelector := &mocks.MockSessionSelector{}
 		defer mockSessionSelector.AssertExpectations(t)
 
+		mockSessionReaper := &mocks.MockSessionReaper{}
+		defer mockSessionReaper.AssertExpectations(t)
+
+		mockSessionPool := &mocks.MockSessionPool{}
+		defer mockSessionPool.AssertExpectations(t)
+
+		mockSessionLogReader := &mocks.MockSessionLogReader{}
+		defer mockSessionLogReader.AssertExpectations(t)
+
 		mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 		defer mockSessionClient.AssertExpectations(t)
 
@@ -196,7 +223,7 @@ func TestMATLABManager_GetMATLABSessionClient_RetryExhausted(t *testing.T) {
 			Return(entities.PingResponse{IsAlive: false}).
 			Twice()
 
-		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 		manager.SetMATLABSessionConnectionRetryInterval(retryInterval)
 
 		// Act
@@ -228,6 +255,15 @@ func TestMATLABManager_GetMATLABSessionClient_ConfigFactoryError(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	expectedSessionID := entities.SessionID(123)
 	ctx := t.Context()
 
@@ -236,7 +272,7 @@ func TestMATLABManager_GetMATLABSessionClient_ConfigFactoryError(t *testing.T) {
 		Return(nil, messages.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -268,6 +304,15 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	expectedSessionID := entities.SessionID(123)
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -282,7 +327,7 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
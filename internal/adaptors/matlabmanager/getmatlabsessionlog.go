@@ -0,0 +1,29 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+var ErrNoMATLABSessionLog = errors.New("the log is only available for MATLAB sessions started by this server, not for sessions that were attached to")
+
+func (m *MATLABManager) GetMATLABSessionLog(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID, maxLines int) (entities.MATLABSessionLog, error) {
+	logDirectory, err := m.sessionStore.GetLogDirectory(sessionID)
+	if err != nil {
+		return entities.MATLABSessionLog{}, err
+	}
+
+	if logDirectory == "" {
+		return entities.MATLABSessionLog{}, ErrNoMATLABSessionLog
+	}
+
+	sessionLogger.
+		With("log_dir", logDirectory).
+		Debug("Reading MATLAB session log")
+
+	return m.sessionLogReader.Read(logDirectory, maxLines)
+}
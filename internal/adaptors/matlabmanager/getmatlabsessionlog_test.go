@@ -0,0 +1,159 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_GetMATLABSessionLog_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	sessionID := entities.SessionID(3)
+	sessionDirectory := "/tmp/matlab-session-1234"
+	expectedLog := entities.MATLABSessionLog{
+		Stdout: "MATLAB is ready",
+	}
+
+	mockSessionStore.EXPECT().
+		GetLogDirectory(sessionID).
+		Return(sessionDirectory, nil).
+		Once()
+
+	mockSessionLogReader.EXPECT().
+		Read(sessionDirectory, 50).
+		Return(expectedLog, nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionLog, err := manager.GetMATLABSessionLog(t.Context(), mockLogger, sessionID, 50)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedLog, sessionLog)
+}
+
+func TestMATLABManager_GetMATLABSessionLog_AttachedSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	sessionID := entities.SessionID(3)
+
+	mockSessionStore.EXPECT().
+		GetLogDirectory(sessionID).
+		Return("", nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionLog, err := manager.GetMATLABSessionLog(t.Context(), mockLogger, sessionID, 50)
+
+	// Assert
+	require.ErrorIs(t, err, matlabmanager.ErrNoMATLABSessionLog)
+	assert.Empty(t, sessionLog)
+}
+
+func TestMATLABManager_GetMATLABSessionLog_SessionStoreError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	sessionID := entities.SessionID(3)
+	expectedError := assert.AnError
+
+	mockSessionStore.EXPECT().
+		GetLogDirectory(sessionID).
+		Return("", expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionLog, err := manager.GetMATLABSessionLog(t.Context(), mockLogger, sessionID, 50)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionLog)
+}
@@ -0,0 +1,368 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package healthmonitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+)
+
+const (
+	defaultPingInterval = 30 * time.Second
+
+	// unhealthyPingThreshold is the number of consecutive failed pings after which a session is considered unresponsive,
+	// or dead when its MATLAB process is gone.
+	unhealthyPingThreshold = 3
+
+	logTailLines = 20
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type SessionStore interface {
+	List() []matlabsessionstore.Session
+	MarkDead(sessionID entities.SessionID, diagnostics string, logDirectory string)
+}
+
+type SessionLogKeeper interface {
+	Keep(sessionDirectory string) (string, error)
+}
+
+type ClientNotifier interface {
+	NotifyClients(ctx context.Context, logger entities.Logger, level entities.LogLevel, data any)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type ProcessFinder interface {
+	FindProcess(processPid int) osfacade.Process
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Monitor notices MATLAB sessions that died without being stopped, either because their MATLAB process exited,
+// or because they stopped answering pings and their MATLAB process is gone. Dead sessions are marked as such in the session store,
+// with the exit code and the end of the MATLAB log for diagnostics, and connected MCP clients are notified.
+// The logs of dead sessions are kept, so that they can still be read once their session directory is deleted.
+// MATLAB does not answer pings while it runs code, so sessions whose process is still running are only reported as unresponsive.
+type Monitor struct {
+	configFactory     ConfigFactory
+	loggerFactory     LoggerFactory
+	sessionStore      SessionStore
+	sessionLogKeeper  SessionLogKeeper
+	clientNotifier    ClientNotifier
+	osLayer           OSLayer
+	processFinder     ProcessFinder
+	lifecycleSignaler LifecycleSignaler
+
+	startOnce *sync.Once
+	startErr  error
+	logger    entities.Logger
+	stopC     chan struct{}
+
+	l                    *sync.Mutex
+	isShutdown           bool
+	watchers             *sync.WaitGroup
+	logFiles             map[int]string
+	failedPings          map[entities.SessionID]int
+	processExitListeners []func(processID int)
+
+	pingInterval time.Duration
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	sessionStore SessionStore,
+	sessionLogKeeper SessionLogKeeper,
+	clientNotifier ClientNotifier,
+	osLayer OSLayer,
+	processFinder ProcessFinder,
+	lifecycleSignaler LifecycleSignaler,
+) *Monitor {
+	return &Monitor{
+		configFactory:     configFactory,
+		loggerFactory:     loggerFactory,
+		sessionStore:      sessionStore,
+		sessionLogKeeper:  sessionLogKeeper,
+		clientNotifier:    clientNotifier,
+		osLayer:           osLayer,
+		processFinder:     processFinder,
+		lifecycleSignaler: lifecycleSignaler,
+
+		startOnce: new(sync.Once),
+		stopC:     make(chan struct{}),
+
+		l:           new(sync.Mutex),
+		watchers:    new(sync.WaitGroup),
+		logFiles:    map[int]string{},
+		failedPings: map[entities.SessionID]int{},
+
+		pingInterval: defaultPingInterval,
+	}
+}
+
+// AddProcessExitListener calls the listener with the process ID of every watched MATLAB process that exits
+// without a session in the store, such as a pooled MATLAB session.
+func (m *Monitor) AddProcessExitListener(listener func(processID int)) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	m.processExitListeners = append(m.processExitListeners, listener)
+}
+
+// WatchProcess reports the session of the MATLAB process as dead when processExited receives its exit code,
+// unless the session was stopped, and so removed from the store, before the process exited.
+// logFile is the MATLAB log, whose last lines are recorded for diagnostics.
+func (m *Monitor) WatchProcess(processID int, processExited <-chan int, logFile string) {
+	if processExited == nil {
+		return
+	}
+
+	if err := m.start(); err != nil {
+		return
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if m.isShutdown {
+		return
+	}
+
+	m.logFiles[processID] = logFile
+	m.watchers.Add(1)
+
+	go func() {
+		defer m.watchers.Done()
+
+		select {
+		case <-m.stopC:
+		case exitCode, ok := <-processExited:
+			if !ok {
+				exitCode = -1
+			}
+			m.handleProcessExit(context.Background(), processID, exitCode)
+		}
+	}()
+}
+
+func (m *Monitor) start() error {
+	m.startOnce.Do(func() {
+		logger, messagesErr := m.loggerFactory.GetGlobalLogger()
+		if messagesErr != nil {
+			m.startErr = messagesErr
+			return
+		}
+		m.logger = logger
+
+		m.lifecycleSignaler.AddShutdownFunction(func() error {
+			m.l.Lock()
+			m.isShutdown = true
+			m.l.Unlock()
+
+			close(m.stopC)
+			m.watchers.Wait()
+			return nil
+		})
+
+		m.watchers.Add(1)
+		go func() {
+			defer m.watchers.Done()
+
+			ticker := time.NewTicker(m.pingInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-m.stopC:
+					return
+				case <-ticker.C:
+					m.checkSessions(context.Background())
+				}
+			}
+		}()
+	})
+	return m.startErr
+}
+
+func (m *Monitor) handleProcessExit(ctx context.Context, processID int, exitCode int) {
+	logFile := m.takeLogFile(processID)
+
+	for _, session := range m.sessionStore.List() {
+		if session.Metadata.ProcessID == processID {
+			m.reportDead(ctx, session, fmt.Sprintf("MATLAB process %d exited with code %d", processID, exitCode), logFile)
+			return
+		}
+	}
+
+	m.logger.
+		With("pid", processID).
+		With("exit-code", exitCode).
+		Debug("MATLAB process exited")
+
+	m.l.Lock()
+	listeners := slices.Clone(m.processExitListeners)
+	m.l.Unlock()
+
+	for _, listener := range listeners {
+		listener(processID)
+	}
+}
+
+// checkSessions pings the idle MATLAB sessions. Sessions that stopped answering are reported as dead when their
+// MATLAB process is gone, and as unresponsive otherwise, as MATLAB does not answer pings while it runs code
+// that was not sent by the server.
+// Busy sessions are skipped, as they may be slow to answer while running code.
+func (m *Monitor) checkSessions(ctx context.Context) {
+	config, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		m.logger.WithError(messagesErr).Warn("Failed to get configuration to check MATLAB sessions")
+		return
+	}
+
+	failedPings := map[entities.SessionID]int{}
+	var deadSessions, unresponsiveSessions []matlabsessionstore.Session
+
+	for _, session := range m.sessionStore.List() {
+		if session.Client.Usage().IsBusy {
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, config.MATLABSessionConnectionTimeout())
+		isAlive := session.Client.Ping(pingCtx, m.logger.With("session-id", session.ID)).IsAlive
+		cancel()
+
+		if isAlive {
+			continue
+		}
+
+		m.l.Lock()
+		failedPings[session.ID] = m.failedPings[session.ID] + 1
+		m.l.Unlock()
+
+		switch {
+		case failedPings[session.ID] < unhealthyPingThreshold:
+		case m.processExited(session.Metadata.ProcessID):
+			delete(failedPings, session.ID)
+			deadSessions = append(deadSessions, session)
+		case failedPings[session.ID] == unhealthyPingThreshold:
+			// Only report once, the session keeps being pinged until it answers or its process exits.
+			unresponsiveSessions = append(unresponsiveSessions, session)
+		}
+	}
+
+	m.l.Lock()
+	m.failedPings = failedPings
+	m.l.Unlock()
+
+	for _, session := range unresponsiveSessions {
+		m.reportUnresponsive(ctx, session)
+	}
+
+	for _, session := range deadSessions {
+		m.reportDead(ctx, session, "MATLAB stopped responding and its process exited", m.takeLogFile(session.Metadata.ProcessID))
+	}
+}
+
+// processExited reports whether the MATLAB process is known to be gone.
+// Sessions without a known process are never considered gone.
+func (m *Monitor) processExited(processID int) bool {
+	return processID > 0 && m.processFinder.FindProcess(processID) == nil
+}
+
+func (m *Monitor) reportUnresponsive(ctx context.Context, session matlabsessionstore.Session) {
+	sessionLogger := m.logger.
+		With("session-id", session.ID).
+		With("pid", session.Metadata.ProcessID)
+
+	sessionLogger.Warn("MATLAB session is not responding")
+
+	m.clientNotifier.NotifyClients(ctx, sessionLogger, entities.LogLevelWarn, fmt.Sprintf("MATLAB session %v is not responding. It may be busy running code", session.ID))
+}
+
+// reportDead reads and keeps the logs before stopping the session, as stopping it deletes its session directory.
+func (m *Monitor) reportDead(ctx context.Context, session matlabsessionstore.Session, cause string, logFile string) {
+	sessionLogger := m.logger.
+		With("session-id", session.ID).
+		With("pid", session.Metadata.ProcessID)
+
+	diagnostics := cause
+	if logTail := m.logTail(logFile); logTail != "" {
+		diagnostics += ". Last lines of the MATLAB log:\n" + logTail
+	}
+
+	m.sessionStore.MarkDead(session.ID, diagnostics, m.keepLogs(sessionLogger, session.Metadata.SessionDirectory))
+
+	sessionLogger.
+		With("diagnostics", diagnostics).
+		Warn("MATLAB session stopped unexpectedly")
+
+	m.clientNotifier.NotifyClients(ctx, sessionLogger, entities.LogLevelWarn, fmt.Sprintf("MATLAB session %v stopped unexpectedly: %s", session.ID, cause))
+
+	// Clean up what is left of the session, such as its process and session directory.
+	// Stopping the session kills MATLAB when it does not answer the request to exit.
+	if err := session.Client.StopSession(ctx, sessionLogger); err != nil {
+		sessionLogger.WithError(err).Debug("Failed to clean up dead MATLAB session")
+	}
+}
+
+// keepLogs returns the directory the logs of the session were kept in, or an empty string when they could not be kept.
+func (m *Monitor) keepLogs(sessionLogger entities.Logger, sessionDirectory string) string {
+	// Sessions that were attached to have no session directory.
+	if sessionDirectory == "" {
+		return ""
+	}
+
+	logDirectory, err := m.sessionLogKeeper.Keep(sessionDirectory)
+	if err != nil {
+		sessionLogger.WithError(err).Warn("Failed to keep the logs of dead MATLAB session")
+		return ""
+	}
+
+	return logDirectory
+}
+
+func (m *Monitor) takeLogFile(processID int) string {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	logFile := m.logFiles[processID]
+	delete(m.logFiles, processID)
+	return logFile
+}
+
+func (m *Monitor) logTail(logFile string) string {
+	if logFile == "" {
+		return ""
+	}
+
+	content, err := m.osLayer.ReadFile(logFile)
+	if err != nil {
+		m.logger.With("log-file", logFile).WithError(err).Debug("Failed to read MATLAB log")
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	return strings.Join(lines[max(len(lines)-logTailLines, 0):], "\n")
+}
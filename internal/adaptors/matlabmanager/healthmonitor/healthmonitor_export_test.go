@@ -0,0 +1,22 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package healthmonitor
+
+import (
+	"context"
+	"time"
+)
+
+func (m *Monitor) SetPingInterval(pingInterval time.Duration) {
+	m.pingInterval = pingInterval
+}
+
+// CheckSessions starts the monitor, as checking sessions needs its logger, and checks the sessions once.
+func (m *Monitor) CheckSessions(ctx context.Context) error {
+	if err := m.start(); err != nil {
+		return err
+	}
+
+	m.checkSessions(ctx)
+	return nil
+}
@@ -0,0 +1,588 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package healthmonitor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/healthmonitor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/healthmonitor"
+	storemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Act
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, monitor)
+}
+
+func TestMonitor_WatchProcess_ReportsExitedSessionAsDead(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	const processID = 1234
+	const sessionDirectory = "/tmp/session"
+	const logFile = "/tmp/session/matlab_stdout.log"
+	const keptLogDirectory = "/tmp/matlab-session-log-1234"
+	sessionID := entities.SessionID(3)
+	session := matlabsessionstore.Session{
+		ID:       sessionID,
+		Client:   mockClient,
+		Metadata: matlabsessionstore.SessionMetadata{ProcessID: processID, SessionDirectory: sessionDirectory},
+	}
+
+	var capturedShutdownFunc func() error
+	processExited := make(chan int, 1)
+	notifiedC := make(chan struct{})
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{session}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(logFile).
+		Return([]byte("Starting MATLAB\nFatal error: out of memory\n"), nil).
+		Once()
+
+	mockSessionLogKeeper.EXPECT().
+		Keep(sessionDirectory).
+		Return(keptLogDirectory, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		MarkDead(sessionID, mock.MatchedBy(func(diagnostics string) bool {
+			return assert.Contains(t, diagnostics, "exited with code 9") &&
+				assert.Contains(t, diagnostics, "Fatal error: out of memory")
+		}), keptLogDirectory).
+		Return().
+		Once()
+
+	mockClientNotifier.EXPECT().
+		NotifyClients(mock.Anything, mock.Anything, entities.LogLevelWarn, mock.AnythingOfType("string")).
+		Return().
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ entities.Logger) {
+			close(notifiedC)
+		}).
+		Return(nil).
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	// Act
+	monitor.WatchProcess(processID, processExited, logFile)
+	processExited <- 9
+
+	// Assert
+	select {
+	case <-notifiedC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "dead MATLAB session was not reported")
+	}
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+
+	warnLogs := mockLogger.WarnLogs()
+	require.Contains(t, warnLogs, "MATLAB session stopped unexpectedly")
+	assert.Equal(t, sessionID, warnLogs["MATLAB session stopped unexpectedly"]["session-id"])
+}
+
+func TestMonitor_WatchProcess_NotifiesListenersOfExitOfSessionNotInStore(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var capturedShutdownFunc func() error
+	processExited := make(chan int, 1)
+	exitedProcessIDC := make(chan int, 1)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return(nil).
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	monitor.AddProcessExitListener(func(processID int) {
+		exitedProcessIDC <- processID
+	})
+
+	// Act
+	monitor.WatchProcess(1234, processExited, "matlab_stdout.log")
+	processExited <- 0
+
+	// Assert
+	select {
+	case exitedProcessID := <-exitedProcessIDC:
+		assert.Equal(t, 1234, exitedProcessID)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "process exit was not handled")
+	}
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestMonitor_WatchProcess_StopsWatchingOnShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var capturedShutdownFunc func() error
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	monitor.WatchProcess(1234, make(chan int), "matlab_stdout.log")
+	require.NotNil(t, capturedShutdownFunc)
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMonitor_CheckSessions_ReportsSessionAsDeadAfterConsecutiveFailedPingsWhenProcessExited(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	sessionID := entities.SessionID(5)
+	const processID = 4321
+	session := matlabsessionstore.Session{
+		ID:       sessionID,
+		Client:   mockClient,
+		Metadata: matlabsessionstore.SessionMetadata{ProcessID: processID},
+	}
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Times(3)
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Times(3)
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{session}).
+		Times(3)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{}).
+		Times(3)
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Times(3)
+
+	mockProcessFinder.EXPECT().
+		FindProcess(processID).
+		Return(nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		MarkDead(sessionID, "MATLAB stopped responding and its process exited", "").
+		Return().
+		Once()
+
+	mockClientNotifier.EXPECT().
+		NotifyClients(ctx, mock.Anything, entities.LogLevelWarn, mock.AnythingOfType("string")).
+		Return().
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(ctx, mock.Anything).
+		Return(nil).
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	// Act
+	for range 3 {
+		require.NoError(t, monitor.CheckSessions(ctx))
+	}
+
+	// Assert
+	assert.Contains(t, mockLogger.WarnLogs(), "MATLAB session stopped unexpectedly")
+}
+
+func TestMonitor_CheckSessions_ReportsSessionAsUnresponsiveOnceWhenProcessIsRunning(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	sessionID := entities.SessionID(5)
+	const processID = 4321
+	session := matlabsessionstore.Session{
+		ID:       sessionID,
+		Client:   mockClient,
+		Metadata: matlabsessionstore.SessionMetadata{ProcessID: processID},
+	}
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Times(4)
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Times(4)
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{session}).
+		Times(4)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{}).
+		Times(4)
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Times(4)
+
+	mockProcessFinder.EXPECT().
+		FindProcess(processID).
+		Return(&osfacademocks.MockProcess{}).
+		Times(2)
+
+	mockClientNotifier.EXPECT().
+		NotifyClients(ctx, mock.Anything, entities.LogLevelWarn, mock.AnythingOfType("string")).
+		Return().
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	// Act
+	for range 4 {
+		require.NoError(t, monitor.CheckSessions(ctx))
+	}
+
+	// Assert
+	assert.Contains(t, mockLogger.WarnLogs(), "MATLAB session is not responding")
+	assert.NotContains(t, mockLogger.WarnLogs(), "MATLAB session stopped unexpectedly")
+}
+
+func TestMonitor_CheckSessions_SkipsBusySessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	session := matlabsessionstore.Session{
+		ID:     entities.SessionID(5),
+		Client: mockClient,
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{session}).
+		Once()
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{IsBusy: true}).
+		Once()
+
+	monitor := healthmonitor.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionLogKeeper, mockClientNotifier, mockOSLayer, mockProcessFinder, mockLifecycleSignaler)
+	monitor.SetPingInterval(time.Hour)
+
+	// Act
+	err := monitor.CheckSessions(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
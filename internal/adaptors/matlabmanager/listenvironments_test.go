@@ -33,6 +33,15 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	expectedMatlabInfos := []datatypes.MatlabInfo{{
 		Location: filepath.Join("path", "to", "matlab", "R2023a"),
 		Version: datatypes.MatlabVersionInfo{
@@ -58,7 +67,7 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 	ctx := t.Context()
 
 	// Act
@@ -93,6 +102,15 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockResponse := datatypes.ListMatlabInfo{
 		MatlabInfo: []datatypes.MatlabInfo{},
 	}
@@ -101,7 +119,7 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 	ctx := t.Context()
 
 	// Act
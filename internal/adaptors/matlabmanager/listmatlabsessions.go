@@ -0,0 +1,52 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func (m *MATLABManager) ListMATLABSessions(ctx context.Context, sessionLogger entities.Logger) ([]entities.MATLABSessionInfo, error) {
+	config, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		return nil, messagesErr
+	}
+
+	sessions := m.sessionStore.List()
+
+	sessionLogger.With("count", len(sessions)).Debug("Checking liveness of MATLAB sessions")
+
+	sessionInfos := make([]entities.MATLABSessionInfo, len(sessions))
+	var wg sync.WaitGroup
+	for i, session := range sessions {
+		usage := session.Client.Usage()
+		sessionInfos[i] = entities.MATLABSessionInfo{
+			SessionID:  session.ID,
+			MATLABRoot: session.Metadata.MATLABRoot,
+			Version:    session.Metadata.Version,
+			ProcessID:  session.Metadata.ProcessID,
+			StartedAt:  session.Metadata.StartedAt,
+			LastUsedAt: usage.LastUsedAt,
+			IsBusy:     usage.IsBusy,
+		}
+
+		// A busy session is running code, so it is alive, and may be slow to answer a ping.
+		if usage.IsBusy {
+			sessionInfos[i].IsAlive = true
+			continue
+		}
+
+		wg.Go(func() {
+			pingCtx, cancel := context.WithTimeout(ctx, config.MATLABSessionConnectionTimeout())
+			defer cancel()
+
+			sessionInfos[i].IsAlive = session.Client.Ping(pingCtx, sessionLogger.With("session_id", session.ID)).IsAlive
+		})
+	}
+	wg.Wait()
+
+	return sessionInfos, nil
+}
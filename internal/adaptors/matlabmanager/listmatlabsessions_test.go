@@ -0,0 +1,231 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_ListMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockBusyClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockBusyClient.AssertExpectations(t)
+
+	mockAliveClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockAliveClient.AssertExpectations(t)
+
+	mockDeadClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockDeadClient.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2026a")
+	startedAt := time.Unix(1767225600, 0)
+	lastUsedAt := time.Unix(1767225900, 0)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Times(2)
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{
+			{
+				ID:       1,
+				Client:   mockBusyClient,
+				Metadata: matlabsessionstore.SessionMetadata{MATLABRoot: matlabRoot, Version: "R2026a", ProcessID: 1234, StartedAt: startedAt},
+			},
+			{
+				ID:       2,
+				Client:   mockAliveClient,
+				Metadata: matlabsessionstore.SessionMetadata{ProcessID: 4321, StartedAt: startedAt},
+			},
+			{
+				ID:       3,
+				Client:   mockDeadClient,
+				Metadata: matlabsessionstore.SessionMetadata{ProcessID: 5678, StartedAt: startedAt},
+			},
+		}).
+		Once()
+
+	mockBusyClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt, IsBusy: true}).
+		Once()
+
+	mockAliveClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	mockAliveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockDeadClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{}).
+		Once()
+
+	mockDeadClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	result, err := manager.ListMATLABSessions(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []entities.MATLABSessionInfo{
+		{SessionID: 1, MATLABRoot: matlabRoot, Version: "R2026a", ProcessID: 1234, StartedAt: startedAt, LastUsedAt: lastUsedAt, IsBusy: true, IsAlive: true},
+		{SessionID: 2, ProcessID: 4321, StartedAt: startedAt, LastUsedAt: lastUsedAt, IsAlive: true},
+		{SessionID: 3, ProcessID: 5678, StartedAt: startedAt, IsAlive: false},
+	}, result)
+}
+
+func TestMATLABManager_ListMATLABSessions_NoSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	result, err := manager.ListMATLABSessions(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestMATLABManager_ListMATLABSessions_ConfigFactoryError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	result, err := manager.ListMATLABSessions(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
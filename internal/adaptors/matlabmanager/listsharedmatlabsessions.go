@@ -0,0 +1,30 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func (m *MATLABManager) ListSharedMATLABSessions(ctx context.Context, sessionLogger entities.Logger) []entities.SharedMATLABSession {
+	sessionLogger.Debug("Discovering shared MATLAB sessions on MATLAB Manager")
+
+	discoveredSessions := m.sessionSelector.DiscoverSharedSessions(ctx, sessionLogger)
+
+	sessionLogger.With("count", len(discoveredSessions)).Debug("Converting shared sessions to entities")
+
+	sharedSessions := make([]entities.SharedMATLABSession, 0, len(discoveredSessions))
+	for _, session := range discoveredSessions {
+		sharedSessions = append(sharedSessions, entities.SharedMATLABSession{
+			ProcessID:     session.PID,
+			Name:          session.Name,
+			Release:       session.Release,
+			WorkingFolder: session.WorkingFolder,
+			SharedAt:      session.SharedAt,
+		})
+	}
+
+	return sharedSessions
+}
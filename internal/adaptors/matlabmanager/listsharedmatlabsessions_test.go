@@ -0,0 +1,112 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMATLABManager_ListSharedMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	sharedAt := time.Unix(1767225600, 0)
+	workingFolder := filepath.Join("home", "user", "work")
+
+	mockSessionSelector.EXPECT().
+		DiscoverSharedSessions(ctx, mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{
+			{PID: 1234, Name: "analysis", Release: "R2026a", WorkingFolder: workingFolder, SharedAt: sharedAt},
+			{PID: 5678},
+		}).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	result := manager.ListSharedMATLABSessions(ctx, mockLogger)
+
+	// Assert
+	assert.Equal(t, []entities.SharedMATLABSession{
+		{ProcessID: 1234, Name: "analysis", Release: "R2026a", WorkingFolder: workingFolder, SharedAt: sharedAt},
+		{ProcessID: 5678},
+	}, result)
+}
+
+func TestMATLABManager_ListSharedMATLABSessions_NoSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockSessionSelector.EXPECT().
+		DiscoverSharedSessions(ctx, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	result := manager.ListSharedMATLABSessions(ctx, mockLogger)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 )
@@ -24,9 +25,11 @@ type MATLABServices interface {
 }
 
 type MATLABSessionStore interface {
-	Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID
+	Add(client matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata) entities.SessionID
 	Get(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
 	Remove(sessionID entities.SessionID)
+	List() []matlabsessionstore.Session
+	GetLogDirectory(sessionID entities.SessionID) (string, error)
 }
 
 type MATLABSessionClientFactory interface {
@@ -34,15 +37,33 @@ type MATLABSessionClientFactory interface {
 }
 
 type SessionSelector interface {
-	SelectSessionToAttachTo(logger entities.Logger) (embeddedconnector.ConnectionDetails, error)
+	SelectSessionToAttachTo(ctx context.Context, logger entities.Logger, request entities.AttachToExistingSession) (embeddedconnector.ConnectionDetails, error)
+	DiscoverSharedSessions(ctx context.Context, logger entities.Logger) []sessiondiscovery.Session
+	FindSharedSession(logger entities.Logger, processID int) (sessiondiscovery.Session, bool)
+}
+
+type SessionReaper interface {
+	Start() error
+	ReserveSlot(ctx context.Context, logger entities.Logger) (func(), error)
+}
+
+type SessionPool interface {
+	Take(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, func(), bool)
+}
+
+type SessionLogReader interface {
+	Read(sessionDirectory string, maxLines int) (entities.MATLABSessionLog, error)
 }
 
 type MATLABManager struct {
-	configFactory   ConfigFactory
-	matlabServices  MATLABServices
-	sessionStore    MATLABSessionStore
-	clientFactory   MATLABSessionClientFactory
-	sessionSelector SessionSelector
+	configFactory    ConfigFactory
+	matlabServices   MATLABServices
+	sessionStore     MATLABSessionStore
+	clientFactory    MATLABSessionClientFactory
+	sessionSelector  SessionSelector
+	sessionReaper    SessionReaper
+	sessionPool      SessionPool
+	sessionLogReader SessionLogReader
 
 	matlabSessionConnectionRetryInterval time.Duration
 }
@@ -55,13 +76,19 @@ func New(
 	sessionStore MATLABSessionStore,
 	clientFactory MATLABSessionClientFactory,
 	sessionSelector SessionSelector,
+	sessionReaper SessionReaper,
+	sessionPool SessionPool,
+	sessionLogReader SessionLogReader,
 ) *MATLABManager {
 	return &MATLABManager{
-		configFactory:   configFactory,
-		matlabServices:  matlabServices,
-		sessionStore:    sessionStore,
-		clientFactory:   clientFactory,
-		sessionSelector: sessionSelector,
+		configFactory:    configFactory,
+		matlabServices:   matlabServices,
+		sessionStore:     sessionStore,
+		clientFactory:    clientFactory,
+		sessionSelector:  sessionSelector,
+		sessionReaper:    sessionReaper,
+		sessionPool:      sessionPool,
+		sessionLogReader: sessionLogReader,
 
 		matlabSessionConnectionRetryInterval: defaultMATLABSessionConnectionRetryInterval,
 	}
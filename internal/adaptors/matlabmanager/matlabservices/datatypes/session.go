@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package datatypes
 
@@ -9,4 +9,7 @@ type LocalSessionDetails struct {
 	IsStartingDirectorySet bool
 	StartingDirectory      string
 	ShowMATLABDesktop      bool
+	StartupScript          string
+	EnvironmentVariables   map[string]string
+	AdditionalFlags        []string
 }
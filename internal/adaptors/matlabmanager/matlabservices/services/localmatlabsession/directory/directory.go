@@ -23,7 +23,9 @@ const defaultCleanupRetry = 500 * time.Millisecond
 const securePortFile = "connector.securePort"
 const certificateFile = "cert.pem"
 const certificateKeyFile = "cert.key"
-const startupErrorFile = "mcp_startup_error.txt"
+
+// StartupErrorFileName is the file, in the session directory, that MATLAB writes to when the MCP initialization fails.
+const StartupErrorFileName = "mcp_startup_error.txt"
 
 var ErrMATLABStartup = errors.New("MATLAB startup failed")
 
@@ -159,7 +161,7 @@ func (d *directory) securePortFile() string {
 }
 
 func (d *directory) checkStartupError() error {
-	path := filepath.Join(d.sessionDir, startupErrorFile)
+	path := filepath.Join(d.sessionDir, StartupErrorFileName)
 	content, err := d.osLayer.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
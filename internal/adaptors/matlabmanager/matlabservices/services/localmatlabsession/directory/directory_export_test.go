@@ -18,7 +18,7 @@ func (d *directory) SecurePortFile() string {
 }
 
 func (d *directory) StartupErrorFile() string {
-	return filepath.Join(d.sessionDir, startupErrorFile)
+	return filepath.Join(d.sessionDir, StartupErrorFileName)
 }
 
 func (d *directory) SetEmbeddedConnectorDetailsRetry(retry time.Duration) {
@@ -0,0 +1,20 @@
+function evalWithProgressStream(streamFolder, mirrorConsole, encodedCode)
+    % evalWithProgressStream evaluates code in the base workspace while streaming its
+    % progress updates, and its console output when mirrorConsole is true, to
+    % streamFolder. See matlab_mcp.startProgressStream.
+    %
+    % The code is base64 encoded UTF-8, so that it can be passed as a single character
+    % vector whatever characters it contains.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    stream = matlab_mcp.startProgressStream(streamFolder, mirrorConsole);
+    code = native2unicode(matlab.net.base64decode(encodedCode), 'UTF-8');
+
+    try
+        evalin('base', code);
+    catch evaluationError
+        % Report the error as if the code had been evaluated directly.
+        throwAsCaller(evaluationError);
+    end
+end
@@ -3,7 +3,7 @@
 % change without any prior notice. Usage of these undocumented APIs outside of
 % these files is not supported.
 
-function results = mcpEval(code)
+function results = mcpEval(code, streamFolder)
     % mcpEval A helper function for handling execution of MATLAB code and post-processing
     % the outputs. The MATLAB MCP Core Server will then convert those to the appropriate MCP Server Tool Content, see:
     % 
@@ -45,6 +45,12 @@ function results = mcpEval(code)
     hotlinksPreviousState = feature('hotlinks','off');
     hotlinksCleanupObj = onCleanup(@() feature('hotlinks', hotlinksPreviousState));
 
+    % Stream progress updates to streamFolder while the code runs, when one is given.
+    % The Live Editor captures the console output, so it is not mirrored.
+    if nargin > 1
+        progressStream = matlab_mcp.startProgressStream(streamFolder, false);
+    end
+
     resp = jsondecode(matlab.internal.editor.evaluateSynchronousRequest(request));
 
     results = jsonencode(processOutputs(resp.outputs));
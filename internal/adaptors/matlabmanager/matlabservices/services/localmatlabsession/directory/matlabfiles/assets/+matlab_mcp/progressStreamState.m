@@ -0,0 +1,30 @@
+function streamFolder = progressStreamState(action, newStreamFolder)
+    % progressStreamState holds the folder that the running evaluation streams its
+    % updates to. The MATLAB MCP Core Server polls the folder while the code runs.
+    %
+    % action is one of:
+    %   "set" - stream updates to newStreamFolder, or stop streaming when it is '',
+    %           and return the folder that updates were streamed to before.
+    %   "get" - return the folder that updates are streamed to, or '' when nothing
+    %           is streaming.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    persistent currentStreamFolder
+
+    if isempty(currentStreamFolder)
+        currentStreamFolder = '';
+    end
+
+    streamFolder = currentStreamFolder;
+
+    if action == "set"
+        currentStreamFolder = char(newStreamFolder);
+        if isempty(currentStreamFolder)
+            munlock;
+        else
+            % Keep the folder when user code runs "clear functions" or "clear all".
+            mlock;
+        end
+    end
+end
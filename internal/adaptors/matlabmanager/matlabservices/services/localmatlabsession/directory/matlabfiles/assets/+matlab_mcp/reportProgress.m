@@ -0,0 +1,33 @@
+function reportProgress(fraction, message)
+    % reportProgress Report how far long running code has got.
+    %
+    %   matlab_mcp.reportProgress(fraction) reports that fraction of the work,
+    %   between 0 and 1, is complete.
+    %
+    %   matlab_mcp.reportProgress(fraction, message) also describes the current step.
+    %
+    %   The MATLAB MCP Core Server forwards the update to the AI application while
+    %   the code is still running. Outside of an evaluation started by the server,
+    %   this function does nothing.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    arguments
+        fraction (1,1) double {mustBeInRange(fraction, 0, 1)}
+        message (1,1) string = ""
+    end
+
+    streamFolder = matlab_mcp.progressStreamState("get");
+    if isempty(streamFolder)
+        return
+    end
+
+    fid = fopen(fullfile(streamFolder, 'events.jsonl'), 'a');
+    if fid == -1
+        return
+    end
+    closeFile = onCleanup(@() fclose(fid));
+
+    % jsonencode escapes newlines in message, so each event stays on a single line.
+    fprintf(fid, '%s\n', jsonencode(struct('progress', fraction, 'message', message)));
+end
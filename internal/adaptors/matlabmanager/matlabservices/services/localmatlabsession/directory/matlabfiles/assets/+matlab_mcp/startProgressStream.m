@@ -0,0 +1,32 @@
+function stream = startProgressStream(streamFolder, mirrorConsole)
+    % startProgressStream directs progress updates to streamFolder until the returned
+    % object is destroyed. When mirrorConsole is true, console output is also mirrored
+    % to streamFolder. See matlab_mcp.reportProgress.
+    %
+    % The object is destroyed when the evaluation that holds it ends, also when the
+    % code errors or is interrupted, which restores the previous stream and the
+    % diary settings of the user.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    previousStreamFolder = matlab_mcp.progressStreamState("set", streamFolder);
+    previousDiaryState = get(0, 'Diary');
+    previousDiaryFile = get(0, 'DiaryFile');
+
+    if mirrorConsole
+        diary('off');
+        diary(fullfile(streamFolder, 'console.log'));
+    end
+
+    stream = onCleanup(@() stopProgressStream(previousStreamFolder, mirrorConsole, previousDiaryState, previousDiaryFile));
+end
+
+function stopProgressStream(previousStreamFolder, mirrorConsole, previousDiaryState, previousDiaryFile)
+    matlab_mcp.progressStreamState("set", previousStreamFolder);
+
+    if mirrorConsole
+        diary('off');
+        set(0, 'DiaryFile', previousDiaryFile);
+        set(0, 'Diary', previousDiaryState);
+    end
+end
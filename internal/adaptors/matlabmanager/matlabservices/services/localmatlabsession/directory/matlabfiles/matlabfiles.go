@@ -13,6 +13,18 @@ var mcpEval []byte
 //go:embed assets/+matlab_mcp/getOrStashExceptions.m
 var getOrStashExceptions []byte
 
+//go:embed assets/+matlab_mcp/progressStreamState.m
+var progressStreamState []byte
+
+//go:embed assets/+matlab_mcp/startProgressStream.m
+var startProgressStream []byte
+
+//go:embed assets/+matlab_mcp/evalWithProgressStream.m
+var evalWithProgressStream []byte
+
+//go:embed assets/+matlab_mcp/reportProgress.m
+var reportProgress []byte
+
 type MATLABFiles struct{}
 
 func New() MATLABFiles {
@@ -21,8 +33,12 @@ func New() MATLABFiles {
 
 func (g MATLABFiles) GetAll() map[string][]byte {
 	return map[string][]byte{
-		"initializeMCP.m":        initializeMCP,
-		"mcpEval.m":              mcpEval,
-		"getOrStashExceptions.m": getOrStashExceptions,
+		"initializeMCP.m":          initializeMCP,
+		"mcpEval.m":                mcpEval,
+		"getOrStashExceptions.m":   getOrStashExceptions,
+		"progressStreamState.m":    progressStreamState,
+		"startProgressStream.m":    startProgressStream,
+		"evalWithProgressStream.m": evalWithProgressStream,
+		"reportProgress.m":         reportProgress,
 	}
 }
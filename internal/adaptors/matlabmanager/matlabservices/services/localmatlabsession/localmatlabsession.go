@@ -4,12 +4,19 @@ package localmatlabsession
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
 )
 
 const startupCode = "sessionPath = getenv('MW_MCP_SESSION_DIR');addpath(sessionPath);matlab_mcp.initializeMCP(); clear sessionPath;"
@@ -25,18 +32,30 @@ type ProcessDetails interface {
 }
 
 type MATLABProcessLauncher interface {
-	Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error)
+	Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan int, error)
 }
 
 type Watchdog interface {
 	RegisterProcessPIDWithWatchdog(processPID int) error
 }
 
+type HealthMonitor interface {
+	WatchProcess(processID int, processExited <-chan int, logFile string)
+}
+
+type SessionLogKeeper interface {
+	Keep(sessionDirectory string) (string, error)
+}
+
 type Starter struct {
 	directoryFactory      SessionDirectoryFactory
 	processDetails        ProcessDetails
 	matlabProcessLauncher MATLABProcessLauncher
 	watchdog              Watchdog
+	healthMonitor         HealthMonitor
+	sessionLogKeeper      SessionLogKeeper
+
+	goos string
 }
 
 func NewStarter(
@@ -44,12 +63,18 @@ func NewStarter(
 	processDetails ProcessDetails,
 	matlabProcessLauncher MATLABProcessLauncher,
 	watchdog Watchdog,
+	healthMonitor HealthMonitor,
+	sessionLogKeeper SessionLogKeeper,
 ) *Starter {
 	return &Starter{
 		directoryFactory:      directoryFactory,
 		processDetails:        processDetails,
 		matlabProcessLauncher: matlabProcessLauncher,
 		watchdog:              watchdog,
+		healthMonitor:         healthMonitor,
+		sessionLogKeeper:      sessionLogKeeper,
+
+		goos: runtime.GOOS,
 	}
 }
 
@@ -79,9 +104,14 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 		sessionDir.CertificateKeyFile(),
 	)
 
-	startupFlags := m.processDetails.StartupFlag(runtime.GOOS, request.ShowMATLABDesktop, startupCode)
+	env = withAdditionalEnvironmentVariables(m.goos, env, request.EnvironmentVariables)
 
-	processID, processCleanup, _, err := m.matlabProcessLauncher.Launch(ctx, logger, sessionDirPath, request.MATLABRoot, request.StartingDirectory, startupFlags, env)
+	startupFlags := withAdditionalFlags(
+		m.processDetails.StartupFlag(m.goos, request.ShowMATLABDesktop, sessionStartupCode(request.StartupScript)),
+		request.AdditionalFlags,
+	)
+
+	processID, processCleanup, processExited, err := m.matlabProcessLauncher.Launch(ctx, logger, sessionDirPath, request.MATLABRoot, request.StartingDirectory, startupFlags, env)
 	if err != nil {
 		if cleanupErr := sessionDir.Cleanup(); cleanupErr != nil {
 			logger.WithError(cleanupErr).Warn("Failed to cleanup session directory after launch error")
@@ -105,10 +135,13 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 		logger.WithError(err).Warn("Failed to register process with watchdog")
 	}
 
+	m.healthMonitor.WatchProcess(processID, processExited, filepath.Join(sessionDirPath, processlauncher.StdoutLogFileName))
+
 	logger.Debug("Retrieving EC details")
 
 	securePort, certificatePEM, err := sessionDir.GetEmbeddedConnectorDetails()
 	if err != nil {
+		err = m.withKeptLogs(logger, sessionDirPath, err)
 		if cleanupErr := cleanup(); cleanupErr != nil {
 			logger.WithError(cleanupErr).Warn("Failed to cleanup after startup error")
 		}
@@ -118,9 +151,100 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 	logger.Debug("Retrieved EC details")
 
 	return embeddedconnector.ConnectionDetails{
-		Host:           "localhost",
-		Port:           securePort,
-		APIKey:         uniqueAPIKey,
-		CertificatePEM: certificatePEM,
+		Host:             "localhost",
+		Port:             securePort,
+		APIKey:           uniqueAPIKey,
+		CertificatePEM:   certificatePEM,
+		ProcessID:        processID,
+		SessionDirectory: sessionDirPath,
 	}, cleanup, nil
 }
+
+// withKeptLogs keeps the logs of a MATLAB session that failed to start, as its session directory is about to be deleted,
+// and adds where they were kept to startErr.
+func (m *Starter) withKeptLogs(logger entities.Logger, sessionDirPath string, startErr error) error {
+	keptLogDir, err := m.sessionLogKeeper.Keep(sessionDirPath)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to keep the logs of the MATLAB session that failed to start")
+		return startErr
+	}
+
+	logger.With("log_dir", keptLogDir).Debug("Kept the logs of the MATLAB session that failed to start")
+
+	return fmt.Errorf("%w (MATLAB logs: %s)", startErr, keptLogDir)
+}
+
+// sessionStartupCode runs the startup script, if any, once the MCP session is initialized.
+func sessionStartupCode(startupScript string) string {
+	if startupScript == "" {
+		return startupCode
+	}
+	return startupCode + fmt.Sprintf("run('%s');", matlabstring.EscapeSingleQuotes(startupScript))
+}
+
+// opposingMATLABFlags maps MATLAB command line flags to the flag that undoes them.
+var opposingMATLABFlags = map[string]string{
+	"-softwareopengl":   "-nosoftwareopengl",
+	"-nosoftwareopengl": "-softwareopengl",
+}
+
+// withAdditionalFlags puts the additional flags before the default ones, and drops the default flags that the additional flags repeat or undo.
+func withAdditionalFlags(defaultFlags []string, additionalFlags []string) []string {
+	if len(additionalFlags) == 0 {
+		return defaultFlags
+	}
+
+	result := make([]string, 0, len(additionalFlags)+len(defaultFlags))
+	for _, flag := range additionalFlags {
+		if !slices.Contains(result, flag) {
+			result = append(result, flag)
+		}
+	}
+
+	for _, flag := range defaultFlags {
+		opposingFlag, hasOpposingFlag := opposingMATLABFlags[flag]
+		if slices.Contains(additionalFlags, flag) || (hasOpposingFlag && slices.Contains(additionalFlags, opposingFlag)) {
+			continue
+		}
+		result = append(result, flag)
+	}
+
+	return result
+}
+
+// withAdditionalEnvironmentVariables overrides existing environment variables with the same name, and appends the others.
+// Environment variable names are case-insensitive on Windows.
+func withAdditionalEnvironmentVariables(goos string, env []string, additionalEnvironmentVariables map[string]string) []string {
+	if len(additionalEnvironmentVariables) == 0 {
+		return env
+	}
+
+	isOverridden := func(name string) bool {
+		if goos == "windows" {
+			for additionalName := range additionalEnvironmentVariables {
+				if strings.EqualFold(additionalName, name) {
+					return true
+				}
+			}
+			return false
+		}
+
+		_, found := additionalEnvironmentVariables[name]
+		return found
+	}
+
+	result := make([]string, 0, len(env)+len(additionalEnvironmentVariables))
+	for _, envVar := range env {
+		name, _, _ := strings.Cut(envVar, "=")
+		if isOverridden(name) {
+			continue
+		}
+		result = append(result, envVar)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(additionalEnvironmentVariables)) {
+		result = append(result, name+"="+additionalEnvironmentVariables[name])
+	}
+
+	return result
+}
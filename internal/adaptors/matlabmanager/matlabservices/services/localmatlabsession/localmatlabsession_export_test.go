@@ -0,0 +1,7 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package localmatlabsession
+
+func (m *Starter) SetGOOS(goos string) {
+	m.goos = goos
+}
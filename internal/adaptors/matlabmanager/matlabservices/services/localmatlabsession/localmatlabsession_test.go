@@ -5,6 +5,7 @@ package localmatlabsession_test
 import (
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
@@ -14,6 +15,7 @@ import (
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,12 +33,20 @@ func TestNewStarter_HappyPath(t *testing.T) {
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	// Act
 	starter := localmatlabsession.NewStarter(
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	// Assert
@@ -60,6 +70,12 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -126,6 +142,11 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
@@ -141,6 +162,8 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -158,6 +181,8 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
 	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
 	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+	assert.Equal(t, expectedProcessID, connectionDetails.ProcessID)
+	assert.Equal(t, expectedSessionDirPath, connectionDetails.SessionDirectory)
 
 	assert.False(t, processCleanupCalled)
 	// The caller owns the returned cleanup callback, so invoke it to verify teardown behavior.
@@ -183,6 +208,12 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -247,6 +278,276 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		StartingDirectory:      expectedStartingDir,
+		IsStartingDirectorySet: true,
+		ShowMATLABDesktop:      showDesktop,
+	}
+
+	// Act
+	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, startErr)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, "localhost", connectionDetails.Host)
+	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
+	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
+	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+}
+
+func TestStarter_StartLocalMATLABSession_WithSessionOptions(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedStartingDir := filepath.Join("home", "somewhere")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	processEnv := []string{"PATH=/usr/bin", "MY_VAR=old", "MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	additionalEnv := map[string]string{"MY_VAR": "new", "A_VAR": "a"}
+	expectedEnv := []string{"PATH=/usr/bin", "MATLAB_MCP_API_KEY=" + expectedAPIKey, "A_VAR=a", "MY_VAR=new"}
+	expectedStartupScript := filepath.Join("home", "it's", "startup.m")
+	additionalFlags := []string{"-nosoftwareopengl", "-singleCompThread", "-nosplash", "-singleCompThread"}
+	expectedStartupCode := "sessionPath = getenv('MW_MCP_SESSION_DIR');addpath(sessionPath);matlab_mcp.initializeMCP(); clear sessionPath;" +
+		"run('" + strings.ReplaceAll(expectedStartupScript, "'", "''") + "');"
+	showDesktop := false
+	processStartupFlags := []string{"-nosplash", "-softwareopengl", "-nodesktop", "-r", expectedStartupCode}
+	expectedStartupFlags := []string{"-nosoftwareopengl", "-singleCompThread", "-nosplash", "-nodesktop", "-r", expectedStartupCode}
+	expectedProcessID := 12345
+	processCleanup := func() {}
+
+	mockDirectoryFactory.EXPECT().
+		New(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		Return(processEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		Return(processStartupFlags).
+		Once()
+
+	expectedCtx := t.Context()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
+		Return(expectedProcessID, processCleanup, nil, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		StartingDirectory:      expectedStartingDir,
+		IsStartingDirectorySet: true,
+		ShowMATLABDesktop:      showDesktop,
+		StartupScript:          expectedStartupScript,
+		EnvironmentVariables:   additionalEnv,
+		AdditionalFlags:        additionalFlags,
+	}
+
+	// Act
+	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, startErr)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, "localhost", connectionDetails.Host)
+	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
+	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
+	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+}
+
+func TestStarter_StartLocalMATLABSession_WithSessionOptions_OnWindows_OverridesEnvironmentVariablesCaseInsensitively(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedStartingDir := filepath.Join("home", "somewhere")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	processEnv := []string{"Path=C:\\Windows", "My_Var=old", "MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	additionalEnv := map[string]string{"MY_VAR": "new", "A_VAR": "a"}
+	expectedEnv := []string{"Path=C:\\Windows", "MATLAB_MCP_API_KEY=" + expectedAPIKey, "A_VAR=a", "MY_VAR=new"}
+	expectedStartupScript := filepath.Join("home", "it's", "startup.m")
+	additionalFlags := []string{"-nosoftwareopengl", "-singleCompThread", "-nosplash", "-singleCompThread"}
+	expectedStartupCode := "sessionPath = getenv('MW_MCP_SESSION_DIR');addpath(sessionPath);matlab_mcp.initializeMCP(); clear sessionPath;" +
+		"run('" + strings.ReplaceAll(expectedStartupScript, "'", "''") + "');"
+	showDesktop := false
+	processStartupFlags := []string{"-nosplash", "-softwareopengl", "-nodesktop", "-r", expectedStartupCode}
+	expectedStartupFlags := []string{"-nosoftwareopengl", "-singleCompThread", "-nosplash", "-nodesktop", "-r", expectedStartupCode}
+	expectedProcessID := 12345
+	processCleanup := func() {}
+
+	mockDirectoryFactory.EXPECT().
+		New(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		Return(processEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag("windows", showDesktop, expectedStartupCode).
+		Return(processStartupFlags).
+		Once()
+
+	expectedCtx := t.Context()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
+		Return(expectedProcessID, processCleanup, nil, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
@@ -257,6 +558,8 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -264,8 +567,13 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		StartingDirectory:      expectedStartingDir,
 		IsStartingDirectorySet: true,
 		ShowMATLABDesktop:      showDesktop,
+		StartupScript:          expectedStartupScript,
+		EnvironmentVariables:   additionalEnv,
+		AdditionalFlags:        additionalFlags,
 	}
 
+	starter.SetGOOS("windows")
+
 	// Act
 	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
@@ -292,6 +600,12 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryNewError(t *testing.T)
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedError := assert.AnError
@@ -306,6 +620,8 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryNewError(t *testing.T)
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -341,6 +657,12 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -406,6 +728,8 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -439,6 +763,12 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedStartingDir := filepath.Join("somewhere")
@@ -504,6 +834,11 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		Return(expectedError).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
@@ -514,6 +849,8 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -555,6 +892,12 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -573,6 +916,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	processCleanupCalled := false
 	processCleanup := func() { processCleanupCalled = true }
 	expectedError := assert.AnError
+	expectedKeptLogDir := filepath.Join("tmp", "matlab-session-log-12345")
 
 	mockDirectoryFactory.EXPECT().
 		New(mockLogger.AsMockArg()).
@@ -621,11 +965,21 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		Return(nil).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return("", nil, expectedError).
 		Once()
 
+	mockSessionLogKeeper.EXPECT().
+		Keep(expectedSessionDirPath).
+		Return(expectedKeptLogDir, nil).
+		Once()
+
 	mockDirectory.EXPECT().
 		Cleanup().
 		Return(nil).
@@ -636,6 +990,8 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -648,6 +1004,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
+	assert.Contains(t, err.Error(), expectedKeptLogDir, "Start error should report where the MATLAB logs were kept")
 	assert.Nil(t, cleanup)
 	assert.Equal(t, embeddedconnector.ConnectionDetails{}, connectionDetails)
 	assert.True(t, processCleanupCalled, "process cleanup should be called on error")
@@ -667,6 +1024,12 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -734,6 +1097,11 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		Return(nil).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
@@ -749,6 +1117,8 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -781,6 +1151,12 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockSessionLogKeeper := &mocks.MockSessionLogKeeper{}
+	defer mockSessionLogKeeper.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -844,11 +1220,21 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 		Return(nil).
 		Once()
 
+	mockHealthMonitor.EXPECT().
+		WatchProcess(expectedProcessID, mock.Anything, filepath.Join(expectedSessionDirPath, "matlab_stdout.log")).
+		Return().
+		Once()
+
 	mockDirectory.EXPECT().
 		GetEmbeddedConnectorDetails().
 		Return("", nil, expectedError).
 		Once()
 
+	mockSessionLogKeeper.EXPECT().
+		Keep(expectedSessionDirPath).
+		Return("", assert.AnError).
+		Once()
+
 	mockDirectory.EXPECT().
 		Cleanup().
 		Return(nil).
@@ -859,6 +1245,8 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockHealthMonitor,
+		mockSessionLogKeeper,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -871,6 +1259,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to keep the logs of the MATLAB session that failed to start")
 	assert.Nil(t, cleanup)
 	assert.Equal(t, embeddedconnector.ConnectionDetails{}, connectionDetails)
 }
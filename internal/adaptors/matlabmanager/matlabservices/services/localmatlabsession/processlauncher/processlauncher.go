@@ -16,6 +16,12 @@ import (
 
 const gracefulShutdownTimeout = 2 * time.Minute
 
+const (
+	// StdoutLogFileName is the file, in the session directory, that MATLAB writes its log to.
+	StdoutLogFileName = "matlab_stdout.log"
+	StderrLogFileName = "matlab_stderr.log"
+)
+
 type MATLABProcessLauncher struct{}
 
 func New() *MATLABProcessLauncher {
@@ -30,7 +36,7 @@ func (l *MATLABProcessLauncher) Launch(
 	workingDir string,
 	args []string,
 	env []string,
-) (int, func(), <-chan struct{}, error) {
+) (int, func(), <-chan int, error) {
 	stdIO, stdIOCleanup, err := createLocalStdioForNewProcess(logger, sessionRoot)
 	if err != nil {
 		return 0, nil, nil, err
@@ -44,12 +50,19 @@ func (l *MATLABProcessLauncher) Launch(
 		return 0, nil, nil, fmt.Errorf("failed to start MATLAB process: %w", err)
 	}
 
-	processExited := make(chan struct{})
+	// processExited receives the exit code of MATLAB, or -1 when it is unknown, and is then closed.
+	processExited := make(chan int, 1)
 	waitResult := make(chan error, 1)
 
 	go func() {
-		_, err := process.Wait()
+		state, err := process.Wait()
 		waitResult <- err
+
+		exitCode := -1
+		if state != nil {
+			exitCode = state.ExitCode()
+		}
+		processExited <- exitCode
 		close(processExited)
 	}()
 
@@ -105,14 +118,14 @@ func (s *stdIO) cleanup(logger entities.Logger) {
 func createLocalStdioForNewProcess(logger entities.Logger, sessionRoot string) (*stdIO, func(), error) {
 	stdIO := &stdIO{}
 
-	stdOut, err := os.Create(filepath.Join(sessionRoot, "matlab_stdout.log")) //nolint:gosec // We construct this path, and file
+	stdOut, err := os.Create(filepath.Join(sessionRoot, StdoutLogFileName)) //nolint:gosec // We construct this path, and file
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create stdOut log file: %w", err)
 	}
 
 	stdIO.stdOut = stdOut
 
-	stdErr, err := os.Create(filepath.Join(sessionRoot, "matlab_stderr.log")) //nolint:gosec // We construct this path, and file
+	stdErr, err := os.Create(filepath.Join(sessionRoot, StderrLogFileName)) //nolint:gosec // We construct this path, and file
 	if err != nil {
 		stdIO.cleanup(logger)
 		return nil, nil, fmt.Errorf("failed to create stdErr log file: %w", err)
@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptrace"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	httpclient "github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/client"
@@ -19,16 +22,28 @@ import (
 
 const defaultPingRetry = 100 * time.Millisecond
 const defaultPingTimeout = 1 * time.Second
+const defaultInterruptTimeout = 5 * time.Second
+const defaultProgressPollInterval = 250 * time.Millisecond
 
 type HttpClientFactory interface {
 	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclient.HttpClient, error)
 }
 
+type OSLayer interface {
+	MkdirTemp(dir string, pattern string) (string, error)
+	ReadFile(filePath string) ([]byte, error)
+	RemoveAll(path string) error
+}
+
 type ConnectionDetails struct {
 	Host           string
 	Port           string
 	APIKey         string
 	CertificatePEM []byte
+	// ProcessID is the process ID of the MATLAB session, or zero when it is not known.
+	ProcessID int
+	// SessionDirectory is the folder created by the server for the MATLAB session, or empty when the server did not start the session.
+	SessionDirectory string
 }
 
 type Client struct {
@@ -36,14 +51,28 @@ type Client struct {
 	port       string
 	apiKey     string
 	httpClient httpclient.HttpClient
-
-	pingRetry   time.Duration
-	pingTimeout time.Duration
+	osLayer    OSLayer
+
+	// progressStreamSupported is true when the MATLAB functions that write the progress stream are on the MATLAB path,
+	// which is only the case in MATLAB sessions started by the server.
+	progressStreamSupported bool
+
+	// MATLAB queues the requests it receives while it runs code, so a request sent but not answered may still be waiting behind another one.
+	// Evaluations hold evaluationSlot from sending their request until they are done with MATLAB,
+	// so that interrupting MATLAB only ever stops the evaluation that asked for it.
+	evaluationSlotOnce sync.Once
+	evaluationSlot     chan struct{}
+
+	pingRetry            time.Duration
+	pingTimeout          time.Duration
+	interruptTimeout     time.Duration
+	progressPollInterval time.Duration
 }
 
 func NewClient(
 	endpoint ConnectionDetails,
 	httpClientFactory HttpClientFactory,
+	osLayer OSLayer,
 ) (*Client, error) {
 	httpClient, err := httpClientFactory.NewClientForSelfSignedTLSServer(endpoint.CertificatePEM)
 	if err != nil {
@@ -55,9 +84,14 @@ func NewClient(
 		port:       endpoint.Port,
 		apiKey:     endpoint.APIKey,
 		httpClient: httpClient,
+		osLayer:    osLayer,
 
-		pingRetry:   defaultPingRetry,
-		pingTimeout: defaultPingTimeout,
+		progressStreamSupported: endpoint.SessionDirectory != "",
+
+		pingRetry:            defaultPingRetry,
+		pingTimeout:          defaultPingTimeout,
+		interruptTimeout:     defaultInterruptTimeout,
+		progressPollInterval: defaultProgressPollInterval,
 	}, nil
 }
 
@@ -69,18 +103,39 @@ func (c *Client) SetPingRetry(retry time.Duration) {
 	c.pingRetry = retry
 }
 
+func (c *Client) SetInterruptTimeout(timeout time.Duration) {
+	c.interruptTimeout = timeout
+}
+
+func (c *Client) SetProgressPollInterval(interval time.Duration) {
+	c.progressPollInterval = interval
+}
+
 func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	progressStream := c.startProgressStream(ctx, logger, true)
+	defer progressStream.stop()
+
 	payload := ConnectorPayload{
 		Messages: ConnectorMessage{
 			Eval: []EvalMessage{
 				{
-					Code: input.Code,
+					Code: progressStream.evalCode(input.Code),
 				},
 			},
 		},
 	}
 
-	response, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	response, timedOut, err := c.evaluate(ctx, logger, payload, input.Timeout)
+	if timedOut {
+		partialResponse := entities.EvalResponse{}
+		if err == nil && len(response.Messages.EvalResponse) > 0 {
+			partialResponse.ConsoleOutput = response.Messages.EvalResponse[0].ResponseStr
+		}
+		return entities.EvalResponse{}, &entities.EvalTimeoutError{
+			Timeout:         input.Timeout,
+			PartialResponse: partialResponse,
+		}
+	}
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
@@ -102,13 +157,29 @@ func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entitie
 }
 
 func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	// The Live Editor captures the console output, so it never reaches the diary that mirrors it.
+	progressStream := c.startProgressStream(ctx, logger, false)
+	defer progressStream.stop()
+
 	fevalRequest := entities.FEvalRequest{
 		Function:   "matlab_mcp.mcpEval",
-		Arguments:  []string{input.Code},
+		Arguments:  progressStream.mcpEvalArguments(input.Code),
 		NumOutputs: 1,
 	}
 
-	response, err := c.FEval(ctx, logger, fevalRequest)
+	response, timedOut, err := c.fEval(ctx, logger, fevalRequest, input.Timeout)
+	if timedOut {
+		partialResponse := entities.EvalResponse{}
+		if err == nil {
+			if parsedResponse, parseErr := parseEvalWithCaptureResponse(response); parseErr == nil {
+				partialResponse = parsedResponse
+			}
+		}
+		return entities.EvalResponse{}, &entities.EvalTimeoutError{
+			Timeout:         input.Timeout,
+			PartialResponse: partialResponse,
+		}
+	}
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
@@ -122,6 +193,11 @@ func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, in
 }
 
 func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entities.FEvalRequest) (entities.FEvalResponse, error) {
+	response, _, err := c.fEval(ctx, logger, input, 0)
+	return response, err
+}
+
+func (c *Client) fEval(ctx context.Context, logger entities.Logger, input entities.FEvalRequest, timeout time.Duration) (entities.FEvalResponse, bool, error) {
 	payload := ConnectorPayload{
 		Messages: ConnectorMessage{
 			FEval: []FevalMessage{
@@ -134,22 +210,22 @@ func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entiti
 		},
 	}
 
-	response, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	response, timedOut, err := c.evaluate(ctx, logger, payload, timeout)
 	if err != nil {
-		return entities.FEvalResponse{}, err
+		return entities.FEvalResponse{}, timedOut, err
 	}
 
 	if len(response.Messages.FevalResponse) == 0 {
 		logger.
 			Debug("No FEvalResponse messages received")
-		return entities.FEvalResponse{}, fmt.Errorf("no response messages received")
+		return entities.FEvalResponse{}, timedOut, fmt.Errorf("no response messages received")
 	}
 
 	if response.Messages.FevalResponse[0].IsError {
 		if len(response.Messages.FevalResponse[0].MessageFaults) == 0 {
 			logger.
 				Debug("Response was in error state but no fault messages received")
-			return entities.FEvalResponse{}, fmt.Errorf("response was in error state but no fault messages received")
+			return entities.FEvalResponse{}, timedOut, fmt.Errorf("response was in error state but no fault messages received")
 		}
 
 		var errorMessage string
@@ -162,12 +238,12 @@ func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entiti
 			}
 			errorMessage += f.Message + "\n\n"
 		}
-		return entities.FEvalResponse{}, newMATLABError(errorMessage)
+		return entities.FEvalResponse{}, timedOut, newMATLABError(errorMessage)
 	}
 
 	return entities.FEvalResponse{
 		Outputs: response.Messages.FevalResponse[0].Results,
-	}, nil
+	}, timedOut, nil
 }
 
 func (m *Client) Ping(ctx context.Context, sessionLogger entities.Logger) entities.PingResponse {
@@ -212,21 +288,185 @@ func (c *Client) pingMATLAB(ctx context.Context, logger entities.Logger) (bool,
 
 	messageFaults := response.Messages.PingResponse[0].MessageFaults
 	if len(messageFaults) > 0 {
-		var errorMessage strings.Builder
-		for _, rawFault := range response.Messages.PingResponse[0].MessageFaults {
-			var f Fault
-			if err := json.Unmarshal(rawFault, &f); err != nil {
-				logger.
-					WithError(err).
-					Debug("Failed to deserialize fault message into a fault")
+		return false, faultsToMATLABError(logger, messageFaults)
+	}
+
+	return true, nil
+}
+
+// interruptAndWaitForIdle asks MATLAB to stop the code it is running, then waits for MATLAB to respond to Ping again.
+// The original context is already cancelled by the time this is called, so a detached context bounds the interrupt.
+// interruptAndWaitForIdle reports whether MATLAB accepted the interrupt and responded to Ping afterwards,
+// as MATLAB may still be running the code when it does not respond.
+func (c *Client) interruptAndWaitForIdle(ctx context.Context, logger entities.Logger) bool {
+	interruptCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.interruptTimeout)
+	defer cancel()
+
+	logger.Info("Evaluation cancelled, interrupting MATLAB")
+
+	if err := c.interruptMATLAB(interruptCtx, logger); err != nil {
+		logger.WithError(err).Warn("Failed to interrupt MATLAB")
+		return false
+	}
+
+	if !c.Ping(interruptCtx, logger).IsAlive {
+		logger.Warn("MATLAB did not respond after interrupt")
+		return false
+	}
+
+	logger.Debug("MATLAB is idle after interrupt")
+	return true
+}
+
+func (c *Client) interruptMATLAB(ctx context.Context, logger entities.Logger) error {
+	payload := ConnectorPayload{
+		Messages: ConnectorMessage{
+			Interrupt: []InterruptMessage{{}},
+		},
+	}
+
+	response, err := c.sendRequestToStateEndpoint(ctx, logger, payload)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Messages.InterruptResponse) == 0 {
+		return fmt.Errorf("no response messages received")
+	}
+
+	messageFaults := response.Messages.InterruptResponse[0].MessageFaults
+	if len(messageFaults) > 0 {
+		return faultsToMATLABError(logger, messageFaults)
+	}
+
+	return nil
+}
+
+func faultsToMATLABError(logger entities.Logger, messageFaults []json.RawMessage) error {
+	var errorMessage strings.Builder
+	for _, rawFault := range messageFaults {
+		var f Fault
+		if err := json.Unmarshal(rawFault, &f); err != nil {
+			logger.
+				WithError(err).
+				Debug("Failed to deserialize fault message into a fault")
+		}
+		errorMessage.WriteString(f.Message)
+		errorMessage.WriteString("\n\n")
+	}
+	return newMATLABError(errorMessage.String())
+}
+
+// evaluate sends payload to the evaluation endpoint and reports whether the timeout expired.
+// When the timeout expires while MATLAB runs the request, MATLAB is interrupted while the request stays open, so MATLAB can still return the output it produced.
+func (c *Client) evaluate(ctx context.Context, logger entities.Logger, payload ConnectorPayload, timeout time.Duration) (ConnectorPayload, bool, error) {
+	requestCtx, cancelRequest := context.WithCancel(ctx)
+	defer cancelRequest()
+
+	// MATLAB only runs the code once the request is written, and answers once it is done.
+	// Track both, so that a cancellation only interrupts MATLAB while it runs this request.
+	var requestSent, responseReceived atomic.Bool
+	requestCtx = httptrace.WithClientTrace(requestCtx, &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				requestSent.Store(true)
 			}
-			errorMessage.WriteString(f.Message)
-			errorMessage.WriteString("\n\n")
+		},
+		GotFirstResponseByte: func() {
+			responseReceived.Store(true)
+		},
+	})
+
+	var timedOut, abandoned atomic.Bool
+	var timer *time.Timer
+	interruptDone := make(chan struct{})
+
+	if timeout > 0 {
+		timer = time.AfterFunc(timeout, func() {
+			defer close(interruptDone)
+			switch {
+			case responseReceived.Load():
+				// MATLAB is already returning its answer, so let it complete.
+			case !requestSent.Load():
+				// MATLAB may be running another evaluation, which must not be interrupted, so abandon the request instead.
+				abandoned.Store(true)
+				logger.With("timeout", timeout).Info("Evaluation timed out before reaching MATLAB")
+				cancelRequest()
+			default:
+				timedOut.Store(true)
+				c.interruptOnTimeout(requestCtx, logger, timeout, cancelRequest)
+			}
+		})
+	}
+
+	release, err := c.acquireEvaluationSlot(requestCtx)
+	if err != nil {
+		// Waiting for another evaluation used up the timeout, or the caller cancelled, before the request was sent.
+		if timer != nil && !timer.Stop() {
+			<-interruptDone
+		}
+		if ctx.Err() != nil {
+			return ConnectorPayload{}, false, &entities.EvalCancelledError{}
 		}
-		return false, newMATLABError(errorMessage.String())
+		return ConnectorPayload{}, false, &entities.EvalTimeoutError{Timeout: timeout, NotStarted: true}
 	}
+	defer release()
 
-	return true, nil
+	response, err := c.sendRequestToEvaluationEndpoint(requestCtx, logger, payload)
+
+	if timer != nil && !timer.Stop() {
+		// Do not let a late interrupt leak into the next evaluation.
+		cancelRequest()
+		<-interruptDone
+	}
+
+	if err != nil && ctx.Err() != nil {
+		// Abandoning the HTTP request leaves MATLAB running the code, so interrupt it explicitly.
+		// When the request never reached MATLAB, MATLAB may be running another evaluation, which must not be interrupted.
+		interrupted := false
+		if requestSent.Load() && !responseReceived.Load() {
+			interrupted = c.interruptAndWaitForIdle(ctx, logger)
+		}
+		return ConnectorPayload{}, false, &entities.EvalCancelledError{Interrupted: interrupted}
+	}
+
+	if abandoned.Load() {
+		return ConnectorPayload{}, false, &entities.EvalTimeoutError{Timeout: timeout, NotStarted: true}
+	}
+
+	return response, timedOut.Load(), err
+}
+
+// acquireEvaluationSlot waits until no other evaluation of this client is in MATLAB's hands, and returns the function that lets the next one through.
+func (c *Client) acquireEvaluationSlot(ctx context.Context) (func(), error) {
+	c.evaluationSlotOnce.Do(func() {
+		c.evaluationSlot = make(chan struct{}, 1)
+	})
+
+	select {
+	case c.evaluationSlot <- struct{}{}:
+		return func() { <-c.evaluationSlot }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) interruptOnTimeout(requestCtx context.Context, logger entities.Logger, timeout time.Duration, cancelRequest context.CancelFunc) {
+	logger.With("timeout", timeout).Info("Evaluation timed out, interrupting MATLAB")
+
+	interruptCtx, cancel := context.WithTimeout(context.WithoutCancel(requestCtx), c.interruptTimeout)
+	defer cancel()
+
+	if err := c.interruptMATLAB(interruptCtx, logger); err != nil {
+		logger.WithError(err).Warn("Failed to interrupt MATLAB")
+	}
+
+	// Give MATLAB a chance to return the interrupted request, then stop waiting for it.
+	select {
+	case <-requestCtx.Done():
+	case <-interruptCtx.Done():
+		cancelRequest()
+	}
 }
 
 func (c *Client) sendRequestToEvaluationEndpoint(ctx context.Context, logger entities.Logger, payload ConnectorPayload) (ConnectorPayload, error) {
@@ -9,3 +9,11 @@ import (
 func (c *Client) SetHttpClient(httpClient httpclient.HttpClient) {
 	c.httpClient = httpClient
 }
+
+func (c *Client) SetOSLayer(osLayer OSLayer) {
+	c.osLayer = osLayer
+}
+
+func (c *Client) SetProgressStreamSupported(progressStreamSupported bool) {
+	c.progressStreamSupported = progressStreamSupported
+}
@@ -0,0 +1,409 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/http/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Eval_Cancelled_InterruptsMATLAB(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isPingRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			PingResponse: []embeddedconnector.PingResponseMessage{{}},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	response, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "while true, end"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, true)
+	assert.Empty(t, response)
+	assert.Contains(t, mockLogger.InfoLogs(), "Evaluation cancelled, interrupting MATLAB")
+	assert.Contains(t, mockLogger.DebugLogs(), "MATLAB is idle after interrupt")
+}
+
+func TestClient_FEval_Cancelled_InterruptsMATLAB(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isPingRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			PingResponse: []embeddedconnector.PingResponseMessage{{}},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	response, err := client.FEval(ctx, mockLogger, entities.FEvalRequest{Function: "pause", Arguments: []string{"100"}})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, true)
+	assert.Empty(t, response)
+}
+
+func TestClient_Eval_Cancelled_InterruptFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(nil, assert.AnError).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "while true, end"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to interrupt MATLAB")
+}
+
+func TestClient_Eval_Cancelled_InterruptFaults(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	fault, err := json.Marshal(embeddedconnector.Fault{Message: "interrupt rejected"})
+	require.NoError(t, err)
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{
+				{MessageFaults: []json.RawMessage{fault}},
+			},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "while true, end"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	require.Contains(t, mockLogger.WarnLogs(), "Failed to interrupt MATLAB")
+	assert.Contains(t, mockLogger.WarnLogs()["Failed to interrupt MATLAB"]["error"].(error).Error(), "interrupt rejected")
+}
+
+func TestClient_Eval_Cancelled_MATLABDoesNotRespond_NotReportedAsInterrupted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isPingRequest)).
+		Return(nil, assert.AnError)
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "while true, end"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	assert.Contains(t, mockLogger.WarnLogs(), "MATLAB did not respond after interrupt")
+}
+
+func TestClient_Eval_CancelledBeforeRequestSent_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(*http.Request) { cancel() }).
+		Return(nil, context.Canceled).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation cancelled, interrupting MATLAB")
+}
+
+func TestClient_Eval_CancelledAfterResponseReceived_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Run(func(req *http.Request) {
+			simulateRequestSent(req)
+			httptrace.ContextClientTrace(req.Context()).GotFirstResponseByte()
+			cancel()
+		}).
+		Return(nil, context.Canceled).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation cancelled, interrupting MATLAB")
+}
+
+func TestClient_Eval_ErrorWithoutCancellation_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Return(nil, assert.AnError).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.Error(t, err)
+	require.NotErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation cancelled, interrupting MATLAB")
+}
+
+func TestClient_Eval_CancelledWhileAnotherEvaluationRuns_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	firstRequestSent := make(chan struct{})
+	finishFirstEvaluation := make(chan struct{})
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			close(firstRequestSent)
+			<-finishFirstEvaluation
+			return connectorResponse(t, embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{{ResponseStr: "done"}},
+			}), nil
+		}).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	firstResult := make(chan error, 1)
+	go func() {
+		_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "pause(1)"})
+		firstResult <- err
+	}()
+	<-firstRequestSent
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "while true, end"})
+	close(finishFirstEvaluation)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrEvaluationCancelled)
+	assertInterrupted(t, err, false)
+	require.NoError(t, <-firstResult)
+	mockHttpClient.AssertNotCalled(t, "Do", mock.MatchedBy(isInterruptRequest))
+}
+
+func newClientForInterruptTests(httpClient *httpclientmocks.MockHttpClient) *embeddedconnector.Client {
+	client := &embeddedconnector.Client{}
+	client.SetHttpClient(httpClient)
+	client.SetPingRetry(10 * time.Millisecond)
+	client.SetPingTimeout(100 * time.Millisecond)
+	client.SetInterruptTimeout(time.Second)
+	return client
+}
+
+// simulateRequestSent reports to the client that req was written to MATLAB, as the HTTP transport does.
+func simulateRequestSent(req *http.Request) {
+	httptrace.ContextClientTrace(req.Context()).WroteRequest(httptrace.WroteRequestInfo{})
+}
+
+func connectorResponse(t *testing.T, messages embeddedconnector.ConnectorMessage) *http.Response {
+	responseBody, err := json.Marshal(embeddedconnector.ConnectorPayload{Messages: messages})
+	require.NoError(t, err)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(responseBody)),
+	}
+}
+
+func isEvalRequest(req *http.Request) bool {
+	payload, ok := parseConnectorRequest(req)
+	return ok && len(payload.Messages.Eval) == 1
+}
+
+func isFEvalRequest(req *http.Request) bool {
+	payload, ok := parseConnectorRequest(req)
+	return ok && len(payload.Messages.FEval) == 1
+}
+
+func isInterruptRequest(req *http.Request) bool {
+	payload, ok := parseConnectorRequest(req)
+	return ok && len(payload.Messages.Interrupt) == 1 && req.URL.Path == "/messageservice/json/state"
+}
+
+func isPingRequest(req *http.Request) bool {
+	payload, ok := parseConnectorRequest(req)
+	return ok && len(payload.Messages.Ping) == 1
+}
+
+func assertInterrupted(t *testing.T, err error, expected bool) {
+	t.Helper()
+
+	var cancelledErr *entities.EvalCancelledError
+	require.ErrorAs(t, err, &cancelledErr)
+	assert.Equal(t, expected, cancelledErr.Interrupted)
+}
@@ -0,0 +1,390 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/http/client"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_EvalWithCapture_ForwardsProgressStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	const streamFolder = "/tmp/matlab-mcp-progress-123"
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-progress-").
+		Return(streamFolder, nil).
+		Once()
+
+	// MATLAB streams to the folder for the duration of the evaluation request, so no other request is sent.
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequestFor("matlab_mcp.mcpEval", "simulate()", streamFolder))).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(buildEvalWithCaptureResponse(t, []embeddedconnector.LiveEditorResponseEntry{}))),
+		}, nil).
+		Once()
+
+	// The last line of the file is still being written, so only the complete lines are forwarded.
+	// The Live Editor captures the console output, so it is not mirrored.
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(streamFolder, "events.jsonl")).
+		Return([]byte(`{"progress":0.5,"message":"halfway"}`+"\n"+`{"progress":0.7`), nil)
+
+	mockReporter.EXPECT().
+		ReportProgress(ctx, 0.5, "halfway").
+		Return().
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(streamFolder).
+		Return(nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	_, err := client.EvalWithCapture(ctx, mockLogger, entities.EvalRequest{Code: "simulate()"})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestClient_Eval_ForwardsProgressStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	const streamFolder = "/tmp/matlab-mcp-progress-456"
+	const expectedOutput = "done"
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockReporter.EXPECT().
+		WantsConsoleOutput().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-progress-").
+		Return(streamFolder, nil).
+		Once()
+
+	// The code is passed base64 encoded, so that it needs no escaping.
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequestFor("matlab_mcp.evalWithProgressStream('/tmp/matlab-mcp-progress-456', true, 'c2ltdWxhdGUoKQ==')"))).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			EvalResponse: []embeddedconnector.EvalResponseMessage{
+				{IsError: false, ResponseStr: expectedOutput},
+			},
+		}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(streamFolder, "console.log")).
+		Return([]byte("step 1\n"), nil)
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(streamFolder, "events.jsonl")).
+		Return(nil, os.ErrNotExist)
+
+	mockReporter.EXPECT().
+		ReportConsoleOutput(ctx, "step 1").
+		Return().
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(streamFolder).
+		Return(nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	response, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "simulate()"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, response.ConsoleOutput)
+}
+
+func TestClient_Eval_ReporterDoesNotWantConsoleOutput_DoesNotMirrorConsole(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	const streamFolder = "/tmp/matlab-mcp-progress-654"
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockReporter.EXPECT().
+		WantsConsoleOutput().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-progress-").
+		Return(streamFolder, nil).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequestFor("matlab_mcp.evalWithProgressStream('/tmp/matlab-mcp-progress-654', false, 'c2ltdWxhdGUoKQ==')"))).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			EvalResponse: []embeddedconnector.EvalResponseMessage{
+				{IsError: false, ResponseStr: "done"},
+			},
+		}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(streamFolder, "events.jsonl")).
+		Return([]byte(`{"progress":0.25,"message":"quarter"}`+"\n"), nil)
+
+	mockReporter.EXPECT().
+		ReportProgress(ctx, 0.25, "quarter").
+		Return().
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(streamFolder).
+		Return(nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "simulate()"})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestClient_Eval_EvaluationFails_StopsProgressStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	const streamFolder = "/tmp/matlab-mcp-progress-O'Brien"
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockReporter.EXPECT().
+		WantsConsoleOutput().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-progress-").
+		Return(streamFolder, nil).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequestFor("matlab_mcp.evalWithProgressStream('/tmp/matlab-mcp-progress-O''Brien', false, 'ZXJyb3IoJ2ZhaWxlZCcp')"))).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			EvalResponse: []embeddedconnector.EvalResponseMessage{
+				{IsError: true, ResponseStr: "failed"},
+			},
+		}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(streamFolder, "events.jsonl")).
+		Return(nil, os.ErrNotExist)
+
+	mockOSLayer.EXPECT().
+		RemoveAll(streamFolder).
+		Return(nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "error('failed')"})
+
+	// Assert
+	require.ErrorContains(t, err, "failed")
+}
+
+func TestClient_EvalWithCapture_MkdirTempFails_EvaluatesWithoutStreaming(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-progress-").
+		Return("", assert.AnError).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequestFor("matlab_mcp.mcpEval", "ver"))).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(buildEvalWithCaptureResponse(t, []embeddedconnector.LiveEditorResponseEntry{}))),
+		}, nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	_, err := client.EvalWithCapture(ctx, mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to create progress stream folder")
+}
+
+func TestClient_EvalWithCapture_NoReporter_DoesNotStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequestFor("matlab_mcp.mcpEval", "ver"))).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(buildEvalWithCaptureResponse(t, []embeddedconnector.LiveEditorResponseEntry{}))),
+		}, nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+
+	// Act
+	_, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestClient_EvalWithCapture_ProgressStreamNotSupported_DoesNotStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReporter := &entitiesmocks.MockEvalProgressReporter{}
+	defer mockReporter.AssertExpectations(t)
+
+	ctx := entities.ContextWithEvalProgressReporter(t.Context(), mockReporter)
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequestFor("matlab_mcp.mcpEval", "ver"))).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(buildEvalWithCaptureResponse(t, []embeddedconnector.LiveEditorResponseEntry{}))),
+		}, nil).
+		Once()
+
+	client := newClientForProgressStreamTests(mockHttpClient, mockOSLayer)
+	client.SetProgressStreamSupported(false)
+
+	// Act
+	_, err := client.EvalWithCapture(ctx, mockLogger, entities.EvalRequest{Code: "ver"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotContains(t, mockLogger.WarnLogs(), "Failed to start MATLAB progress stream")
+}
+
+func newClientForProgressStreamTests(httpClient *httpclientmocks.MockHttpClient, osLayer *mocks.MockOSLayer) *embeddedconnector.Client {
+	client := newClientForInterruptTests(httpClient)
+	client.SetOSLayer(osLayer)
+	client.SetProgressStreamSupported(true)
+	client.SetProgressPollInterval(time.Millisecond)
+	return client
+}
+
+func isEvalRequestFor(code string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		payload, ok := parseConnectorRequest(req)
+		return ok && len(payload.Messages.Eval) == 1 && payload.Messages.Eval[0].Code == code
+	}
+}
+
+func isFEvalRequestFor(function string, arguments ...string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		payload, ok := parseConnectorRequest(req)
+		if !ok || len(payload.Messages.FEval) != 1 {
+			return false
+		}
+
+		feval := payload.Messages.FEval[0]
+		if feval.Function != function || len(feval.Arguments) != len(arguments) {
+			return false
+		}
+
+		for i, argument := range arguments {
+			if feval.Arguments[i] != argument {
+				return false
+			}
+		}
+
+		return true
+	}
+}
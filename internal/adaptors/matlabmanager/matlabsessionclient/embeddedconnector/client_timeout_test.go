@@ -0,0 +1,325 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/http/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Eval_Timeout_ReturnsPartialOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+	const partialOutput = "Operation terminated by user during loop"
+
+	interrupted := make(chan struct{})
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			<-interrupted
+			return connectorResponse(t, embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{
+					{IsError: true, ResponseStr: partialOutput},
+				},
+			}), nil
+		}).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Run(func(*http.Request) { close(interrupted) }).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	response, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "while true, end", Timeout: expectedTimeout})
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, expectedTimeout, timeoutErr.Timeout)
+	assert.Equal(t, partialOutput, timeoutErr.PartialResponse.ConsoleOutput)
+	assert.Contains(t, err.Error(), partialOutput)
+	assert.Empty(t, response)
+	assert.Contains(t, mockLogger.InfoLogs(), "Evaluation timed out, interrupting MATLAB")
+}
+
+func TestClient_EvalWithCapture_Timeout_ReturnsPartialOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+
+	interrupted := make(chan struct{})
+
+	entries := []embeddedconnector.LiveEditorResponseEntry{
+		{
+			Type: "stream",
+			Content: struct {
+				Text string `json:"text"`
+				Name string `json:"name"`
+			}{
+				Text: "iteration 1",
+				Name: "stdout",
+			},
+		},
+		{
+			Type: "stream",
+			Content: struct {
+				Text string `json:"text"`
+				Name string `json:"name"`
+			}{
+				Text: "Operation terminated by user.",
+				Name: "stderr",
+			},
+		},
+	}
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isFEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			<-interrupted
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(buildEvalWithCaptureResponse(t, entries))),
+			}, nil
+		}).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Run(func(*http.Request) { close(interrupted) }).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "for i = 1:Inf, disp(i), end", Timeout: expectedTimeout})
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, expectedTimeout, timeoutErr.Timeout)
+	assert.Equal(t, "iteration 1\nOperation terminated by user.", timeoutErr.PartialResponse.ConsoleOutput)
+}
+
+func TestClient_Eval_Timeout_MATLABDoesNotReturn(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}).
+		Once()
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isInterruptRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+	client.SetInterruptTimeout(50 * time.Millisecond)
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "while true, end", Timeout: expectedTimeout})
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Empty(t, timeoutErr.PartialResponse.ConsoleOutput)
+	assert.Equal(t, "evaluation timed out after 20ms and MATLAB execution was interrupted", err.Error())
+}
+
+func TestClient_Eval_CompletesBeforeTimeout(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedOutput = "ans = 2"
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		Return(connectorResponse(t, embeddedconnector.ConnectorMessage{
+			EvalResponse: []embeddedconnector.EvalResponseMessage{
+				{IsError: false, ResponseStr: expectedOutput},
+			},
+		}), nil).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	response, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "1 + 1", Timeout: time.Minute})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, response.ConsoleOutput)
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation timed out, interrupting MATLAB")
+}
+
+func TestClient_Eval_TimeoutBeforeRequestSent_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "while true, end", Timeout: expectedTimeout})
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, &entities.EvalTimeoutError{Timeout: expectedTimeout, NotStarted: true}, timeoutErr, "The timeout should carry no partial response")
+	assert.Contains(t, mockLogger.InfoLogs(), "Evaluation timed out before reaching MATLAB")
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation timed out, interrupting MATLAB")
+	mockHttpClient.AssertNotCalled(t, "Do", mock.MatchedBy(isInterruptRequest))
+}
+
+func TestClient_Eval_TimeoutWhileAnotherEvaluationRuns_DoesNotInterrupt(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+
+	firstRequestSent := make(chan struct{})
+	finishFirstEvaluation := make(chan struct{})
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			close(firstRequestSent)
+			<-finishFirstEvaluation
+			return connectorResponse(t, embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{{ResponseStr: "done"}},
+			}), nil
+		}).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	firstResult := make(chan error, 1)
+	go func() {
+		_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "pause(1)"})
+		firstResult <- err
+	}()
+	<-firstRequestSent
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "while true, end", Timeout: expectedTimeout})
+	close(finishFirstEvaluation)
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, &entities.EvalTimeoutError{Timeout: expectedTimeout, NotStarted: true}, timeoutErr, "The timeout should carry no partial response")
+	require.NoError(t, <-firstResult)
+	assert.NotContains(t, mockLogger.InfoLogs(), "Evaluation timed out, interrupting MATLAB")
+	mockHttpClient.AssertNotCalled(t, "Do", mock.MatchedBy(isInterruptRequest))
+}
+
+func TestClient_EvalWithCapture_TimeoutWhileAnotherEvaluationRuns_ReturnsTimeoutError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	const expectedTimeout = 20 * time.Millisecond
+
+	firstRequestSent := make(chan struct{})
+	finishFirstEvaluation := make(chan struct{})
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(isEvalRequest)).
+		RunAndReturn(func(req *http.Request) (*http.Response, error) {
+			simulateRequestSent(req)
+			close(firstRequestSent)
+			<-finishFirstEvaluation
+			return connectorResponse(t, embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{{ResponseStr: "done"}},
+			}), nil
+		}).
+		Once()
+
+	client := newClientForInterruptTests(mockHttpClient)
+
+	firstResult := make(chan error, 1)
+	go func() {
+		_, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "pause(1)"})
+		firstResult <- err
+	}()
+	<-firstRequestSent
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "while true, end", Timeout: expectedTimeout})
+	close(finishFirstEvaluation)
+
+	// Assert
+	var timeoutErr *entities.EvalTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, expectedTimeout, timeoutErr.Timeout)
+	assert.True(t, timeoutErr.NotStarted, "The code should be reported as not run")
+	assert.Empty(t, timeoutErr.PartialResponse, "There should be no partial response, as MATLAB did not run the code")
+	assert.Empty(t, response)
+	require.NoError(t, <-firstResult)
+	mockHttpClient.AssertNotCalled(t, "Do", mock.MatchedBy(isInterruptRequest))
+}
@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package embeddedconnector
 
@@ -21,6 +21,9 @@ type ConnectorMessage struct {
 	FevalResponse []FevalResponseMessage `json:"FEvalResponse,omitempty"`
 	Ping          []PingMessage          `json:"Ping,omitempty"`
 	PingResponse  []PingResponseMessage  `json:"PingResponse,omitempty"`
+
+	Interrupt         []InterruptMessage         `json:"Interrupt,omitempty"`
+	InterruptResponse []InterruptResponseMessage `json:"InterruptResponse,omitempty"`
 }
 
 type EvalMessage struct {
@@ -52,6 +55,13 @@ type PingResponseMessage struct {
 	MessageFaults []json.RawMessage `json:"messageFaults"`
 }
 
+type InterruptMessage struct {
+}
+
+type InterruptResponseMessage struct {
+	MessageFaults []json.RawMessage `json:"messageFaults"`
+}
+
 type Fault struct {
 	Message string `json:"message"`
 }
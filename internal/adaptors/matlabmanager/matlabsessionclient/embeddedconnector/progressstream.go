@@ -0,0 +1,180 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
+)
+
+const (
+	progressStreamFolderPattern = "matlab-mcp-progress-"
+	progressEventsFileName      = "events.jsonl"
+	progressConsoleFileName     = "console.log"
+)
+
+// progressEvent is one line of the events file written by matlab_mcp.reportProgress.
+type progressEvent struct {
+	Progress float64 `json:"progress"`
+	Message  string  `json:"message"`
+}
+
+// progressStream is the folder that MATLAB streams the updates of one evaluation to.
+// An empty folder means that nothing is streamed.
+type progressStream struct {
+	folder        string
+	mirrorConsole bool
+	stop          func()
+}
+
+// startProgressStream creates a temporary folder for MATLAB to write progress updates to, and console output when mirrorConsole is true
+// and the reporter wants it, and forwards whatever appears there to the reporter carried by ctx until stop is called.
+// MATLAB only streams to the folder while it evaluates the request that names it, and cannot answer requests meanwhile, so the folder is polled.
+func (c *Client) startProgressStream(ctx context.Context, logger entities.Logger, mirrorConsole bool) progressStream {
+	reporter := entities.EvalProgressReporterFromContext(ctx)
+	if reporter == nil || c.osLayer == nil || !c.progressStreamSupported {
+		return progressStream{stop: func() {}}
+	}
+
+	streamFolder, err := c.osLayer.MkdirTemp("", progressStreamFolderPattern)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create progress stream folder")
+		return progressStream{stop: func() {}}
+	}
+
+	mirrorConsole = mirrorConsole && reporter.WantsConsoleOutput()
+
+	tailer := &progressStreamTailer{
+		osLayer:    c.osLayer,
+		eventsPath: filepath.Join(streamFolder, progressEventsFileName),
+	}
+	if mirrorConsole {
+		tailer.consolePath = filepath.Join(streamFolder, progressConsoleFileName)
+	}
+
+	stopPolling := make(chan struct{})
+	pollingDone := make(chan struct{})
+
+	go func() {
+		defer close(pollingDone)
+
+		ticker := time.NewTicker(c.progressPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopPolling:
+				return
+			case <-ticker.C:
+				tailer.forward(ctx, logger, reporter)
+			}
+		}
+	}()
+
+	return progressStream{
+		folder:        streamFolder,
+		mirrorConsole: mirrorConsole,
+		stop: func() {
+			close(stopPolling)
+			<-pollingDone
+
+			// Forward anything written after the last poll.
+			tailer.forward(ctx, logger, reporter)
+
+			c.removeProgressStreamFolder(logger, streamFolder)
+		},
+	}
+}
+
+// evalCode returns code that evaluates code in the base workspace while MATLAB streams to the folder.
+// The stream ends with the evaluation, also when the code errors or is interrupted.
+func (s progressStream) evalCode(code string) string {
+	if s.folder == "" {
+		return code
+	}
+
+	return fmt.Sprintf(
+		"matlab_mcp.evalWithProgressStream('%s', %t, '%s')",
+		matlabstring.EscapeSingleQuotes(s.folder),
+		s.mirrorConsole,
+		base64.StdEncoding.EncodeToString([]byte(code)),
+	)
+}
+
+// mcpEvalArguments returns the arguments of matlab_mcp.mcpEval, which streams to the folder while it evaluates code.
+func (s progressStream) mcpEvalArguments(code string) []string {
+	if s.folder == "" {
+		return []string{code}
+	}
+
+	return []string{code, s.folder}
+}
+
+func (c *Client) removeProgressStreamFolder(logger entities.Logger, streamFolder string) {
+	if err := c.osLayer.RemoveAll(streamFolder); err != nil {
+		logger.WithError(err).With("folder", streamFolder).Warn("Failed to remove progress stream folder")
+	}
+}
+
+// progressStreamTailer remembers how far each stream file has been read, so every update is forwarded once.
+// Only complete lines are consumed, so a line that MATLAB is still writing is picked up on the next poll.
+type progressStreamTailer struct {
+	osLayer OSLayer
+
+	eventsPath   string
+	eventsOffset int
+
+	// consolePath is empty when the console output is not mirrored.
+	consolePath   string
+	consoleOffset int
+}
+
+func (t *progressStreamTailer) forward(ctx context.Context, logger entities.Logger, reporter entities.EvalProgressReporter) {
+	if t.consolePath != "" {
+		if output := t.readCompleteLines(t.consolePath, &t.consoleOffset); output != "" {
+			reporter.ReportConsoleOutput(ctx, strings.TrimSuffix(output, "\n"))
+		}
+	}
+
+	events := t.readCompleteLines(t.eventsPath, &t.eventsOffset)
+	for _, line := range strings.Split(events, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var event progressEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			logger.WithError(err).Debug("Failed to parse progress event")
+			continue
+		}
+
+		reporter.ReportProgress(ctx, event.Progress, event.Message)
+	}
+}
+
+func (t *progressStreamTailer) readCompleteLines(path string, offset *int) string {
+	content, err := t.osLayer.ReadFile(path)
+	if err != nil || len(content) <= *offset {
+		// The file does not exist until MATLAB writes to it for the first time.
+		return ""
+	}
+
+	lastNewline := bytes.LastIndexByte(content[*offset:], '\n')
+	if lastNewline < 0 {
+		return ""
+	}
+
+	lines := string(content[*offset : *offset+lastNewline+1])
+	*offset += lastNewline + 1
+
+	return lines
+}
@@ -12,18 +12,27 @@ type HttpClientFactory interface {
 	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclient.HttpClient, error)
 }
 
+type OSLayer interface {
+	MkdirTemp(dir string, pattern string) (string, error)
+	ReadFile(filePath string) ([]byte, error)
+	RemoveAll(path string) error
+}
+
 type Factory struct {
 	httpClientFactory HttpClientFactory
+	osLayer           OSLayer
 }
 
 func NewFactory(
 	httpClientFactory HttpClientFactory,
+	osLayer OSLayer,
 ) *Factory {
 	return &Factory{
 		httpClientFactory: httpClientFactory,
+		osLayer:           osLayer,
 	}
 }
 
 func (f *Factory) New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error) {
-	return embeddedconnector.NewClient(endpoint, f.httpClientFactory)
+	return embeddedconnector.NewClient(endpoint, f.httpClientFactory, f.osLayer)
 }
@@ -4,22 +4,86 @@ package matlabmanager
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
-type matlabSessionClientWithoutCleanup struct {
+// exitTimeout bounds how long StopSession waits for MATLAB to answer the request to exit, as an unresponsive MATLAB never does.
+const exitTimeout = 10 * time.Second
+
+// usageTrackingClient records when the MATLAB session was last used, and whether a request is in progress.
+type usageTrackingClient struct {
 	entities.MATLABSessionClient
+
+	l              *sync.Mutex
+	activeRequests int
+	lastUsedAt     time.Time
+}
+
+type matlabSessionClientWithoutCleanup struct {
+	*usageTrackingClient
 }
 
 type matlabSessionClientWithCleanup struct {
-	entities.MATLABSessionClient
+	*usageTrackingClient
 	sessionCleanup func() error
 }
 
+func newUsageTrackingClient(matlabSessionClient entities.MATLABSessionClient) *usageTrackingClient {
+	return &usageTrackingClient{
+		MATLABSessionClient: matlabSessionClient,
+		l:                   new(sync.Mutex),
+	}
+}
+
+func (c *usageTrackingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	defer c.startRequest()()
+	return c.MATLABSessionClient.Eval(ctx, sessionLogger, request)
+}
+
+func (c *usageTrackingClient) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	defer c.startRequest()()
+	return c.MATLABSessionClient.EvalWithCapture(ctx, logger, input)
+}
+
+func (c *usageTrackingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	defer c.startRequest()()
+	return c.MATLABSessionClient.FEval(ctx, sessionLogger, request)
+}
+
+func (c *usageTrackingClient) Usage() matlabsessionstore.Usage {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	return matlabsessionstore.Usage{
+		LastUsedAt: c.lastUsedAt,
+		IsBusy:     c.activeRequests > 0,
+	}
+}
+
+// startRequest marks the session as busy, and returns the function that marks the request as done.
+func (c *usageTrackingClient) startRequest() func() {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	c.activeRequests++
+	c.lastUsedAt = time.Now()
+
+	return func() {
+		c.l.Lock()
+		defer c.l.Unlock()
+
+		c.activeRequests--
+		c.lastUsedAt = time.Now()
+	}
+}
+
 func newMATLABSessionClientWithoutCleanup(matlabSessionClient entities.MATLABSessionClient) *matlabSessionClientWithoutCleanup {
 	return &matlabSessionClientWithoutCleanup{
-		MATLABSessionClient: matlabSessionClient,
+		usageTrackingClient: newUsageTrackingClient(matlabSessionClient),
 	}
 }
 
@@ -30,15 +94,19 @@ func (c *matlabSessionClientWithoutCleanup) StopSession(ctx context.Context, ses
 
 func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error) *matlabSessionClientWithCleanup {
 	return &matlabSessionClientWithCleanup{
-		MATLABSessionClient: matlabSessionClient,
+		usageTrackingClient: newUsageTrackingClient(matlabSessionClient),
 		sessionCleanup:      sessionCleanup,
 	}
 }
 
+// StopSession asks MATLAB to exit, then cleans up the session.
+// The cleanup kills MATLAB when it does not exit on its own, so it runs even when MATLAB does not answer.
 func (c *matlabSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
-	_, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"})
-	if err != nil {
-		return err
+	exitCtx, cancel := context.WithTimeout(ctx, exitTimeout)
+	defer cancel()
+
+	if _, err := c.Eval(exitCtx, sessionLogger, entities.EvalRequest{Code: "exit()"}); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to ask MATLAB to exit")
 	}
 
 	return c.sessionCleanup()
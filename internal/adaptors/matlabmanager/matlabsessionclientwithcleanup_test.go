@@ -3,13 +3,16 @@
 package matlabmanager_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -77,7 +80,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_HappyPath(t *testing.T) {
 	expectedEvalRequest := entities.EvalRequest{Code: "exit()"}
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
+		Eval(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(entities.EvalResponse{}, nil).
 		Once()
 
@@ -109,7 +112,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_EvalError(t *testing.T) {
 	expectedError := assert.AnError
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
+		Eval(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
@@ -119,8 +122,9 @@ func TestMATLABSessionClientWithCleanup_StopSession_EvalError(t *testing.T) {
 	err := client.StopSession(ctx, mockLogger)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError)
-	require.False(t, cleanupCalled, "Cleanup should not be called when eval fails")
+	require.NoError(t, err)
+	require.True(t, cleanupCalled, "Cleanup should be called when eval fails")
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to ask MATLAB to exit")
 }
 
 func TestMATLABSessionClientWithCleanup_StopSession_CleanupError(t *testing.T) {
@@ -139,7 +143,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_CleanupError(t *testing.T) {
 	expectedEvalRequest := entities.EvalRequest{Code: "exit()"}
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
+		Eval(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(entities.EvalResponse{}, nil).
 		Once()
 
@@ -151,3 +155,99 @@ func TestMATLABSessionClientWithCleanup_StopSession_CleanupError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedCleanupError)
 }
+
+func TestMATLABSessionClientWithCleanup_Usage_NotUsed(t *testing.T) {
+	// Arrange
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, func() error { return nil })
+
+	// Act
+	usage := client.Usage()
+
+	// Assert
+	assert.False(t, usage.IsBusy, "A new session should be idle")
+	assert.True(t, usage.LastUsedAt.IsZero(), "A new session should not have been used")
+}
+
+func TestMATLABSessionClientWithoutCleanup_Usage_BusyWhileEvaluating(t *testing.T) {
+	// Arrange
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "pause(1)"}
+
+	client := matlabmanager.NewMATLABSessionClientWithoutCleanup(mockClient)
+
+	var usageWhileEvaluating matlabsessionstore.Usage
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), request).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			usageWhileEvaluating = client.Usage()
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, usageWhileEvaluating.IsBusy, "Session should be busy while evaluating")
+
+	usage := client.Usage()
+	assert.False(t, usage.IsBusy, "Session should be idle once the evaluation is done")
+	assert.False(t, usage.LastUsedAt.IsZero(), "Last used time should be recorded")
+}
+
+func TestMATLABSessionClientWithoutCleanup_Usage_FEvalAndEvalWithCaptureAreTracked(t *testing.T) {
+	// Arrange
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	evalRequest := entities.EvalRequest{Code: "x = 1;"}
+	fevalRequest := entities.FEvalRequest{Function: "disp"}
+
+	client := matlabmanager.NewMATLABSessionClientWithoutCleanup(mockClient)
+
+	busyCount := 0
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), evalRequest).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			if client.Usage().IsBusy {
+				busyCount++
+			}
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), fevalRequest).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.FEvalRequest) {
+			if client.Usage().IsBusy {
+				busyCount++
+			}
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, evalErr := client.EvalWithCapture(ctx, mockLogger, evalRequest)
+	_, fevalErr := client.FEval(ctx, mockLogger, fevalRequest)
+
+	// Assert
+	require.NoError(t, evalErr)
+	require.NoError(t, fevalErr)
+	assert.Equal(t, 2, busyCount, "Session should be busy during both requests")
+	assert.False(t, client.Usage().IsBusy)
+}
+
+func hasDeadline(ctx context.Context) bool {
+	_, ok := ctx.Deadline()
+	return ok
+}
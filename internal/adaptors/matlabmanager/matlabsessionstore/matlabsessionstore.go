@@ -4,14 +4,27 @@ package matlabsessionstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"golang.org/x/sync/errgroup"
 )
 
+var (
+	ErrSessionExpired = errors.New("MATLAB session expired after being idle, start a new session")
+	ErrSessionEvicted = errors.New("MATLAB session was stopped to make room for a new session, start a new session")
+	ErrSessionDied    = errors.New("MATLAB session stopped unexpectedly, start a new session")
+)
+
+// maxEndedSessions bounds how many ended sessions the store remembers. The sessions that ended first are forgotten first.
+const maxEndedSessions = 1000
+
 type LoggerFactory interface {
 	GetGlobalLogger() (entities.Logger, messages.Error)
 }
@@ -19,16 +32,51 @@ type LoggerFactory interface {
 type MATLABSessionClientWithCleanup interface {
 	entities.MATLABSessionClient
 	StopSession(ctx context.Context, sessionLogger entities.Logger) error
+	Usage() Usage
+}
+
+// Usage describes how a MATLAB session client is being used.
+type Usage struct {
+	LastUsedAt time.Time
+	IsBusy     bool
+}
+
+// SessionMetadata is recorded when a MATLAB session is added to the store.
+type SessionMetadata struct {
+	MATLABRoot string
+	Version    string
+	ProcessID  int
+	StartedAt  time.Time
+	// SessionDirectory is the folder holding the logs of a MATLAB session started by the server.
+	SessionDirectory string
+}
+
+// Session is a MATLAB session held by the store.
+type Session struct {
+	ID       entities.SessionID
+	Client   MATLABSessionClientWithCleanup
+	Metadata SessionMetadata
 }
 
 type LifecycleSignaler interface {
 	AddShutdownFunction(shutdownFcn func() error)
 }
 
+// endedSession is remembered for a session that was removed from the store, so that later lookups report why it ended.
+type endedSession struct {
+	err     error
+	endedAt time.Time
+	// logDirectory is the directory that the logs of a dead session were kept in.
+	logDirectory string
+}
+
 type Store struct {
-	l       *sync.RWMutex
-	next    entities.SessionID
-	clients map[entities.SessionID]MATLABSessionClientWithCleanup
+	l        *sync.RWMutex
+	next     entities.SessionID
+	clients  map[entities.SessionID]MATLABSessionClientWithCleanup
+	metadata map[entities.SessionID]SessionMetadata
+	ended    map[entities.SessionID]endedSession
+	reserved int
 }
 
 func New(
@@ -36,23 +84,29 @@ func New(
 	lifecycleSignaler LifecycleSignaler,
 ) *Store {
 	store := &Store{
-		l:       new(sync.RWMutex),
-		next:    1,
-		clients: map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		l:        new(sync.RWMutex),
+		next:     1,
+		clients:  map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		metadata: map[entities.SessionID]SessionMetadata{},
+		ended:    map[entities.SessionID]endedSession{},
 	}
 
 	lifecycleSignaler.AddShutdownFunction(func() error {
-		store.l.Lock()
-		defer store.l.Unlock()
-
 		logger, err := loggerFactory.GetGlobalLogger()
 		if err != nil {
 			return err
 		}
 
+		// Sessions are removed before being stopped, so that their processes exiting is not mistaken for sessions dying.
+		store.l.Lock()
+		clients := store.clients
+		store.clients = map[entities.SessionID]MATLABSessionClientWithCleanup{}
+		store.metadata = map[entities.SessionID]SessionMetadata{}
+		store.l.Unlock()
+
 		wg := new(errgroup.Group)
 
-		for sessionID, client := range store.clients {
+		for sessionID, client := range clients {
 			wg.Go(func() error {
 				err := client.StopSession(context.Background(), logger)
 				if err != nil {
@@ -68,12 +122,13 @@ func New(
 	return store
 }
 
-func (s *Store) Add(client MATLABSessionClientWithCleanup) entities.SessionID {
+func (s *Store) Add(client MATLABSessionClientWithCleanup, metadata SessionMetadata) entities.SessionID {
 	s.l.Lock()
 	defer s.l.Unlock()
 
 	sessionID := s.next
 	s.clients[sessionID] = client
+	s.metadata[sessionID] = metadata
 	s.next++
 	return entities.SessionID(sessionID)
 }
@@ -82,12 +137,42 @@ func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanu
 	s.l.RLock()
 	defer s.l.RUnlock()
 
-	client, exists := s.clients[sessionID]
+	if err := s.checkExists(sessionID); err != nil {
+		return nil, err
+	}
+
+	return s.clients[sessionID], nil
+}
+
+// GetLogDirectory returns the directory holding the logs of the session: its session directory while it runs,
+// or the directory its logs were kept in once it died. Otherwise, it returns the same errors as Get.
+// The directory is empty for sessions that were attached to, as they have no session directory.
+func (s *Store) GetLogDirectory(sessionID entities.SessionID) (string, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	if ended, isEnded := s.ended[sessionID]; isEnded && ended.logDirectory != "" {
+		return ended.logDirectory, nil
+	}
+
+	if err := s.checkExists(sessionID); err != nil {
+		return "", err
+	}
+
+	return s.metadata[sessionID].SessionDirectory, nil
+}
+
+// checkExists must be called with the lock held.
+func (s *Store) checkExists(sessionID entities.SessionID) error {
+	_, exists := s.clients[sessionID]
+	if ended, isEnded := s.ended[sessionID]; !exists && isEnded {
+		return ended.err
+	}
 	if !exists {
-		return nil, fmt.Errorf("session not found: %v", sessionID)
+		return fmt.Errorf("session not found: %v", sessionID)
 	}
 
-	return client, nil
+	return nil
 }
 
 func (s *Store) Remove(sessionID entities.SessionID) {
@@ -95,4 +180,168 @@ func (s *Store) Remove(sessionID entities.SessionID) {
 	defer s.l.Unlock()
 
 	delete(s.clients, sessionID)
+	delete(s.metadata, sessionID)
+}
+
+// Expire removes an idle session from the store, and remembers it so that later lookups report it as expired.
+// The session is kept, and false is returned, when it is busy or was used after lastUsedAt.
+func (s *Store) Expire(sessionID entities.SessionID, lastUsedAt time.Time) bool {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	client, exists := s.clients[sessionID]
+	if !exists {
+		return false
+	}
+
+	if usage := client.Usage(); usage.IsBusy || !usage.LastUsedAt.Equal(lastUsedAt) {
+		return false
+	}
+
+	delete(s.clients, sessionID)
+	delete(s.metadata, sessionID)
+	s.remember(sessionID, endedSession{err: fmt.Errorf("%w: %v", ErrSessionExpired, sessionID)})
+	return true
+}
+
+// MarkDead removes the session from the store, and remembers it so that later lookups report that it died, with the given diagnostics.
+// logDirectory is where the logs of the session were kept, if they were, so that they can still be read.
+func (s *Store) MarkDead(sessionID entities.SessionID, diagnostics string, logDirectory string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	delete(s.clients, sessionID)
+	delete(s.metadata, sessionID)
+	s.remember(sessionID, endedSession{
+		err:          fmt.Errorf("%w: %v: %s", ErrSessionDied, sessionID, diagnostics),
+		logDirectory: logDirectory,
+	})
+}
+
+// ForgetEndedBefore forgets the sessions that ended before cutoff, so that later lookups report them as not found.
+func (s *Store) ForgetEndedBefore(cutoff time.Time) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	maps.DeleteFunc(s.ended, func(_ entities.SessionID, ended endedSession) bool {
+		return ended.endedAt.Before(cutoff)
+	})
+}
+
+// remember must be called with the lock held. It forgets the session that ended first when the store remembers too many.
+func (s *Store) remember(sessionID entities.SessionID, ended endedSession) {
+	ended.endedAt = time.Now()
+	s.ended[sessionID] = ended
+
+	if len(s.ended) <= maxEndedSessions {
+		return
+	}
+
+	firstEndedID := sessionID
+	for endedID, candidate := range s.ended {
+		if candidate.endedAt.Before(s.ended[firstEndedID].endedAt) ||
+			(candidate.endedAt.Equal(s.ended[firstEndedID].endedAt) && endedID < firstEndedID) {
+			firstEndedID = endedID
+		}
+	}
+	delete(s.ended, firstEndedID)
+}
+
+// TryReserve reserves a slot for a MATLAB session that is not in the store yet, such as a starting or pooled session,
+// unless the store already holds, or has reserved slots for, maxSessions sessions. A maxSessions of zero or less means no limit.
+// The slot stays reserved until the returned release function is called.
+func (s *Store) TryReserve(maxSessions int) (func(), bool) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if maxSessions > 0 && len(s.clients)+s.reserved >= maxSessions {
+		return nil, false
+	}
+
+	s.reserved++
+	return sync.OnceFunc(func() {
+		s.l.Lock()
+		defer s.l.Unlock()
+		s.reserved--
+	}), true
+}
+
+// EvictionCandidate is an idle session that may be evicted to make room for a new session, unless it was used after LastUsedAt.
+type EvictionCandidate struct {
+	ID         entities.SessionID
+	LastUsedAt time.Time
+}
+
+// Occupied returns the number of sessions held by the store, plus the number of reserved slots.
+func (s *Store) Occupied() int {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	return len(s.clients) + s.reserved
+}
+
+// ReserveEvicting reserves a slot like TryReserve, counting freedSlots reserved slots as released by the caller,
+// and evicting as few of the candidates as it takes, in the given order. Candidates that are busy or were used again are skipped.
+// Nothing is evicted, and false is returned, when the candidates that are still idle do not make enough room.
+// The caller must release the freed slots once the slot is reserved.
+func (s *Store) ReserveEvicting(maxSessions int, freedSlots int, candidates []EvictionCandidate) (func(), []entities.SessionID, bool) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	needed := len(s.clients) + s.reserved - freedSlots - maxSessions + 1
+	if maxSessions <= 0 {
+		needed = 0
+	}
+
+	var evicted []entities.SessionID
+	for _, candidate := range candidates {
+		if len(evicted) >= needed {
+			break
+		}
+
+		client, exists := s.clients[candidate.ID]
+		if !exists {
+			continue
+		}
+
+		if usage := client.Usage(); usage.IsBusy || !usage.LastUsedAt.Equal(candidate.LastUsedAt) {
+			continue
+		}
+
+		evicted = append(evicted, candidate.ID)
+	}
+
+	if len(evicted) < needed {
+		return nil, nil, false
+	}
+
+	for _, sessionID := range evicted {
+		delete(s.clients, sessionID)
+		delete(s.metadata, sessionID)
+		s.remember(sessionID, endedSession{err: fmt.Errorf("%w: %v", ErrSessionEvicted, sessionID)})
+	}
+
+	s.reserved++
+	return sync.OnceFunc(func() {
+		s.l.Lock()
+		defer s.l.Unlock()
+		s.reserved--
+	}), evicted, true
+}
+
+// List returns the sessions held by the store, ordered by session ID.
+func (s *Store) List() []Session {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	sessions := make([]Session, 0, len(s.clients))
+	for _, sessionID := range slices.Sorted(maps.Keys(s.clients)) {
+		sessions = append(sessions, Session{
+			ID:       sessionID,
+			Client:   s.clients[sessionID],
+			Metadata: s.metadata[sessionID],
+		})
+	}
+
+	return sessions
 }
@@ -0,0 +1,5 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstore
+
+const MaxEndedSessions = maxEndedSessions
@@ -3,7 +3,10 @@
 package matlabsessionstore_test
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -82,8 +85,8 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	store.Add(mockClient1, matlabsessionstore.SessionMetadata{})
+	store.Add(mockClient2, matlabsessionstore.SessionMetadata{})
 
 	// Act
 	err := capturedShutdownFunc()
@@ -92,6 +95,60 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNew_ShutdownFunctionRemovesSessionsBeforeStoppingThem(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	var capturedShutdownFunc func() error
+	var store *matlabsessionstore.Store
+	var sessionsWhileStopping []matlabsessionstore.Session
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mockLogger.AsMockArg()).
+		Run(func(_ context.Context, _ entities.Logger) {
+			sessionsWhileStopping = store.List()
+		}).
+		Return(nil).
+		Once()
+
+	store = matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	require.NotNil(t, capturedShutdownFunc)
+
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, sessionsWhileStopping, "Sessions should be removed from the store before they are stopped")
+
+	_, err = store.Get(sessionID)
+	require.Error(t, err)
+}
+
 func TestNew_GetGlobalLoggerError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -206,8 +263,8 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	store.Add(mockClient1, matlabsessionstore.SessionMetadata{})
+	store.Add(mockClient2, matlabsessionstore.SessionMetadata{})
 
 	// Act
 	err := capturedShutdownFunc()
@@ -235,7 +292,7 @@ func TestStore_Add_HappyPath(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID := store.Add(mockClient)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
 
 	// Assert
 	assert.Equal(t, entities.SessionID(1), sessionID)
@@ -266,9 +323,9 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1 := store.Add(mockClient1, matlabsessionstore.SessionMetadata{})
+	sessionID2 := store.Add(mockClient2, matlabsessionstore.SessionMetadata{})
+	sessionID3 := store.Add(mockClient3, matlabsessionstore.SessionMetadata{})
 
 	// Assert
 	assert.Equal(t, entities.SessionID(1), sessionID1)
@@ -293,7 +350,7 @@ func TestStore_Get_HappyPath(t *testing.T) {
 		Once()
 
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
 
 	// Act
 	retrievedClient, err := store.Get(sessionID)
@@ -329,6 +386,119 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "999")
 }
 
+func TestStore_GetLogDirectory_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	metadata := matlabsessionstore.SessionMetadata{
+		MATLABRoot:       "/path/to/matlab",
+		ProcessID:        1234,
+		SessionDirectory: "/tmp/matlab-session-1234",
+	}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, metadata)
+
+	// Act
+	logDirectory, err := store.GetLogDirectory(sessionID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, metadata.SessionDirectory, logDirectory)
+}
+
+func TestStore_GetLogDirectory_ExpiredSession_ReturnsSessionExpiredError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{ProcessID: 1234})
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{}).
+		Once()
+
+	require.True(t, store.Expire(sessionID, time.Time{}))
+
+	// Act
+	logDirectory, err := store.GetLogDirectory(sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionExpired)
+	assert.Empty(t, logDirectory)
+}
+
+func TestStore_GetLogDirectory_DeadSession_ReturnsKeptLogDirectory(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		keptLogDirectory     string
+		expectedLogDirectory string
+		expectedErr          error
+	}{
+		{name: "logs kept", keptLogDirectory: "/tmp/matlab-session-log-1234", expectedLogDirectory: "/tmp/matlab-session-log-1234"},
+		{name: "logs not kept", keptLogDirectory: "", expectedErr: matlabsessionstore.ErrSessionDied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+			defer mockClient.AssertExpectations(t)
+
+			mockLifecycleSignaler.EXPECT().
+				AddShutdownFunction(mock.AnythingOfType("func() error")).
+				Return().
+				Once()
+
+			store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+			sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{SessionDirectory: "/tmp/matlab-session-1234"})
+			store.MarkDead(sessionID, "MATLAB exited with code 137", tc.keptLogDirectory)
+
+			// Act
+			logDirectory, err := store.GetLogDirectory(sessionID)
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedLogDirectory, logDirectory)
+		})
+	}
+}
+
 func TestStore_Remove_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -346,7 +516,7 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 		Once()
 
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
 
 	// Verify client exists before removal
 	retrievedClient, err := store.Get(sessionID)
@@ -383,6 +553,379 @@ func TestStore_Remove_NonExistentSession_NoError(t *testing.T) {
 	store.Remove(nonExistentSessionID)
 }
 
+func TestStore_Expire_GetReturnsSessionExpiredError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+	lastUsedAt := time.Unix(1767225600, 0)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	// Act
+	expired := store.Expire(sessionID, lastUsedAt)
+
+	// Assert
+	assert.True(t, expired)
+	retrievedClient, err := store.Get(sessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionExpired)
+	assert.Nil(t, retrievedClient)
+	assert.Empty(t, store.List())
+}
+
+func TestStore_Expire_BusySession_KeepsSession(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+	lastUsedAt := time.Unix(1767225600, 0)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt, IsBusy: true}).
+		Once()
+
+	// Act
+	expired := store.Expire(sessionID, lastUsedAt)
+
+	// Assert
+	assert.False(t, expired)
+	retrievedClient, err := store.Get(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, retrievedClient)
+}
+
+func TestStore_Expire_SessionUsedAgain_KeepsSession(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+	lastUsedAt := time.Unix(1767225600, 0)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt.Add(time.Minute)}).
+		Once()
+
+	// Act
+	expired := store.Expire(sessionID, lastUsedAt)
+
+	// Assert
+	assert.False(t, expired)
+	retrievedClient, err := store.Get(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, retrievedClient)
+}
+
+func TestStore_ReserveEvicting_EvictsAsFewCandidatesAsNeeded(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockFirstClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockFirstClient.AssertExpectations(t)
+
+	mockSecondClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSecondClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	firstSessionID := store.Add(mockFirstClient, matlabsessionstore.SessionMetadata{})
+	secondSessionID := store.Add(mockSecondClient, matlabsessionstore.SessionMetadata{})
+	lastUsedAt := time.Unix(1767225600, 0)
+
+	mockFirstClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	candidates := []matlabsessionstore.EvictionCandidate{
+		{ID: firstSessionID, LastUsedAt: lastUsedAt},
+		{ID: secondSessionID, LastUsedAt: lastUsedAt},
+	}
+
+	// Act
+	releaseSlot, evicted, isReserved := store.ReserveEvicting(2, 0, candidates)
+
+	// Assert
+	require.True(t, isReserved)
+	require.NotNil(t, releaseSlot)
+	assert.Equal(t, []entities.SessionID{firstSessionID}, evicted)
+
+	retrievedClient, err := store.Get(firstSessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionEvicted)
+	assert.Nil(t, retrievedClient)
+
+	retrievedClient, err = store.Get(secondSessionID)
+	require.NoError(t, err)
+	assert.Equal(t, mockSecondClient, retrievedClient)
+
+	assert.Equal(t, 2, store.Occupied(), "The remaining session and the reserved slot should be counted")
+	releaseSlot()
+	assert.Equal(t, 1, store.Occupied())
+}
+
+func TestStore_ReserveEvicting_NotEnoughIdleCandidates_EvictsNothing(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockIdleClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockIdleClient.AssertExpectations(t)
+
+	mockUsedAgainClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockUsedAgainClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	idleSessionID := store.Add(mockIdleClient, matlabsessionstore.SessionMetadata{})
+	usedAgainSessionID := store.Add(mockUsedAgainClient, matlabsessionstore.SessionMetadata{})
+	lastUsedAt := time.Unix(1767225600, 0)
+
+	mockIdleClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	mockUsedAgainClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt.Add(time.Minute)}).
+		Once()
+
+	candidates := []matlabsessionstore.EvictionCandidate{
+		{ID: idleSessionID, LastUsedAt: lastUsedAt},
+		{ID: usedAgainSessionID, LastUsedAt: lastUsedAt},
+	}
+
+	// Act
+	releaseSlot, evicted, isReserved := store.ReserveEvicting(1, 0, candidates)
+
+	// Assert
+	assert.False(t, isReserved)
+	assert.Nil(t, releaseSlot)
+	assert.Empty(t, evicted)
+	assert.Len(t, store.List(), 2, "No session should be evicted when the slot cannot be reserved")
+}
+
+func TestStore_ReserveEvicting_CountsFreedSlots(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	releasePooledSlot, isReserved := store.TryReserve(1)
+	require.True(t, isReserved)
+
+	// Act
+	releaseSlot, evicted, isReserved := store.ReserveEvicting(1, 1, nil)
+
+	// Assert
+	require.True(t, isReserved)
+	assert.Empty(t, evicted)
+
+	releasePooledSlot()
+	assert.Equal(t, 1, store.Occupied())
+	releaseSlot()
+	assert.Equal(t, 0, store.Occupied())
+}
+
+func TestStore_MarkDead_GetReturnsSessionDiedError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	const diagnostics = "MATLAB exited with code 137"
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+
+	// Act
+	store.MarkDead(sessionID, diagnostics, "")
+
+	// Assert
+	retrievedClient, err := store.Get(sessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionDied)
+	assert.ErrorContains(t, err, diagnostics)
+	assert.Nil(t, retrievedClient)
+	assert.Empty(t, store.List())
+}
+
+func TestStore_MarkDead_RemembersTooManySessions_ForgetsSessionThatEndedFirst(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+
+	var sessionIDs []entities.SessionID
+	for range matlabsessionstore.MaxEndedSessions {
+		sessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+		store.MarkDead(sessionID, "MATLAB exited with code 137", "")
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	lastSessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+
+	// Act
+	store.MarkDead(lastSessionID, "MATLAB exited with code 137", "")
+
+	// Assert
+	_, err := store.Get(sessionIDs[0])
+	require.ErrorContains(t, err, "session not found")
+
+	_, err = store.Get(sessionIDs[1])
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionDied)
+
+	_, err = store.Get(lastSessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionDied)
+}
+
+func TestStore_ForgetEndedBefore_ForgetsSessionsThatEndedBeforeCutoff(t *testing.T) {
+	testCases := []struct {
+		name              string
+		cutoff            time.Time
+		expectedForgotten bool
+	}{
+		{name: "ended after cutoff", cutoff: time.Now().Add(-time.Hour)},
+		{name: "ended before cutoff", cutoff: time.Now().Add(time.Hour), expectedForgotten: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+			defer mockClient.AssertExpectations(t)
+
+			mockLifecycleSignaler.EXPECT().
+				AddShutdownFunction(mock.AnythingOfType("func() error")).
+				Return().
+				Once()
+
+			mockClient.EXPECT().
+				Usage().
+				Return(matlabsessionstore.Usage{}).
+				Once()
+
+			store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+			deadSessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+			expiredSessionID := store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+			store.MarkDead(deadSessionID, "MATLAB exited with code 137", "/tmp/matlab-session-log-1234")
+			require.True(t, store.Expire(expiredSessionID, time.Time{}))
+
+			// Act
+			store.ForgetEndedBefore(tc.cutoff)
+
+			// Assert
+			_, deadErr := store.Get(deadSessionID)
+			logDirectory, logDirectoryErr := store.GetLogDirectory(deadSessionID)
+			_, expiredErr := store.Get(expiredSessionID)
+
+			if tc.expectedForgotten {
+				require.ErrorContains(t, deadErr, "session not found")
+				require.ErrorContains(t, logDirectoryErr, "session not found")
+				require.ErrorContains(t, expiredErr, "session not found")
+			} else {
+				require.ErrorIs(t, deadErr, matlabsessionstore.ErrSessionDied)
+				require.NoError(t, logDirectoryErr)
+				assert.Equal(t, "/tmp/matlab-session-log-1234", logDirectory)
+				require.ErrorIs(t, expiredErr, matlabsessionstore.ErrSessionExpired)
+			}
+		})
+	}
+}
+
 func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -408,9 +951,9 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act - Add multiple clients
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1 := store.Add(mockClient1, matlabsessionstore.SessionMetadata{})
+	sessionID2 := store.Add(mockClient2, matlabsessionstore.SessionMetadata{})
+	sessionID3 := store.Add(mockClient3, matlabsessionstore.SessionMetadata{})
 
 	// Assert - All clients can be retrieved
 	retrievedClient1, err := store.Get(sessionID1)
@@ -441,3 +984,122 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, mockClient3, retrievedClient3)
 }
+
+func TestStore_List_ReturnsSessionsOrderedByID(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockClient3 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient3.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	metadata1 := matlabsessionstore.SessionMetadata{MATLABRoot: filepath.Join("path", "to", "R2025b"), Version: "R2025b", ProcessID: 1234, StartedAt: time.Unix(1767225600, 0)}
+	metadata3 := matlabsessionstore.SessionMetadata{ProcessID: 5678, StartedAt: time.Unix(1767225700, 0)}
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID1 := store.Add(mockClient1, metadata1)
+	sessionID2 := store.Add(mockClient2, matlabsessionstore.SessionMetadata{})
+	sessionID3 := store.Add(mockClient3, metadata3)
+	store.Remove(sessionID2)
+
+	// Act
+	sessions := store.List()
+
+	// Assert
+	assert.Equal(t, []matlabsessionstore.Session{
+		{ID: sessionID1, Client: mockClient1, Metadata: metadata1},
+		{ID: sessionID3, Client: mockClient3, Metadata: metadata3},
+	}, sessions)
+}
+
+func TestStore_List_EmptyStore(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	sessions := store.List()
+
+	// Assert
+	assert.Empty(t, sessions)
+}
+
+func TestStore_TryReserve_CountsSessionsAndReservedSlots(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	store.Add(mockClient, matlabsessionstore.SessionMetadata{})
+
+	// Act
+	releaseSlot, reserved := store.TryReserve(2)
+	_, reservedOverLimit := store.TryReserve(2)
+	releaseSlot()
+	releaseSlot()
+	_, reservedAfterRelease := store.TryReserve(2)
+
+	// Assert
+	assert.True(t, reserved, "Slot should be reserved below the limit")
+	assert.False(t, reservedOverLimit, "Reserved slots should count toward the limit")
+	assert.True(t, reservedAfterRelease, "Released slot should be available again")
+}
+
+func TestStore_TryReserve_NoLimit(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	_, firstReserved := store.TryReserve(0)
+	_, secondReserved := store.TryReserve(0)
+
+	// Assert
+	assert.True(t, firstReserved)
+	assert.True(t, secondReserved)
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionlog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	applicationdirectory "github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+)
+
+const keptLogDirPattern = "matlab-session-log-"
+
+type ApplicationDirectoryFactory interface {
+	Directory() (applicationdirectory.Directory, messages.Error)
+}
+
+// Keeper copies the files that a MATLAB session wrote to its session directory,
+// so that they can still be read once the session directory is deleted.
+type Keeper struct {
+	applicationDirectoryFactory ApplicationDirectoryFactory
+	osLayer                     OSLayer
+}
+
+func NewKeeper(
+	applicationDirectoryFactory ApplicationDirectoryFactory,
+	osLayer OSLayer,
+) *Keeper {
+	return &Keeper{
+		applicationDirectoryFactory: applicationDirectoryFactory,
+		osLayer:                     osLayer,
+	}
+}
+
+// Keep copies the MATLAB output, errors, startup error and crash dumps of the session directory to a new directory
+// in the application directory, and returns it. The returned directory can be read like a session directory.
+func (k *Keeper) Keep(sessionDirectory string) (string, error) {
+	crashDumpFiles, err := k.osLayer.Glob(filepath.Join(sessionDirectory, crashDumpPattern))
+	if err != nil {
+		return "", fmt.Errorf("failed to look for MATLAB crash dumps: %w", err)
+	}
+
+	applicationDirectory, messagesErr := k.applicationDirectoryFactory.Directory()
+	if messagesErr != nil {
+		return "", messagesErr
+	}
+
+	keptLogDir, messagesErr := applicationDirectory.CreateSubDir(keptLogDirPattern)
+	if messagesErr != nil {
+		return "", messagesErr
+	}
+
+	logFiles := append([]string{
+		filepath.Join(sessionDirectory, processlauncher.StdoutLogFileName),
+		filepath.Join(sessionDirectory, processlauncher.StderrLogFileName),
+		filepath.Join(sessionDirectory, directory.StartupErrorFileName),
+	}, crashDumpFiles...)
+
+	for _, logFile := range logFiles {
+		content, err := k.osLayer.ReadFile(logFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return "", fmt.Errorf("failed to read %s: %w", filepath.Base(logFile), err)
+		}
+
+		if err := k.osLayer.WriteFile(filepath.Join(keptLogDir, filepath.Base(logFile)), content, 0o600); err != nil {
+			return "", fmt.Errorf("failed to copy %s: %w", filepath.Base(logFile), err)
+		}
+	}
+
+	return keptLogDir, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionlog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/directory"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeeper_HappyPath(t *testing.T) {
+	// Arrange
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	keeper := sessionlog.NewKeeper(mockApplicationDirectoryFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, keeper)
+}
+
+func TestKeeper_Keep_CopiesLogFilesThatExist(t *testing.T) {
+	// Arrange
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+	keptLogDirectory := filepath.Join("tmp", "matlab-session-log-1234")
+	crashDumpFile := filepath.Join(sessionDirectory, "matlab_crash_dump.1234-1")
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(sessionDirectory, "matlab_crash_dump.*")).
+		Return([]string{crashDumpFile}, nil).
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-log-").
+		Return(keptLogDirectory, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stdout.log")).
+		Return([]byte("MATLAB is starting\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stderr.log")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "mcp_startup_error.txt")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(crashDumpFile).
+		Return([]byte("Segmentation violation"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(keptLogDirectory, "matlab_stdout.log"), []byte("MATLAB is starting\n"), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(keptLogDirectory, "matlab_crash_dump.1234-1"), []byte("Segmentation violation"), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	keeper := sessionlog.NewKeeper(mockApplicationDirectoryFactory, mockOSLayer)
+
+	// Act
+	logDirectory, err := keeper.Keep(sessionDirectory)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, keptLogDirectory, logDirectory)
+}
+
+func TestKeeper_Keep_CreateSubDirError(t *testing.T) {
+	// Arrange
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+	expectedError := messages.AnError
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(sessionDirectory, "matlab_crash_dump.*")).
+		Return(nil, nil).
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-log-").
+		Return("", expectedError).
+		Once()
+
+	keeper := sessionlog.NewKeeper(mockApplicationDirectoryFactory, mockOSLayer)
+
+	// Act
+	logDirectory, err := keeper.Keep(sessionDirectory)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, logDirectory)
+}
+
+func TestKeeper_Keep_WriteFileError(t *testing.T) {
+	// Arrange
+	mockApplicationDirectoryFactory := &mocks.MockApplicationDirectoryFactory{}
+	defer mockApplicationDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+	keptLogDirectory := filepath.Join("tmp", "matlab-session-log-1234")
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(sessionDirectory, "matlab_crash_dump.*")).
+		Return(nil, nil).
+		Once()
+
+	mockApplicationDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		CreateSubDir("matlab-session-log-").
+		Return(keptLogDirectory, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stdout.log")).
+		Return([]byte("MATLAB is starting\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(keptLogDirectory, "matlab_stdout.log"), []byte("MATLAB is starting\n"), os.FileMode(0o600)).
+		Return(expectedError).
+		Once()
+
+	keeper := sessionlog.NewKeeper(mockApplicationDirectoryFactory, mockOSLayer)
+
+	// Act
+	logDirectory, err := keeper.Keep(sessionDirectory)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, logDirectory)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionlog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// crashDumpPattern matches the crash dump files that MATLAB writes to MATLAB_LOG_DIR, which is the session directory.
+const crashDumpPattern = "matlab_crash_dump.*"
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Glob(pattern string) ([]string, error)
+}
+
+// Reader reads the files that a MATLAB session started by the server writes to its session directory.
+type Reader struct {
+	osLayer OSLayer
+}
+
+func New(
+	osLayer OSLayer,
+) *Reader {
+	return &Reader{
+		osLayer: osLayer,
+	}
+}
+
+// Read returns the last maxLines lines of the MATLAB output, along with the startup error and crash dumps, if any.
+// Files that MATLAB did not write are left empty.
+func (r *Reader) Read(sessionDirectory string, maxLines int) (entities.MATLABSessionLog, error) {
+	stdout, err := r.readFile(filepath.Join(sessionDirectory, processlauncher.StdoutLogFileName))
+	if err != nil {
+		return entities.MATLABSessionLog{}, err
+	}
+
+	stderr, err := r.readFile(filepath.Join(sessionDirectory, processlauncher.StderrLogFileName))
+	if err != nil {
+		return entities.MATLABSessionLog{}, err
+	}
+
+	startupError, err := r.readFile(filepath.Join(sessionDirectory, directory.StartupErrorFileName))
+	if err != nil {
+		return entities.MATLABSessionLog{}, err
+	}
+
+	crashDumps, err := r.readCrashDumps(sessionDirectory)
+	if err != nil {
+		return entities.MATLABSessionLog{}, err
+	}
+
+	return entities.MATLABSessionLog{
+		Stdout:       lastLines(stdout, maxLines),
+		Stderr:       lastLines(stderr, maxLines),
+		StartupError: strings.TrimSpace(startupError),
+		CrashDumps:   crashDumps,
+	}, nil
+}
+
+func (r *Reader) readCrashDumps(sessionDirectory string) ([]entities.MATLABCrashDump, error) {
+	crashDumpFiles, err := r.osLayer.Glob(filepath.Join(sessionDirectory, crashDumpPattern))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for MATLAB crash dumps: %w", err)
+	}
+
+	slices.Sort(crashDumpFiles)
+
+	crashDumps := make([]entities.MATLABCrashDump, 0, len(crashDumpFiles))
+	for _, crashDumpFile := range crashDumpFiles {
+		content, err := r.readFile(crashDumpFile)
+		if err != nil {
+			return nil, err
+		}
+
+		crashDumps = append(crashDumps, entities.MATLABCrashDump{
+			FileName: filepath.Base(crashDumpFile),
+			Content:  content,
+		})
+	}
+
+	return crashDumps, nil
+}
+
+func (r *Reader) readFile(filePath string) (string, error) {
+	content, err := r.osLayer.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read %s: %w", filepath.Base(filePath), err)
+	}
+
+	return string(content), nil
+}
+
+func lastLines(content string, maxLines int) string {
+	lines := strings.Split(strings.TrimRight(content, "\r\n"), "\n")
+	return strings.Join(lines[max(len(lines)-maxLines, 0):], "\n")
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionlog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	reader := sessionlog.New(mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, reader)
+}
+
+func TestReader_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+	crashDumpFile := filepath.Join(sessionDirectory, "matlab_crash_dump.1234-1")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stdout.log")).
+		Return([]byte("line 1\nline 2\nline 3\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stderr.log")).
+		Return([]byte("warning\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "mcp_startup_error.txt")).
+		Return([]byte("Failed to start connector\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(sessionDirectory, "matlab_crash_dump.*")).
+		Return([]string{crashDumpFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(crashDumpFile).
+		Return([]byte("Segmentation violation"), nil).
+		Once()
+
+	reader := sessionlog.New(mockOSLayer)
+
+	// Act
+	sessionLog, err := reader.Read(sessionDirectory, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.MATLABSessionLog{
+		Stdout:       "line 2\nline 3",
+		Stderr:       "warning",
+		StartupError: "Failed to start connector",
+		CrashDumps: []entities.MATLABCrashDump{
+			{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+		},
+	}, sessionLog)
+}
+
+func TestReader_Read_MissingFilesAreEmpty(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stdout.log")).
+		Return([]byte("MATLAB is starting"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stderr.log")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "mcp_startup_error.txt")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(sessionDirectory, "matlab_crash_dump.*")).
+		Return(nil, nil).
+		Once()
+
+	reader := sessionlog.New(mockOSLayer)
+
+	// Act
+	sessionLog, err := reader.Read(sessionDirectory, 100)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "MATLAB is starting", sessionLog.Stdout)
+	assert.Empty(t, sessionLog.Stderr)
+	assert.Empty(t, sessionLog.StartupError)
+	assert.Empty(t, sessionLog.CrashDumps)
+}
+
+func TestReader_Read_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirectory := filepath.Join("tmp", "matlab-session-1234")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDirectory, "matlab_stdout.log")).
+		Return(nil, assert.AnError).
+		Once()
+
+	reader := sessionlog.New(mockOSLayer)
+
+	// Act
+	sessionLog, err := reader.Read(sessionDirectory, 100)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, sessionLog)
+}
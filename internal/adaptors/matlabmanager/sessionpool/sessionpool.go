@@ -0,0 +1,517 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionpool
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultHealthCheckInterval = 30 * time.Second
+
+// exitTimeout bounds how long stopping a pooled session waits for MATLAB to answer the request to exit, as an unresponsive MATLAB never does.
+const exitTimeout = 10 * time.Second
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type MATLABServices interface {
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+}
+
+type MATLABSessionClientFactory interface {
+	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
+}
+
+type SessionStore interface {
+	TryReserve(maxSessions int) (func(), bool)
+}
+
+type HealthMonitor interface {
+	AddProcessExitListener(listener func(processID int))
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type pooledSession struct {
+	connectionDetails embeddedconnector.ConnectionDetails
+	client            entities.MATLABSessionClient
+	cleanup           func() error
+	releaseSlot       func()
+}
+
+// Pool keeps started MATLAB sessions ready for each MATLAB root that sessions were requested for,
+// so that starting a MATLAB session does not have to wait for MATLAB to start.
+// Pooled sessions are started like any other local MATLAB session, with the configured display mode,
+// so they are registered with the watchdog and the health monitor, and the ones still in the pool are stopped when the application shuts down.
+// Each pooled session reserves a slot in the session store, so that it counts toward the maximum number of MATLAB sessions.
+type Pool struct {
+	configFactory     ConfigFactory
+	loggerFactory     LoggerFactory
+	matlabServices    MATLABServices
+	clientFactory     MATLABSessionClientFactory
+	sessionStore      SessionStore
+	healthMonitor     HealthMonitor
+	lifecycleSignaler LifecycleSignaler
+
+	startOnce *sync.Once
+	startErr  error
+	logger    entities.Logger
+
+	l          *sync.Mutex
+	sessions   map[string][]pooledSession
+	pending    map[string]int
+	isShutdown bool
+	launches   *sync.WaitGroup
+	discards   *sync.WaitGroup
+
+	healthCheckInterval time.Duration
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	matlabServices MATLABServices,
+	clientFactory MATLABSessionClientFactory,
+	sessionStore SessionStore,
+	healthMonitor HealthMonitor,
+	lifecycleSignaler LifecycleSignaler,
+) *Pool {
+	return &Pool{
+		configFactory:     configFactory,
+		loggerFactory:     loggerFactory,
+		matlabServices:    matlabServices,
+		clientFactory:     clientFactory,
+		sessionStore:      sessionStore,
+		healthMonitor:     healthMonitor,
+		lifecycleSignaler: lifecycleSignaler,
+
+		startOnce: new(sync.Once),
+
+		l:        new(sync.Mutex),
+		sessions: map[string][]pooledSession{},
+		pending:  map[string]int{},
+		launches: new(sync.WaitGroup),
+		discards: new(sync.WaitGroup),
+
+		healthCheckInterval: defaultHealthCheckInterval,
+	}
+}
+
+// Take hands out a healthy pooled MATLAB session matching the request, and replenishes the pool in the background.
+// The session comes with its slot in the session store, which the caller releases once the session is in the store.
+// It returns false when the pool is disabled, when the request needs a specially started MATLAB,
+// or when no pooled session is ready yet, in which case the caller should start MATLAB itself.
+func (p *Pool) Take(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, func(), bool) {
+	config, messagesErr := p.configFactory.Config()
+	if messagesErr != nil {
+		logger.WithError(messagesErr).Warn("Failed to get configuration to use the MATLAB session pool")
+		return embeddedconnector.ConnectionDetails{}, nil, nil, false
+	}
+
+	poolSize := config.MATLABPoolSize()
+	if config.UseSingleMATLABSession() || poolSize <= 0 || !isPoolable(request, config.ShouldShowMATLABDesktop()) {
+		return embeddedconnector.ConnectionDetails{}, nil, nil, false
+	}
+
+	if err := p.start(); err != nil {
+		logger.WithError(err).Warn("Failed to start the MATLAB session pool")
+		return embeddedconnector.ConnectionDetails{}, nil, nil, false
+	}
+
+	matlabRoot := filepath.Clean(request.MATLABRoot)
+	defer p.replenish(matlabRoot, config)
+
+	for {
+		session, found := p.pop(matlabRoot)
+		if !found {
+			logger.Debug("No pooled MATLAB session is ready")
+			return embeddedconnector.ConnectionDetails{}, nil, nil, false
+		}
+
+		sessionLogger := logger.With("pid", session.connectionDetails.ProcessID)
+		if p.isHealthy(ctx, sessionLogger, config, session) {
+			sessionLogger.Info("Using pooled MATLAB session")
+			return session.connectionDetails, session.cleanup, session.releaseSlot, true
+		}
+
+		sessionLogger.Warn("Discarding pooled MATLAB session that does not respond")
+		p.discardInBackground(sessionLogger, session)
+	}
+}
+
+// checkHealth discards the pooled MATLAB sessions that no longer respond, and replenishes the pool.
+func (p *Pool) checkHealth(ctx context.Context) {
+	config, messagesErr := p.configFactory.Config()
+	if messagesErr != nil {
+		p.logger.WithError(messagesErr).Warn("Failed to get configuration to check pooled MATLAB sessions")
+		return
+	}
+
+	p.l.Lock()
+	sessions := make(map[string][]pooledSession, len(p.sessions))
+	for matlabRoot, rootSessions := range p.sessions {
+		sessions[matlabRoot] = slices.Clone(rootSessions)
+	}
+	p.l.Unlock()
+
+	for matlabRoot, rootSessions := range sessions {
+		for _, session := range rootSessions {
+			sessionLogger := p.logger.
+				With("matlab-root", matlabRoot).
+				With("pid", session.connectionDetails.ProcessID)
+
+			if p.isHealthy(ctx, sessionLogger, config, session) {
+				continue
+			}
+
+			if p.remove(matlabRoot, session) {
+				sessionLogger.Warn("Discarding pooled MATLAB session that does not respond")
+				p.discard(sessionLogger, session)
+			}
+		}
+
+		p.replenish(matlabRoot, config)
+	}
+}
+
+// EvictUpTo takes up to n pooled MATLAB sessions out of the pool, to make room for a MATLAB session that was asked for,
+// and calls commit with how many it took. commit is always called, even when the pool holds no ready session.
+// When commit returns true, the slots of the sessions are released, and the functions that stop them are returned,
+// so that the caller does not have to hold its own locks while MATLAB stops. Otherwise, the sessions are put back in the pool.
+func (p *Pool) EvictUpTo(logger entities.Logger, n int, commit func(evicted int) bool) []func() {
+	type evictedSession struct {
+		matlabRoot string
+		session    pooledSession
+	}
+
+	var evicted []evictedSession
+
+	p.l.Lock()
+	for _, matlabRoot := range slices.Sorted(maps.Keys(p.sessions)) {
+		for len(evicted) < n {
+			session, found := p.popLocked(matlabRoot)
+			if !found {
+				break
+			}
+			evicted = append(evicted, evictedSession{matlabRoot: matlabRoot, session: session})
+		}
+	}
+	p.l.Unlock()
+
+	if !commit(len(evicted)) {
+		p.l.Lock()
+		isShutdown := p.isShutdown
+		if !isShutdown {
+			for _, e := range slices.Backward(evicted) {
+				p.sessions[e.matlabRoot] = append([]pooledSession{e.session}, p.sessions[e.matlabRoot]...)
+			}
+		}
+		p.l.Unlock()
+
+		// The pool was shut down while the sessions were out of it, so they would never be stopped.
+		if isShutdown {
+			for _, e := range evicted {
+				p.discard(logger.With("pid", e.session.connectionDetails.ProcessID), e.session)
+			}
+		}
+		return nil
+	}
+
+	stops := make([]func(), 0, len(evicted))
+	for _, e := range evicted {
+		e.session.releaseSlot()
+
+		sessionLogger := logger.With("pid", e.session.connectionDetails.ProcessID)
+		sessionLogger.Info("Discarding pooled MATLAB session to make room for a new MATLAB session")
+
+		stops = append(stops, func() {
+			if err := p.stop(sessionLogger, e.session); err != nil {
+				sessionLogger.WithError(err).Warn("Failed to stop pooled MATLAB session")
+			}
+		})
+	}
+
+	return stops
+}
+
+// handleProcessExit drops the pooled MATLAB session whose process exited, and replenishes the pool.
+func (p *Pool) handleProcessExit(processID int) {
+	p.l.Lock()
+	var session pooledSession
+	var matlabRoot string
+	var found bool
+	for root, rootSessions := range p.sessions {
+		index := slices.IndexFunc(rootSessions, func(s pooledSession) bool {
+			return s.connectionDetails.ProcessID == processID
+		})
+		if index >= 0 {
+			session, matlabRoot, found = rootSessions[index], root, true
+			p.sessions[root] = slices.Delete(rootSessions, index, index+1)
+			break
+		}
+	}
+	p.l.Unlock()
+
+	if !found {
+		return
+	}
+
+	sessionLogger := p.logger.
+		With("matlab-root", matlabRoot).
+		With("pid", processID)
+	sessionLogger.Warn("Discarding pooled MATLAB session whose process exited")
+
+	if err := session.cleanup(); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to clean up pooled MATLAB session")
+	}
+	session.releaseSlot()
+
+	config, messagesErr := p.configFactory.Config()
+	if messagesErr != nil {
+		sessionLogger.WithError(messagesErr).Warn("Failed to get configuration to replenish the MATLAB session pool")
+		return
+	}
+	p.replenish(matlabRoot, config)
+}
+
+func (p *Pool) start() error {
+	p.startOnce.Do(func() {
+		logger, messagesErr := p.loggerFactory.GetGlobalLogger()
+		if messagesErr != nil {
+			p.startErr = messagesErr
+			return
+		}
+		p.logger = logger
+
+		p.healthMonitor.AddProcessExitListener(p.handleProcessExit)
+
+		stopC := make(chan struct{})
+		doneC := make(chan struct{})
+		p.lifecycleSignaler.AddShutdownFunction(func() error {
+			close(stopC)
+			<-doneC
+			return p.shutdown()
+		})
+
+		go func() {
+			defer close(doneC)
+
+			ticker := time.NewTicker(p.healthCheckInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-stopC:
+					return
+				case <-ticker.C:
+					p.checkHealth(context.Background())
+				}
+			}
+		}()
+	})
+	return p.startErr
+}
+
+// replenish starts as many MATLAB sessions in the background as needed to fill the pool for the MATLAB root,
+// as long as the maximum number of MATLAB sessions leaves room for them.
+func (p *Pool) replenish(matlabRoot string, config config.Config) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	if p.isShutdown {
+		return
+	}
+
+	missing := config.MATLABPoolSize() - len(p.sessions[matlabRoot]) - p.pending[matlabRoot]
+	for range max(missing, 0) {
+		releaseSlot, isReserved := p.sessionStore.TryReserve(config.MaxMATLABSessions())
+		if !isReserved {
+			return
+		}
+
+		p.pending[matlabRoot]++
+		p.launches.Add(1)
+		go p.launch(matlabRoot, config.ShouldShowMATLABDesktop(), releaseSlot)
+	}
+}
+
+func (p *Pool) launch(matlabRoot string, showMATLABDesktop bool, releaseSlot func()) {
+	defer p.launches.Done()
+
+	logger := p.logger.With("matlab-root", matlabRoot)
+	logger.Debug("Starting pooled MATLAB session")
+
+	session, err := p.startSession(logger, matlabRoot, showMATLABDesktop)
+	session.releaseSlot = releaseSlot
+
+	p.l.Lock()
+	p.pending[matlabRoot]--
+	isShutdown := p.isShutdown
+	if err == nil && !isShutdown {
+		p.sessions[matlabRoot] = append(p.sessions[matlabRoot], session)
+	}
+	p.l.Unlock()
+
+	if err != nil {
+		releaseSlot()
+		logger.WithError(err).Warn("Failed to start pooled MATLAB session")
+		return
+	}
+
+	logger = logger.With("pid", session.connectionDetails.ProcessID)
+
+	if isShutdown {
+		p.discard(logger, session)
+		return
+	}
+
+	logger.Info("Pooled MATLAB session is ready")
+}
+
+func (p *Pool) startSession(logger entities.Logger, matlabRoot string, showMATLABDesktop bool) (pooledSession, error) {
+	connectionDetails, cleanup, err := p.matlabServices.StartLocalMATLABSession(
+		context.Background(),
+		logger,
+		datatypes.LocalSessionDetails{
+			MATLABRoot:        matlabRoot,
+			ShowMATLABDesktop: showMATLABDesktop,
+		},
+	)
+	if err != nil {
+		return pooledSession{}, err
+	}
+
+	client, err := p.clientFactory.New(connectionDetails)
+	if err != nil {
+		if cleanupErr := cleanup(); cleanupErr != nil {
+			logger.WithError(cleanupErr).Warn("Failed to clean up pooled MATLAB session after client factory error")
+		}
+		return pooledSession{}, err
+	}
+
+	return pooledSession{
+		connectionDetails: connectionDetails,
+		client:            client,
+		cleanup:           cleanup,
+	}, nil
+}
+
+func (p *Pool) pop(matlabRoot string) (pooledSession, bool) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	return p.popLocked(matlabRoot)
+}
+
+// popLocked must be called with the lock held.
+func (p *Pool) popLocked(matlabRoot string) (pooledSession, bool) {
+	sessions := p.sessions[matlabRoot]
+	if len(sessions) == 0 {
+		return pooledSession{}, false
+	}
+
+	p.sessions[matlabRoot] = sessions[1:]
+	return sessions[0], true
+}
+
+// remove takes the session out of the pool, and reports whether it was still pooled.
+func (p *Pool) remove(matlabRoot string, session pooledSession) bool {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	sessions := p.sessions[matlabRoot]
+	index := slices.IndexFunc(sessions, func(s pooledSession) bool {
+		return s.connectionDetails.ProcessID == session.connectionDetails.ProcessID
+	})
+	if index < 0 {
+		return false
+	}
+
+	p.sessions[matlabRoot] = slices.Delete(sessions, index, index+1)
+	return true
+}
+
+func (p *Pool) isHealthy(ctx context.Context, logger entities.Logger, config config.Config, session pooledSession) bool {
+	pingCtx, cancel := context.WithTimeout(ctx, config.MATLABSessionConnectionTimeout())
+	defer cancel()
+
+	return session.client.Ping(pingCtx, logger).IsAlive
+}
+
+// discardInBackground discards the session without making the caller wait for MATLAB to stop.
+func (p *Pool) discardInBackground(logger entities.Logger, session pooledSession) {
+	p.discards.Add(1)
+	go func() {
+		defer p.discards.Done()
+		p.discard(logger, session)
+	}()
+}
+
+func (p *Pool) discard(logger entities.Logger, session pooledSession) {
+	defer session.releaseSlot()
+
+	if err := p.stop(logger, session); err != nil {
+		logger.WithError(err).Warn("Failed to stop pooled MATLAB session")
+	}
+}
+
+// stop asks MATLAB to exit, and cleans up the session even when MATLAB no longer responds, which kills MATLAB.
+func (p *Pool) stop(logger entities.Logger, session pooledSession) error {
+	exitCtx, cancel := context.WithTimeout(context.Background(), exitTimeout)
+	defer cancel()
+
+	_, evalErr := session.client.Eval(exitCtx, logger, entities.EvalRequest{Code: "exit()"})
+	return errors.Join(evalErr, session.cleanup())
+}
+
+func (p *Pool) shutdown() error {
+	p.l.Lock()
+	p.isShutdown = true
+	sessions := p.sessions
+	p.sessions = map[string][]pooledSession{}
+	p.l.Unlock()
+
+	// Sessions still starting stop themselves once started, as the pool is shut down.
+	p.launches.Wait()
+	p.discards.Wait()
+
+	wg := new(errgroup.Group)
+	for matlabRoot, rootSessions := range sessions {
+		for _, session := range rootSessions {
+			wg.Go(func() error {
+				return p.stop(p.logger.With("matlab-root", matlabRoot), session)
+			})
+		}
+	}
+
+	return wg.Wait()
+}
+
+// isPoolable reports whether the request can be served by a MATLAB started with the default options and the configured display mode.
+func isPoolable(request datatypes.LocalSessionDetails, showMATLABDesktop bool) bool {
+	return !request.IsStartingDirectorySet &&
+		request.ShowMATLABDesktop == showMATLABDesktop &&
+		request.StartupScript == "" &&
+		len(request.EnvironmentVariables) == 0 &&
+		len(request.AdditionalFlags) == 0
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionpool
+
+import (
+	"context"
+	"time"
+)
+
+func (p *Pool) SetHealthCheckInterval(healthCheckInterval time.Duration) {
+	p.healthCheckInterval = healthCheckInterval
+}
+
+func (p *Pool) CheckHealth(ctx context.Context) {
+	p.checkHealth(ctx)
+}
+
+func (p *Pool) WaitForLaunches() {
+	p.launches.Wait()
+}
+
+func (p *Pool) WaitForDiscards() {
+	p.discards.Wait()
+}
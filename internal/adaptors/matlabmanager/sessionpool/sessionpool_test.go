@@ -0,0 +1,1272 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionpool_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionpool"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Act
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, pool)
+}
+
+func TestPool_Take_PoolNotUsed(t *testing.T) {
+	matlabRoot := filepath.Join("path", "to", "matlab")
+
+	testCases := []struct {
+		name                   string
+		poolSize               int
+		useSingleMATLABSession bool
+		showMATLABDesktop      bool
+		request                datatypes.LocalSessionDetails
+	}{
+		{name: "pool disabled", poolSize: 0, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}},
+		{name: "single MATLAB session", poolSize: 2, useSingleMATLABSession: true, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}},
+		{name: "starting folder", poolSize: 2, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot, IsStartingDirectorySet: true, StartingDirectory: "work"}},
+		{name: "MATLAB desktop without configured desktop", poolSize: 2, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot, ShowMATLABDesktop: true}},
+		{name: "no MATLAB desktop with configured desktop", poolSize: 2, showMATLABDesktop: true, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}},
+		{name: "startup script", poolSize: 2, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot, StartupScript: "setup.m"}},
+		{name: "environment variables", poolSize: 2, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot, EnvironmentVariables: map[string]string{"MY_VAR": "value"}}},
+		{name: "MATLAB flags", poolSize: 2, request: datatypes.LocalSessionDetails{MATLABRoot: matlabRoot, AdditionalFlags: []string{"-nosplash"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockMATLABServices := &mocks.MockMATLABServices{}
+			defer mockMATLABServices.AssertExpectations(t)
+
+			mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+			defer mockClientFactory.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockHealthMonitor := &mocks.MockHealthMonitor{}
+			defer mockHealthMonitor.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABPoolSize().
+				Return(tc.poolSize).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			mockConfig.EXPECT().
+				ShouldShowMATLABDesktop().
+				Return(tc.showMATLABDesktop).
+				Maybe()
+
+			pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+			// Act
+			_, _, _, found := pool.Take(t.Context(), mockLogger, tc.request)
+
+			// Assert
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestPool_Take_HandsOutPooledSessionsAndStopsTheRestOnShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("path", "to", "matlab")
+	request := datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}
+	poolSize := 2
+	ctx := t.Context()
+
+	var processID atomic.Int32
+	var cleanupCount atomic.Int32
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(poolSize)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() {}, true)
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+			return embeddedconnector.ConnectionDetails{ProcessID: int(processID.Add(1))}, func() error {
+				cleanupCount.Add(1)
+				return nil
+			}, nil
+		}).
+		Times(poolSize + 1)
+
+	mockClientFactory.EXPECT().
+		New(mock.AnythingOfType("embeddedconnector.ConnectionDetails")).
+		Return(mockClient, nil).
+		Times(poolSize + 1)
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Times(poolSize)
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	// Act
+	_, _, _, foundBeforeWarmUp := pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	connectionDetails, sessionCleanup, releaseSlot, foundAfterWarmUp := pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	require.NotNil(t, capturedShutdownFunc)
+	shutdownErr := capturedShutdownFunc()
+
+	// Assert
+	assert.False(t, foundBeforeWarmUp)
+	require.True(t, foundAfterWarmUp)
+	assert.NotZero(t, connectionDetails.ProcessID)
+	require.NotNil(t, sessionCleanup)
+	require.NotNil(t, releaseSlot)
+	require.NoError(t, shutdownErr)
+	assert.Equal(t, int32(poolSize), cleanupCount.Load(), "Only the sessions left in the pool should be cleaned up")
+}
+
+func TestPool_Take_DiscardsUnresponsiveSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockUnresponsiveClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockUnresponsiveClient.AssertExpectations(t)
+
+	mockReplacementClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockReplacementClient.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("path", "to", "matlab")
+	request := datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}
+	ctx := t.Context()
+
+	unresponsiveSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 1}
+	replacementSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 2}
+
+	var unresponsiveSessionCleanedUp atomic.Bool
+	exitRequested := make(chan struct{})
+	releaseExit := make(chan struct{})
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() {}, true)
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(unresponsiveSessionDetails, func() error {
+			unresponsiveSessionCleanedUp.Store(true)
+			return nil
+		}, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(replacementSessionDetails, func() error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(unresponsiveSessionDetails).
+		Return(mockUnresponsiveClient, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(replacementSessionDetails).
+		Return(mockReplacementClient, nil).
+		Once()
+
+	mockUnresponsiveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockUnresponsiveClient.EXPECT().
+		Eval(mock.MatchedBy(hasDeadline), mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		RunAndReturn(func(context.Context, entities.Logger, entities.EvalRequest) (entities.EvalResponse, error) {
+			close(exitRequested)
+			<-releaseExit
+			return entities.EvalResponse{}, assert.AnError
+		}).
+		Once()
+
+	mockReplacementClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	_, _, _, _ = pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	// The pooled session is discarded while the pool is replenished, so each logs to its own logger.
+	takeLogger := testutils.NewInspectableLogger()
+
+	// Act
+	_, _, _, found := pool.Take(ctx, takeLogger, request)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.False(t, found)
+
+	<-exitRequested
+	assert.False(t, unresponsiveSessionCleanedUp.Load(), "Take should not wait for the unresponsive session to stop")
+	close(releaseExit)
+	pool.WaitForDiscards()
+
+	assert.True(t, unresponsiveSessionCleanedUp.Load(), "Unresponsive session should be cleaned up even when MATLAB does not exit")
+	assert.Contains(t, takeLogger.WarnLogs(), "Discarding pooled MATLAB session that does not respond")
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_Take_StartSessionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	ctx := t.Context()
+
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() {}, true)
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(embeddedconnector.ConnectionDetails{}, nil, assert.AnError).
+		Twice()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	// Act
+	_, _, _, firstFound := pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	_, _, _, secondFound := pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.False(t, firstFound)
+	assert.False(t, secondFound)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to start pooled MATLAB session")
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_CheckHealth_DiscardsUnresponsiveSessionsAndReplenishes(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockCrashedClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockCrashedClient.AssertExpectations(t)
+
+	mockReplacementClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockReplacementClient.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	ctx := t.Context()
+
+	crashedSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 1}
+	replacementSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 2}
+
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() {}, true)
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(crashedSessionDetails, func() error { return nil }, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(replacementSessionDetails, func() error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(crashedSessionDetails).
+		Return(mockCrashedClient, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(replacementSessionDetails).
+		Return(mockReplacementClient, nil).
+		Once()
+
+	mockCrashedClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockCrashedClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockReplacementClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	_, _, _, _ = pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	// Act
+	pool.CheckHealth(ctx)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.Contains(t, mockLogger.WarnLogs(), "Discarding pooled MATLAB session that does not respond")
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_Take_StartsPooledSessionsWithConfiguredDisplayMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab"), ShowMATLABDesktop: true}
+	ctx := t.Context()
+
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() {}, true).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(embeddedconnector.ConnectionDetails{ProcessID: 1}, func() error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(embeddedconnector.ConnectionDetails{ProcessID: 1}).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	// Act
+	_, _, _, found := pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.False(t, found)
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_Take_DoesNotReplenishBeyondMaxSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(2)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(3).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(3).
+		Return(nil, false).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	// Act
+	_, _, _, found := pool.Take(t.Context(), mockLogger, request)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.False(t, found)
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_EvictUpTo_ReleasesSlotAndStopsPooledSessionOnRequest(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	ctx := t.Context()
+
+	var slotReleased atomic.Bool
+	var sessionCleanedUp atomic.Bool
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(2).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(2).
+		Return(func() { slotReleased.Store(true) }, true).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(embeddedconnector.ConnectionDetails{ProcessID: 1}, func() error {
+			sessionCleanedUp.Store(true)
+			return nil
+		}, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(embeddedconnector.ConnectionDetails{ProcessID: 1}).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	_, _, _, _ = pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	var firstEvicted, secondEvicted int
+
+	// Act
+	stops := pool.EvictUpTo(mockLogger, 2, func(evicted int) bool {
+		firstEvicted = evicted
+		return true
+	})
+	_ = pool.EvictUpTo(mockLogger, 2, func(evicted int) bool {
+		secondEvicted = evicted
+		return true
+	})
+
+	// Assert
+	assert.Equal(t, 1, firstEvicted)
+	assert.Equal(t, 0, secondEvicted, "Nothing should be left to evict")
+	assert.True(t, slotReleased.Load(), "Slot of the evicted session should be released")
+	assert.False(t, sessionCleanedUp.Load(), "Evicted session should only be stopped by the caller")
+
+	require.Len(t, stops, 1)
+	stops[0]()
+	assert.True(t, sessionCleanedUp.Load())
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_EvictUpTo_CommitRefused_PutsSessionsBack(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	ctx := t.Context()
+
+	var slotReleased atomic.Bool
+	var sessionCleanedUp atomic.Bool
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(2).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(2).
+		Return(func() { slotReleased.Store(true) }, true).
+		Once()
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(embeddedconnector.ConnectionDetails{ProcessID: 1}, func() error {
+			sessionCleanedUp.Store(true)
+			return nil
+		}, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(embeddedconnector.ConnectionDetails{ProcessID: 1}).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	_, _, _, _ = pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+
+	var firstEvicted, secondEvicted int
+
+	// Act
+	refusedStops := pool.EvictUpTo(mockLogger, 1, func(evicted int) bool {
+		firstEvicted = evicted
+		return false
+	})
+	stops := pool.EvictUpTo(mockLogger, 1, func(evicted int) bool {
+		secondEvicted = evicted
+		return true
+	})
+
+	// Assert
+	assert.Empty(t, refusedStops)
+	assert.Equal(t, 1, firstEvicted)
+	assert.Equal(t, 1, secondEvicted, "Session should be back in the pool after the refused eviction")
+
+	require.Len(t, stops, 1)
+	stops[0]()
+	assert.True(t, slotReleased.Load())
+	assert.True(t, sessionCleanedUp.Load())
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestPool_ProcessExit_DiscardsPooledSessionAndReplenishes(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockHealthMonitor := &mocks.MockHealthMonitor{}
+	defer mockHealthMonitor.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockExitedClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockExitedClient.AssertExpectations(t)
+
+	mockReplacementClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockReplacementClient.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	ctx := t.Context()
+
+	exitedSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 1}
+	replacementSessionDetails := embeddedconnector.ConnectionDetails{ProcessID: 2}
+
+	var releasedSlots atomic.Int32
+	var exitedSessionCleanedUp atomic.Bool
+	var capturedProcessExitListener func(processID int)
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABPoolSize().
+		Return(1)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false)
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false)
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(0)
+
+	mockSessionStore.EXPECT().
+		TryReserve(0).
+		Return(func() { releasedSlots.Add(1) }, true).
+		Twice()
+
+	mockHealthMonitor.EXPECT().
+		AddProcessExitListener(mock.AnythingOfType("func(int)")).
+		Run(func(listener func(processID int)) {
+			capturedProcessExitListener = listener
+		}).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(exitedSessionDetails, func() error {
+			exitedSessionCleanedUp.Store(true)
+			return nil
+		}, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, request).
+		Return(replacementSessionDetails, func() error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(exitedSessionDetails).
+		Return(mockExitedClient, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(replacementSessionDetails).
+		Return(mockReplacementClient, nil).
+		Once()
+
+	mockReplacementClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := sessionpool.New(mockConfigFactory, mockLoggerFactory, mockMATLABServices, mockClientFactory, mockSessionStore, mockHealthMonitor, mockLifecycleSignaler)
+
+	_, _, _, _ = pool.Take(ctx, mockLogger, request)
+	pool.WaitForLaunches()
+	require.NotNil(t, capturedProcessExitListener)
+
+	// Act
+	capturedProcessExitListener(exitedSessionDetails.ProcessID)
+	pool.WaitForLaunches()
+
+	// Assert
+	assert.True(t, exitedSessionCleanedUp.Load(), "Pooled session whose process exited should be cleaned up")
+	assert.Equal(t, int32(1), releasedSlots.Load(), "Slot of the exited session should be released")
+	assert.Contains(t, mockLogger.WarnLogs(), "Discarding pooled MATLAB session whose process exited")
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func hasDeadline(ctx context.Context) bool {
+	_, ok := ctx.Deadline()
+	return ok
+}
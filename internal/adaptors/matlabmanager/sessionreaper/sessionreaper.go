@@ -0,0 +1,309 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionreaper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+)
+
+var ErrMaxSessionsReached = errors.New("maximum number of MATLAB sessions reached, stop a MATLAB session before starting a new one")
+
+const defaultCheckInterval = 30 * time.Second
+
+// endedSessionRetention is how long the store keeps reporting why a MATLAB session ended, before the session is forgotten.
+const endedSessionRetention = 24 * time.Hour
+
+// stopTimeout bounds how long stopping a MATLAB session waits for MATLAB to answer the request to exit, as an unresponsive MATLAB never does.
+const stopTimeout = 10 * time.Second
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type SessionStore interface {
+	List() []matlabsessionstore.Session
+	Expire(sessionID entities.SessionID, lastUsedAt time.Time) bool
+	ForgetEndedBefore(cutoff time.Time)
+	TryReserve(maxSessions int) (func(), bool)
+	Occupied() int
+	ReserveEvicting(maxSessions int, freedSlots int, candidates []matlabsessionstore.EvictionCandidate) (func(), []entities.SessionID, bool)
+}
+
+type SessionPool interface {
+	EvictUpTo(logger entities.Logger, n int, commit func(evicted int) bool) []func()
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Reaper stops MATLAB sessions that have been idle for longer than the configured idle timeout,
+// and makes room for new MATLAB sessions when the configured maximum number of sessions is reached.
+type Reaper struct {
+	configFactory     ConfigFactory
+	loggerFactory     LoggerFactory
+	sessionStore      SessionStore
+	sessionPool       SessionPool
+	lifecycleSignaler LifecycleSignaler
+
+	startOnce *sync.Once
+	startErr  error
+
+	l *sync.Mutex
+
+	checkInterval time.Duration
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	sessionStore SessionStore,
+	sessionPool SessionPool,
+	lifecycleSignaler LifecycleSignaler,
+) *Reaper {
+	return &Reaper{
+		configFactory:     configFactory,
+		loggerFactory:     loggerFactory,
+		sessionStore:      sessionStore,
+		sessionPool:       sessionPool,
+		lifecycleSignaler: lifecycleSignaler,
+
+		startOnce: new(sync.Once),
+
+		l: new(sync.Mutex),
+
+		checkInterval: defaultCheckInterval,
+	}
+}
+
+// Start begins reaping idle MATLAB sessions in the background, until the application shuts down.
+// The sessions that ended longer than endedSessionRetention ago are forgotten at the same time.
+// Nothing is reaped when no idle timeout is configured, or when the single MATLAB session is used.
+// Calling Start more than once has no further effect.
+func (r *Reaper) Start() error {
+	r.startOnce.Do(func() {
+		r.startErr = r.start()
+	})
+	return r.startErr
+}
+
+func (r *Reaper) start() error {
+	config, messagesErr := r.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	idleTimeout := config.MATLABSessionIdleTimeout()
+	if config.UseSingleMATLABSession() || idleTimeout <= 0 {
+		return nil
+	}
+
+	logger, messagesErr := r.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	stopC := make(chan struct{})
+	doneC := make(chan struct{})
+	r.lifecycleSignaler.AddShutdownFunction(func() error {
+		close(stopC)
+		<-doneC
+		return nil
+	})
+
+	go func() {
+		defer close(doneC)
+
+		ticker := time.NewTicker(min(r.checkInterval, idleTimeout))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+				r.ReapIdleSessions(context.Background(), logger)
+				r.sessionStore.ForgetEndedBefore(time.Now().Add(-endedSessionRetention))
+			}
+		}
+	}()
+
+	logger.With("idle-timeout", idleTimeout.String()).Info("Started reaping idle MATLAB sessions")
+
+	return nil
+}
+
+// ReapIdleSessions stops every MATLAB session that has been idle for longer than the configured idle timeout.
+func (r *Reaper) ReapIdleSessions(ctx context.Context, logger entities.Logger) {
+	config, messagesErr := r.configFactory.Config()
+	if messagesErr != nil {
+		logger.WithError(messagesErr).Warn("Failed to get configuration to reap idle MATLAB sessions")
+		return
+	}
+
+	idleTimeout := config.MATLABSessionIdleTimeout()
+	if idleTimeout <= 0 {
+		return
+	}
+
+	now := time.Now()
+	for _, session := range r.sessionStore.List() {
+		if idle, isIdle := newIdleSession(session, now); isIdle && idle.idleFor >= idleTimeout {
+			if stop, isExpired := r.expire(ctx, logger, idle); isExpired {
+				stop()
+			}
+		}
+	}
+}
+
+// ReserveSlot makes room for a new MATLAB session when the configured maximum number of sessions is reached,
+// by discarding pooled MATLAB sessions first, and then, when evicting idle sessions is enabled, stopping the least recently used idle sessions.
+// It returns ErrMaxSessionsReached, without stopping any session, when there are not enough sessions to stop.
+// The slot counts as taken until the returned release function is called, which should happen once the new session is in the store, or failed to start.
+func (r *Reaper) ReserveSlot(ctx context.Context, logger entities.Logger) (func(), error) {
+	config, messagesErr := r.configFactory.Config()
+	if messagesErr != nil {
+		return nil, messagesErr
+	}
+
+	maxSessions := config.MaxMATLABSessions()
+	if config.UseSingleMATLABSession() || maxSessions <= 0 {
+		return func() {}, nil
+	}
+
+	r.l.Lock()
+	releaseSlot, stops, err := r.reserveSlot(ctx, logger, maxSessions, config.EvictIdleMATLABSessions())
+	r.l.Unlock()
+
+	// Stopping MATLAB takes a while, so the evicted sessions are stopped outside the lock, which only guards the reservations.
+	for _, stop := range stops {
+		stop()
+	}
+
+	return releaseSlot, err
+}
+
+// reserveSlot must be called with the lock held. It evicts as few sessions as it takes to reserve a slot,
+// and returns the functions that stop the evicted sessions.
+// Sessions are only taken out of the pool and the store once they are known to make enough room,
+// so that no session is stopped when the slot cannot be reserved anyway.
+func (r *Reaper) reserveSlot(ctx context.Context, logger entities.Logger, maxSessions int, evictIdleSessions bool) (func(), []func(), error) {
+	if releaseSlot, isReserved := r.sessionStore.TryReserve(maxSessions); isReserved {
+		return releaseSlot, nil, nil
+	}
+
+	idleSessions := map[entities.SessionID]idleSession{}
+	var candidates []matlabsessionstore.EvictionCandidate
+	if evictIdleSessions {
+		now := time.Now()
+		var leastRecentlyUsed []idleSession
+		for _, session := range r.sessionStore.List() {
+			if idle, isIdle := newIdleSession(session, now); isIdle {
+				leastRecentlyUsed = append(leastRecentlyUsed, idle)
+			}
+		}
+
+		slices.SortStableFunc(leastRecentlyUsed, func(a, b idleSession) int {
+			return cmp.Compare(b.idleFor, a.idleFor)
+		})
+
+		for _, idle := range leastRecentlyUsed {
+			idleSessions[idle.session.ID] = idle
+			candidates = append(candidates, matlabsessionstore.EvictionCandidate{ID: idle.session.ID, LastUsedAt: idle.lastUsedAt})
+		}
+	}
+
+	var releaseSlot func()
+	var evictedSessionIDs []entities.SessionID
+	stops := r.sessionPool.EvictUpTo(logger, r.sessionStore.Occupied()-maxSessions+1, func(evictedPooledSessions int) bool {
+		var isReserved bool
+		releaseSlot, evictedSessionIDs, isReserved = r.sessionStore.ReserveEvicting(maxSessions, evictedPooledSessions, candidates)
+		return isReserved
+	})
+	if releaseSlot == nil {
+		return nil, nil, fmt.Errorf("%w (%d)", ErrMaxSessionsReached, maxSessions)
+	}
+
+	for _, sessionID := range evictedSessionIDs {
+		idle := idleSessions[sessionID]
+		sessionLogger := logger.
+			With("session-id", sessionID).
+			With("idle-for", idle.idleFor.Round(time.Second).String())
+
+		stops = append(stops, func() {
+			r.stop(ctx, sessionLogger, idle.session)
+		})
+	}
+
+	return releaseSlot, stops, nil
+}
+
+// expire removes the session from the store, so that callers holding its ID are told why the session stopped,
+// and returns the function that stops it.
+// It returns false when the store refuses to remove the session because it was used in the meantime.
+func (r *Reaper) expire(ctx context.Context, logger entities.Logger, idle idleSession) (func(), bool) {
+	sessionLogger := logger.
+		With("session-id", idle.session.ID).
+		With("idle-for", idle.idleFor.Round(time.Second).String())
+
+	if !r.sessionStore.Expire(idle.session.ID, idle.lastUsedAt) {
+		sessionLogger.Debug("MATLAB session was used again, keeping it")
+		return nil, false
+	}
+
+	return func() {
+		r.stop(ctx, sessionLogger, idle.session)
+	}, true
+}
+
+func (r *Reaper) stop(ctx context.Context, sessionLogger entities.Logger, session matlabsessionstore.Session) {
+	stopCtx, cancel := context.WithTimeout(ctx, stopTimeout)
+	defer cancel()
+
+	if err := session.Client.StopSession(stopCtx, sessionLogger); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to stop idle MATLAB session")
+		return
+	}
+
+	sessionLogger.Info("Stopped idle MATLAB session")
+}
+
+type idleSession struct {
+	session    matlabsessionstore.Session
+	lastUsedAt time.Time
+	idleFor    time.Duration
+}
+
+// newIdleSession reports whether the session is idle, and for how long. A session that was never used is idle since it started.
+func newIdleSession(session matlabsessionstore.Session, now time.Time) (idleSession, bool) {
+	usage := session.Client.Usage()
+	if usage.IsBusy {
+		return idleSession{}, false
+	}
+
+	lastActiveAt := usage.LastUsedAt
+	if lastActiveAt.IsZero() {
+		lastActiveAt = session.Metadata.StartedAt
+	}
+
+	return idleSession{
+		session:    session,
+		lastUsedAt: usage.LastUsedAt,
+		idleFor:    now.Sub(lastActiveAt),
+	}, true
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionreaper
+
+import "time"
+
+func (r *Reaper) SetCheckInterval(checkInterval time.Duration) {
+	r.checkInterval = checkInterval
+}
+
+// IsLocked reports whether a slot is being reserved.
+func (r *Reaper) IsLocked() bool {
+	if r.l.TryLock() {
+		r.l.Unlock()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,1259 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionreaper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionreaper"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	storemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionreaper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Act
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, reaper)
+}
+
+func TestReaper_Start_ReapsIdleSessionsUntilShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	idleTimeout := time.Minute
+	lastUsedAt := time.Now().Add(-2 * idleTimeout)
+	sessionID := entities.SessionID(1)
+	session := matlabsessionstore.Session{
+		ID:     sessionID,
+		Client: mockClient,
+	}
+
+	var capturedShutdownFunc func() error
+	stoppedC := make(chan struct{})
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil)
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(idleTimeout)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{session}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return(nil)
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	forgottenC := make(chan time.Time, 1)
+	mockSessionStore.EXPECT().
+		ForgetEndedBefore(mock.AnythingOfType("time.Time")).
+		Run(func(cutoff time.Time) {
+			select {
+			case forgottenC <- cutoff:
+			default:
+			}
+		}).
+		Return()
+
+	mockSessionStore.EXPECT().
+		Expire(sessionID, lastUsedAt).
+		Return(true).
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ entities.Logger) {
+			close(stoppedC)
+		}).
+		Return(nil).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+	reaper.SetCheckInterval(time.Millisecond)
+
+	// Act
+	err := reaper.Start()
+
+	// Assert
+	require.NoError(t, err)
+
+	select {
+	case <-stoppedC:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "idle MATLAB session was not stopped")
+	}
+
+	select {
+	case cutoff := <-forgottenC:
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), cutoff, time.Minute)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "ended MATLAB sessions were not forgotten")
+	}
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestReaper_Start_OnlyStartsOnce(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var capturedShutdownFunc func() error
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(time.Hour).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	firstErr := reaper.Start()
+	secondErr := reaper.Start()
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Contains(t, mockLogger.InfoLogs(), "Started reaping idle MATLAB sessions")
+
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestReaper_Start_DoesNothingWithoutIdleTimeout(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		idleTimeout            time.Duration
+		useSingleMATLABSession bool
+	}{
+		{name: "no idle timeout", idleTimeout: 0, useSingleMATLABSession: false},
+		{name: "single MATLAB session", idleTimeout: time.Hour, useSingleMATLABSession: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockSessionPool := &mocks.MockSessionPool{}
+			defer mockSessionPool.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionIdleTimeout().
+				Return(tc.idleTimeout).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+			// Act
+			err := reaper.Start()
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReaper_Start_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	firstErr := reaper.Start()
+	secondErr := reaper.Start()
+
+	// Assert
+	require.ErrorIs(t, firstErr, expectedError)
+	require.ErrorIs(t, secondErr, expectedError)
+}
+
+func TestReaper_ReapIdleSessions_StopsSessionsIdleForLongerThanTimeout(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockIdleClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockIdleClient.AssertExpectations(t)
+
+	mockRecentlyUsedClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockRecentlyUsedClient.AssertExpectations(t)
+
+	mockBusyClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockBusyClient.AssertExpectations(t)
+
+	mockNeverUsedClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockNeverUsedClient.AssertExpectations(t)
+
+	idleTimeout := 10 * time.Minute
+	now := time.Now()
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{
+			{ID: 1, Client: mockIdleClient, Metadata: matlabsessionstore.SessionMetadata{StartedAt: now.Add(-time.Hour)}},
+			{ID: 2, Client: mockRecentlyUsedClient, Metadata: matlabsessionstore.SessionMetadata{StartedAt: now.Add(-time.Hour)}},
+			{ID: 3, Client: mockBusyClient, Metadata: matlabsessionstore.SessionMetadata{StartedAt: now.Add(-time.Hour)}},
+			{ID: 4, Client: mockNeverUsedClient, Metadata: matlabsessionstore.SessionMetadata{StartedAt: now.Add(-time.Hour)}},
+		}).
+		Once()
+
+	mockIdleClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-30 * time.Minute)}).
+		Once()
+
+	mockRecentlyUsedClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-time.Minute)}).
+		Once()
+
+	mockBusyClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-30 * time.Minute), IsBusy: true}).
+		Once()
+
+	mockNeverUsedClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Expire(entities.SessionID(1), now.Add(-30*time.Minute)).
+		Return(true).
+		Once()
+
+	mockIdleClient.EXPECT().
+		StopSession(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Expire(entities.SessionID(4), time.Time{}).
+		Return(true).
+		Once()
+
+	mockNeverUsedClient.EXPECT().
+		StopSession(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	reaper.ReapIdleSessions(ctx, mockLogger)
+
+	// Assert
+	assert.Contains(t, mockLogger.InfoLogs(), "Stopped idle MATLAB session")
+}
+
+func TestReaper_ReapIdleSessions_NoIdleTimeout(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(0).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	reaper.ReapIdleSessions(t.Context(), mockLogger)
+
+	// Assert
+	assert.Empty(t, mockLogger.InfoLogs())
+}
+
+func TestReaper_ReapIdleSessions_StopSessionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	idleTimeout := time.Minute
+	lastUsedAt := time.Now().Add(-time.Hour)
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{{ID: 1, Client: mockClient}}).
+		Once()
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Expire(entities.SessionID(1), lastUsedAt).
+		Return(true).
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	reaper.ReapIdleSessions(ctx, mockLogger)
+
+	// Assert
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to stop idle MATLAB session")
+	assert.NotContains(t, mockLogger.InfoLogs(), "Stopped idle MATLAB session")
+}
+
+func TestReaper_ReapIdleSessions_SessionUsedAgain(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	idleTimeout := time.Minute
+	lastUsedAt := time.Now().Add(-time.Hour)
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{{ID: 1, Client: mockClient}}).
+		Once()
+
+	mockClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: lastUsedAt}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Expire(entities.SessionID(1), lastUsedAt).
+		Return(false).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	reaper.ReapIdleSessions(ctx, mockLogger)
+
+	// Assert
+	assert.Contains(t, mockLogger.DebugLogs(), "MATLAB session was used again, keeping it")
+	assert.NotContains(t, mockLogger.InfoLogs(), "Stopped idle MATLAB session")
+}
+
+func TestReaper_ReserveSlot_BelowMaxSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(2).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(2).
+		Return(func() {}, true).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	releaseSlot, err := reaper.ReserveSlot(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, releaseSlot)
+}
+
+func TestReaper_ReserveSlot_NoMaxSessions(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		maxSessions            int
+		useSingleMATLABSession bool
+	}{
+		{name: "no limit", maxSessions: 0, useSingleMATLABSession: false},
+		{name: "single MATLAB session", maxSessions: 1, useSingleMATLABSession: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockSessionPool := &mocks.MockSessionPool{}
+			defer mockSessionPool.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MaxMATLABSessions().
+				Return(tc.maxSessions).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+			// Act
+			releaseSlot, err := reaper.ReserveSlot(t.Context(), mockLogger)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NotNil(t, releaseSlot)
+		})
+	}
+}
+
+func TestReaper_ReserveSlot_StopsLeastRecentlyUsedIdleSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockRecentlyUsedClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockRecentlyUsedClient.AssertExpectations(t)
+
+	mockLeastRecentlyUsedClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockLeastRecentlyUsedClient.AssertExpectations(t)
+
+	mockBusyClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockBusyClient.AssertExpectations(t)
+
+	now := time.Now()
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(3).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(true).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(3).
+		Return(nil, false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{
+			{ID: 1, Client: mockRecentlyUsedClient},
+			{ID: 2, Client: mockLeastRecentlyUsedClient},
+			{ID: 3, Client: mockBusyClient},
+		}).
+		Once()
+
+	mockRecentlyUsedClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-time.Minute)}).
+		Once()
+
+	mockLeastRecentlyUsedClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-time.Hour)}).
+		Once()
+
+	mockBusyClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-2 * time.Hour), IsBusy: true}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Occupied().
+		Return(3).
+		Once()
+
+	mockSessionPool.EXPECT().
+		EvictUpTo(mockLogger.AsMockArg(), 1, mock.AnythingOfType("func(int) bool")).
+		RunAndReturn(func(_ entities.Logger, _ int, commit func(int) bool) []func() {
+			if !commit(0) {
+				return nil
+			}
+			return nil
+		}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		ReserveEvicting(3, 0, []matlabsessionstore.EvictionCandidate{
+			{ID: 2, LastUsedAt: now.Add(-time.Hour)},
+			{ID: 1, LastUsedAt: now.Add(-time.Minute)},
+		}).
+		Return(func() {}, []entities.SessionID{2}, true).
+		Once()
+
+	var reaper *sessionreaper.Reaper
+	var isLockedWhileStopping bool
+
+	mockLeastRecentlyUsedClient.EXPECT().
+		StopSession(mock.MatchedBy(hasDeadline), mockLogger.AsMockArg()).
+		Run(func(context.Context, entities.Logger) {
+			isLockedWhileStopping = reaper.IsLocked()
+		}).
+		Return(nil).
+		Once()
+
+	reaper = sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	releaseSlot, err := reaper.ReserveSlot(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, releaseSlot)
+	assert.Contains(t, mockLogger.InfoLogs(), "Stopped idle MATLAB session")
+	assert.False(t, isLockedWhileStopping, "Idle session should be stopped outside the lock")
+}
+
+func TestReaper_ReserveSlot_AllSessionsBusy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockBusyClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockBusyClient.AssertExpectations(t)
+
+	now := time.Now()
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(1).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(true).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(1).
+		Return(nil, false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{{ID: 1, Client: mockBusyClient}}).
+		Once()
+
+	mockBusyClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now, IsBusy: true}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Occupied().
+		Return(1).
+		Once()
+
+	mockSessionPool.EXPECT().
+		EvictUpTo(mockLogger.AsMockArg(), 1, mock.AnythingOfType("func(int) bool")).
+		RunAndReturn(func(_ entities.Logger, _ int, commit func(int) bool) []func() {
+			if !commit(0) {
+				return nil
+			}
+			return nil
+		}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		ReserveEvicting(1, 0, []matlabsessionstore.EvictionCandidate(nil)).
+		Return(nil, nil, false).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	_, err := reaper.ReserveSlot(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, sessionreaper.ErrMaxSessionsReached)
+}
+
+func TestReaper_ReserveSlot_NotEnoughSessionsToStop_StopsNothing(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockIdleClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockIdleClient.AssertExpectations(t)
+
+	mockBusyClient := &storemocks.MockMATLABSessionClientWithCleanup{}
+	defer mockBusyClient.AssertExpectations(t)
+
+	now := time.Now()
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(2).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(true).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(2).
+		Return(nil, false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		List().
+		Return([]matlabsessionstore.Session{
+			{ID: 1, Client: mockIdleClient},
+			{ID: 2, Client: mockBusyClient},
+		}).
+		Once()
+
+	mockIdleClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now.Add(-time.Hour)}).
+		Once()
+
+	mockBusyClient.EXPECT().
+		Usage().
+		Return(matlabsessionstore.Usage{LastUsedAt: now, IsBusy: true}).
+		Once()
+
+	// Two more sessions are still starting, so one pooled session and one idle session do not make enough room.
+	mockSessionStore.EXPECT().
+		Occupied().
+		Return(5).
+		Once()
+
+	var pooledSessionStopped bool
+
+	mockSessionPool.EXPECT().
+		EvictUpTo(mockLogger.AsMockArg(), 4, mock.AnythingOfType("func(int) bool")).
+		RunAndReturn(func(_ entities.Logger, _ int, commit func(int) bool) []func() {
+			if !commit(1) {
+				return nil
+			}
+			return []func(){func() { pooledSessionStopped = true }}
+		}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		ReserveEvicting(2, 1, []matlabsessionstore.EvictionCandidate{{ID: 1, LastUsedAt: now.Add(-time.Hour)}}).
+		Return(nil, nil, false).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	releaseSlot, err := reaper.ReserveSlot(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, sessionreaper.ErrMaxSessionsReached)
+	assert.Nil(t, releaseSlot)
+	assert.False(t, pooledSessionStopped, "Pooled session should not be stopped when the slot cannot be reserved")
+	mockIdleClient.AssertNotCalled(t, "StopSession", mock.Anything, mock.Anything)
+	mockBusyClient.AssertNotCalled(t, "StopSession", mock.Anything, mock.Anything)
+}
+
+func TestReaper_ReserveSlot_IdleSessionEvictionDisabled_DoesNotStopIdleSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(1).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(1).
+		Return(nil, false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Occupied().
+		Return(1).
+		Once()
+
+	mockSessionPool.EXPECT().
+		EvictUpTo(mockLogger.AsMockArg(), 1, mock.AnythingOfType("func(int) bool")).
+		RunAndReturn(func(_ entities.Logger, _ int, commit func(int) bool) []func() {
+			if !commit(0) {
+				return nil
+			}
+			return nil
+		}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		ReserveEvicting(1, 0, []matlabsessionstore.EvictionCandidate(nil)).
+		Return(nil, nil, false).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	_, err := reaper.ReserveSlot(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, sessionreaper.ErrMaxSessionsReached)
+}
+
+func TestReaper_ReserveSlot_DiscardsPooledSessionsFirst(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxMATLABSessions().
+		Return(2).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EvictIdleMATLABSessions().
+		Return(false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		TryReserve(2).
+		Return(nil, false).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Occupied().
+		Return(2).
+		Once()
+
+	var pooledSessionStopped bool
+
+	mockSessionPool.EXPECT().
+		EvictUpTo(mockLogger.AsMockArg(), 1, mock.AnythingOfType("func(int) bool")).
+		RunAndReturn(func(_ entities.Logger, _ int, commit func(int) bool) []func() {
+			if !commit(1) {
+				return nil
+			}
+			return []func(){func() { pooledSessionStopped = true }}
+		}).
+		Once()
+
+	mockSessionStore.EXPECT().
+		ReserveEvicting(2, 1, []matlabsessionstore.EvictionCandidate(nil)).
+		Return(func() {}, nil, true).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	releaseSlot, err := reaper.ReserveSlot(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, releaseSlot)
+	assert.True(t, pooledSessionStopped, "Evicted pooled session should be stopped")
+}
+
+func TestReaper_ReserveSlot_ConfigError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	reaper := sessionreaper.New(mockConfigFactory, mockLoggerFactory, mockSessionStore, mockSessionPool, mockLifecycleSignaler)
+
+	// Act
+	_, err := reaper.ReserveSlot(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func hasDeadline(ctx context.Context) bool {
+	_, ok := ctx.Deadline()
+	return ok
+}
@@ -5,14 +5,21 @@ package sessiondiscovery
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"path/filepath"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 )
 
-const sessionDetailsFileName = "sessionDetails.json"
+const (
+	sessionDetailsFileName = "sessionDetails.json"
+	sessionsFolderName     = "sessions"
+)
 
 var ErrInvalidSessionDetails = errors.New("invalid session details")
 
@@ -22,29 +29,146 @@ type AppDataDirGetter interface {
 
 type OSLayer interface {
 	ReadFile(filePath string) ([]byte, error)
+	Glob(pattern string) ([]string, error)
+	Remove(name string) error
+}
+
+type ProcessFinder interface {
+	FindProcess(processPid int) osfacade.Process
+}
+
+// Session is a MATLAB session shared with shareMATLABSession.
+type Session struct {
+	ConnectionDetails embeddedconnector.ConnectionDetails
+	PID               int
+	Name              string
+	Release           string
+	WorkingFolder     string
+	SharedAt          time.Time
+
+	// DetailsFilePath is the file the session was discovered from.
+	DetailsFilePath string
 }
 
 type sessionDetailsJSON struct {
-	Port        json.Number `json:"port"`
-	Certificate string      `json:"certificate"`
-	APIKey      string      `json:"apiKey"`
-	PID         json.Number `json:"pid"`
+	Port          json.Number `json:"port"`
+	Certificate   string      `json:"certificate"`
+	APIKey        string      `json:"apiKey"`
+	PID           json.Number `json:"pid"`
+	Name          string      `json:"name"`
+	Release       string      `json:"release"`
+	WorkingFolder string      `json:"workingFolder"`
+	SharedAt      json.Number `json:"sharedAt"`
 }
 
 type SessionDiscoverer struct {
 	appDataDirGetter AppDataDirGetter
 	osLayer          OSLayer
+	processFinder    ProcessFinder
 }
 
-func New(appDataDirGetter AppDataDirGetter, osLayer OSLayer) *SessionDiscoverer {
+func New(appDataDirGetter AppDataDirGetter, osLayer OSLayer, processFinder ProcessFinder) *SessionDiscoverer {
 	return &SessionDiscoverer{
 		appDataDirGetter: appDataDirGetter,
 		osLayer:          osLayer,
+		processFinder:    processFinder,
 	}
 }
 
 func (d *SessionDiscoverer) FromSessionDetails(logger entities.Logger, sessionDetails []byte) (embeddedconnector.ConnectionDetails, error) {
-	var zeroValue embeddedconnector.ConnectionDetails
+	session, err := d.parseSession(logger, sessionDetails)
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, err
+	}
+
+	return session.ConnectionDetails, nil
+}
+
+// DiscoverSessions returns the shared MATLAB sessions whose process is still running, most recently shared first.
+// Details files left behind by MATLAB processes that have exited are deleted.
+func (d *SessionDiscoverer) DiscoverSessions(logger entities.Logger) []Session {
+	appDataDir, err := d.appDataDirGetter.AppDataDir()
+	if err != nil {
+		logger.WithError(err).Debug("Failed to determine app data directory for session discovery")
+		return nil
+	}
+
+	// Hardcoding v1 for now, if we end up having multiple version, we'll need version based handlers
+	v1Dir := filepath.Join(appDataDir, "v1")
+
+	sessionFilePaths, err := d.osLayer.Glob(filepath.Join(v1Dir, sessionsFolderName, "*.json"))
+	if err != nil {
+		logger.WithError(err).Debug("Failed to list shared MATLAB session files")
+	}
+
+	var sessions []Session
+	seenPIDs := map[int]bool{}
+
+	for _, sessionFilePath := range sessionFilePaths {
+		session, ok := d.readSessionFile(logger, sessionFilePath)
+		if !ok {
+			continue
+		}
+
+		if session.PID > 0 && d.processFinder.FindProcess(session.PID) == nil {
+			fileLogger := logger.With("pid", session.PID)
+			fileLogger.Debug("Removing details of shared MATLAB session whose process has exited")
+			if err := d.osLayer.Remove(sessionFilePath); err != nil {
+				fileLogger.WithError(err).Debug("Failed to remove stale shared MATLAB session file")
+			}
+			continue
+		}
+
+		seenPIDs[session.PID] = true
+		sessions = append(sessions, session)
+	}
+
+	// Toolbox versions that predate per-session files only write this file.
+	if legacySession, ok := d.readSessionFile(logger, filepath.Join(v1Dir, sessionDetailsFileName)); ok {
+		isStale := legacySession.PID > 0 && d.processFinder.FindProcess(legacySession.PID) == nil
+		if !seenPIDs[legacySession.PID] && !isStale {
+			sessions = append(sessions, legacySession)
+		}
+	}
+
+	slices.SortStableFunc(sessions, func(a, b Session) int {
+		return b.SharedAt.Compare(a.SharedAt)
+	})
+
+	return sessions
+}
+
+// RemoveSession deletes the details file of a discovered session, so the session is no longer discovered.
+func (d *SessionDiscoverer) RemoveSession(logger entities.Logger, session Session) {
+	if session.DetailsFilePath == "" {
+		return
+	}
+
+	if err := d.osLayer.Remove(session.DetailsFilePath); err != nil {
+		logger.WithError(err).Debug("Failed to remove shared MATLAB session file")
+	}
+}
+
+func (d *SessionDiscoverer) readSessionFile(logger entities.Logger, sessionFilePath string) (Session, bool) {
+	data, err := d.osLayer.ReadFile(sessionFilePath)
+	if err != nil {
+		logger.WithError(err).Debug("No shared MATLAB session file found")
+		return Session{}, false
+	}
+
+	session, err := d.parseSession(logger, data)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to get connection details from session details")
+		return Session{}, false
+	}
+
+	session.DetailsFilePath = sessionFilePath
+
+	return session, true
+}
+
+func (d *SessionDiscoverer) parseSession(logger entities.Logger, sessionDetails []byte) (Session, error) {
+	var zeroValue Session
 
 	var details sessionDetailsJSON
 	if err := json.Unmarshal(sessionDetails, &details); err != nil {
@@ -78,34 +202,30 @@ func (d *SessionDiscoverer) FromSessionDetails(logger entities.Logger, sessionDe
 		return zeroValue, ErrInvalidSessionDetails
 	}
 
-	return embeddedconnector.ConnectionDetails{
-		Host:           "localhost",
-		Port:           port,
-		APIKey:         details.APIKey,
-		CertificatePEM: certificatePEM,
-	}, nil
-}
-
-func (d *SessionDiscoverer) DiscoverSessions(logger entities.Logger) []embeddedconnector.ConnectionDetails {
-	appDataDir, err := d.appDataDirGetter.AppDataDir()
+	// The PID, release, working folder and share time are informational: older toolbox versions may not write them.
+	pid, err := strconv.Atoi(details.PID.String())
 	if err != nil {
-		logger.WithError(err).Debug("Failed to determine app data directory for session discovery")
-		return nil
+		pid = 0
 	}
 
-	// Hardcoding v1 for now, if we end up having multiple version, we'll need version based handlers
-	sessionFilePath := filepath.Join(appDataDir, "v1", sessionDetailsFileName)
-	data, err := d.osLayer.ReadFile(sessionFilePath)
-	if err != nil {
-		logger.WithError(err).Debug("No shared MATLAB session file found")
-		return nil
+	var sharedAt time.Time
+	if sharedAtSeconds, err := details.SharedAt.Float64(); err == nil {
+		seconds, fraction := math.Modf(sharedAtSeconds)
+		sharedAt = time.Unix(int64(seconds), int64(fraction*float64(time.Second)))
 	}
 
-	connectionDetails, err := d.FromSessionDetails(logger, data)
-	if err != nil {
-		logger.WithError(err).Debug("Failed to get connection details from session details")
-		return nil
-	}
-
-	return []embeddedconnector.ConnectionDetails{connectionDetails}
+	return Session{
+		ConnectionDetails: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           port,
+			APIKey:         details.APIKey,
+			CertificatePEM: certificatePEM,
+			ProcessID:      pid,
+		},
+		PID:           pid,
+		Name:          details.Name,
+		Release:       details.Release,
+		WorkingFolder: details.WorkingFolder,
+		SharedAt:      sharedAt,
+	}, nil
 }
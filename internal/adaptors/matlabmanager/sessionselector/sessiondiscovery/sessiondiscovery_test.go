@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionselector/sessiondiscovery"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,8 +25,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	// Act
-	result := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	result := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Assert
 	require.NotNil(t, result)
@@ -38,6 +43,9 @@ func TestSessionDiscoverer_FromSessionDetails_HappyPath(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedCertPath := filepath.Join("path", "to", "cert.pem")
@@ -57,7 +65,7 @@ func TestSessionDiscoverer_FromSessionDetails_HappyPath(t *testing.T) {
 		Return(expectedCertPEM, nil).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -78,11 +86,14 @@ func TestSessionDiscoverer_FromSessionDetails_InvalidJSON(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	invalidJSON := []byte("not valid json")
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, invalidJSON)
@@ -100,6 +111,9 @@ func TestSessionDiscoverer_FromSessionDetails_MissingPort(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := marshallSessionDetails(t, map[string]any{
@@ -108,7 +122,7 @@ func TestSessionDiscoverer_FromSessionDetails_MissingPort(t *testing.T) {
 		"pid":         100,
 	})
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -126,11 +140,14 @@ func TestSessionDiscoverer_FromSessionDetails_PortNotANumber(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := []byte(`{"port":"not-a-number","certificate":"cert.pem","apiKey":"key","pid":100}`)
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -148,11 +165,14 @@ func TestSessionDiscoverer_FromSessionDetails_PortNotAnInt(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := []byte(`{"port":"1.5","certificate":"cert.pem","apiKey":"key","pid":100}`)
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -170,6 +190,9 @@ func TestSessionDiscoverer_FromSessionDetails_PortBelowRange(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := marshallSessionDetails(t, map[string]any{
@@ -179,7 +202,7 @@ func TestSessionDiscoverer_FromSessionDetails_PortBelowRange(t *testing.T) {
 		"pid":         100,
 	})
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -197,6 +220,9 @@ func TestSessionDiscoverer_FromSessionDetails_PortAboveRange(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := marshallSessionDetails(t, map[string]any{
@@ -206,7 +232,7 @@ func TestSessionDiscoverer_FromSessionDetails_PortAboveRange(t *testing.T) {
 		"pid":         100,
 	})
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -224,6 +250,9 @@ func TestSessionDiscoverer_FromSessionDetails_EmptyAPIKey(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionJSON := marshallSessionDetails(t, map[string]any{
@@ -233,7 +262,7 @@ func TestSessionDiscoverer_FromSessionDetails_EmptyAPIKey(t *testing.T) {
 		"pid":         100,
 	})
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -251,6 +280,9 @@ func TestSessionDiscoverer_FromSessionDetails_CertificateReadError(t *testing.T)
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedCertPath := filepath.Join("path", "to", "cert.pem")
@@ -267,7 +299,7 @@ func TestSessionDiscoverer_FromSessionDetails_CertificateReadError(t *testing.T)
 		Return(nil, assert.AnError).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -285,6 +317,9 @@ func TestSessionDiscoverer_FromSessionDetails_EmptyCertificatePEM(t *testing.T)
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedCertPath := filepath.Join("path", "to", "cert.pem")
@@ -301,7 +336,7 @@ func TestSessionDiscoverer_FromSessionDetails_EmptyCertificatePEM(t *testing.T)
 		Return([]byte(""), nil).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	result, err := discoverer.FromSessionDetails(mockLogger, sessionJSON)
@@ -319,6 +354,9 @@ func TestSessionDiscoverer_DiscoverSessions_HappyPath(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
@@ -339,6 +377,11 @@ func TestSessionDiscoverer_DiscoverSessions_HappyPath(t *testing.T) {
 		Return(expectedAppDataDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return(nil, nil).
+		Once()
+
 	expectedSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
 	mockOSLayer.EXPECT().
 		ReadFile(expectedSessionFile).
@@ -350,17 +393,24 @@ func TestSessionDiscoverer_DiscoverSessions_HappyPath(t *testing.T) {
 		Return(expectedCertPEM, nil).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	mockProcessFinder.EXPECT().
+		FindProcess(12345).
+		Return(&osfacademocks.MockProcess{}).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
 
 	// Assert
 	require.Len(t, sessions, 1)
-	assert.Equal(t, "localhost", sessions[0].Host)
-	assert.Equal(t, fmt.Sprintf("%d", expectedPort), sessions[0].Port)
-	assert.Equal(t, expectedAPIKey, sessions[0].APIKey)
-	assert.Equal(t, expectedCertPEM, sessions[0].CertificatePEM)
+	assert.Equal(t, "localhost", sessions[0].ConnectionDetails.Host)
+	assert.Equal(t, fmt.Sprintf("%d", expectedPort), sessions[0].ConnectionDetails.Port)
+	assert.Equal(t, expectedAPIKey, sessions[0].ConnectionDetails.APIKey)
+	assert.Equal(t, expectedCertPEM, sessions[0].ConnectionDetails.CertificatePEM)
+	assert.Equal(t, 12345, sessions[0].ConnectionDetails.ProcessID)
+	assert.Equal(t, expectedSessionFile, sessions[0].DetailsFilePath)
 }
 
 func TestSessionDiscoverer_DiscoverSessions_AppDataDirError(t *testing.T) {
@@ -371,6 +421,9 @@ func TestSessionDiscoverer_DiscoverSessions_AppDataDirError(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockAppDataDirGetter.EXPECT().
@@ -378,7 +431,7 @@ func TestSessionDiscoverer_DiscoverSessions_AppDataDirError(t *testing.T) {
 		Return("", assert.AnError).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
@@ -396,6 +449,9 @@ func TestSessionDiscoverer_DiscoverSessions_SessionFileNotFound(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
@@ -405,13 +461,18 @@ func TestSessionDiscoverer_DiscoverSessions_SessionFileNotFound(t *testing.T) {
 		Return(expectedAppDataDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return(nil, nil).
+		Once()
+
 	expectedSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
 	mockOSLayer.EXPECT().
 		ReadFile(expectedSessionFile).
 		Return(nil, assert.AnError).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
@@ -429,6 +490,9 @@ func TestSessionDiscoverer_DiscoverSessions_InvalidJSON(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
@@ -438,13 +502,18 @@ func TestSessionDiscoverer_DiscoverSessions_InvalidJSON(t *testing.T) {
 		Return(expectedAppDataDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return(nil, nil).
+		Once()
+
 	expectedSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
 	mockOSLayer.EXPECT().
 		ReadFile(expectedSessionFile).
 		Return([]byte("not valid json"), nil).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
@@ -462,6 +531,9 @@ func TestSessionDiscoverer_DiscoverSessions_InvalidPort(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
@@ -473,13 +545,18 @@ func TestSessionDiscoverer_DiscoverSessions_InvalidPort(t *testing.T) {
 		Return(expectedAppDataDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return(nil, nil).
+		Once()
+
 	expectedSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
 	mockOSLayer.EXPECT().
 		ReadFile(expectedSessionFile).
 		Return(sessionJSON, nil).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
@@ -497,6 +574,9 @@ func TestSessionDiscoverer_DiscoverSessions_CertificateReadError(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
@@ -514,6 +594,11 @@ func TestSessionDiscoverer_DiscoverSessions_CertificateReadError(t *testing.T) {
 		Return(expectedAppDataDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return(nil, nil).
+		Once()
+
 	expectedSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
 	mockOSLayer.EXPECT().
 		ReadFile(expectedSessionFile).
@@ -525,7 +610,7 @@ func TestSessionDiscoverer_DiscoverSessions_CertificateReadError(t *testing.T) {
 		Return(nil, assert.AnError).
 		Once()
 
-	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
 
 	// Act
 	sessions := discoverer.DiscoverSessions(mockLogger)
@@ -535,6 +620,252 @@ func TestSessionDiscoverer_DiscoverSessions_CertificateReadError(t *testing.T) {
 	assert.Len(t, mockLogger.DebugLogs(), 1)
 }
 
+func TestSessionDiscoverer_DiscoverSessions_MultipleSessionsMostRecentFirst(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
+	expectedCertPath := filepath.Join("path", "to", "cert.pem")
+	expectedCertPEM := []byte("-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----")
+	olderSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessions", "100.json")
+	newerSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessions", "200.json")
+	legacySessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
+
+	olderSessionJSON := marshallSessionDetails(t, map[string]any{
+		"port":        31515,
+		"certificate": expectedCertPath,
+		"apiKey":      "older-api-key",
+		"pid":         100,
+		"name":        "older",
+		"sharedAt":    1767225600,
+	})
+	newerSessionJSON := marshallSessionDetails(t, map[string]any{
+		"port":          31516,
+		"certificate":   expectedCertPath,
+		"apiKey":        "newer-api-key",
+		"pid":           200,
+		"name":          "newer",
+		"release":       "R2026a",
+		"workingFolder": filepath.Join("home", "user", "work"),
+		"sharedAt":      1767225700.5,
+	})
+
+	mockAppDataDirGetter.EXPECT().
+		AppDataDir().
+		Return(expectedAppDataDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return([]string{olderSessionFile, newerSessionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(olderSessionFile).
+		Return(olderSessionJSON, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(newerSessionFile).
+		Return(newerSessionJSON, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(legacySessionFile).
+		Return(newerSessionJSON, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(expectedCertPath).
+		Return(expectedCertPEM, nil).
+		Times(3)
+
+	mockProcessFinder.EXPECT().
+		FindProcess(100).
+		Return(&osfacademocks.MockProcess{}).
+		Once()
+
+	mockProcessFinder.EXPECT().
+		FindProcess(200).
+		Return(&osfacademocks.MockProcess{}).
+		Twice()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
+
+	// Act
+	sessions := discoverer.DiscoverSessions(mockLogger)
+
+	// Assert
+	require.Len(t, sessions, 2)
+	assert.Equal(t, 200, sessions[0].PID)
+	assert.Equal(t, "newer", sessions[0].Name)
+	assert.Equal(t, "31516", sessions[0].ConnectionDetails.Port)
+	assert.Equal(t, "R2026a", sessions[0].Release)
+	assert.Equal(t, filepath.Join("home", "user", "work"), sessions[0].WorkingFolder)
+	assert.Equal(t, time.Unix(1767225700, int64(500*time.Millisecond)), sessions[0].SharedAt)
+	assert.Equal(t, 100, sessions[1].PID)
+	assert.Equal(t, "older", sessions[1].Name)
+	assert.Equal(t, time.Unix(1767225600, 0), sessions[1].SharedAt)
+}
+
+func TestSessionDiscoverer_DiscoverSessions_RemovesSessionsWhoseProcessExited(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPCoreServer")
+	expectedCertPath := filepath.Join("path", "to", "cert.pem")
+	staleSessionFile := filepath.Join(expectedAppDataDir, "v1", "sessions", "100.json")
+	legacySessionFile := filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json")
+
+	staleSessionJSON := marshallSessionDetails(t, map[string]any{
+		"port":        31515,
+		"certificate": expectedCertPath,
+		"apiKey":      "stale-api-key",
+		"pid":         100,
+	})
+
+	mockAppDataDirGetter.EXPECT().
+		AppDataDir().
+		Return(expectedAppDataDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(expectedAppDataDir, "v1", "sessions", "*.json")).
+		Return([]string{staleSessionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(staleSessionFile).
+		Return(staleSessionJSON, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(legacySessionFile).
+		Return(staleSessionJSON, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(expectedCertPath).
+		Return([]byte("cert-content"), nil).
+		Twice()
+
+	mockProcessFinder.EXPECT().
+		FindProcess(100).
+		Return(nil).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		Remove(staleSessionFile).
+		Return(nil).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
+
+	// Act
+	sessions := discoverer.DiscoverSessions(mockLogger)
+
+	// Assert
+	assert.Empty(t, sessions)
+}
+
+func TestSessionDiscoverer_RemoveSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionFile := filepath.Join("home", "user", "MATLABMCPCoreServer", "v1", "sessions", "1234.json")
+
+	mockOSLayer.EXPECT().
+		Remove(expectedSessionFile).
+		Return(nil).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
+
+	// Act
+	discoverer.RemoveSession(mockLogger, sessiondiscovery.Session{PID: 1234, DetailsFilePath: expectedSessionFile})
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
+func TestSessionDiscoverer_RemoveSession_RemoveFails(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionFile := filepath.Join("home", "user", "MATLABMCPCoreServer", "v1", "sessions", "1234.json")
+
+	mockOSLayer.EXPECT().
+		Remove(expectedSessionFile).
+		Return(assert.AnError).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
+
+	// Act
+	discoverer.RemoveSession(mockLogger, sessiondiscovery.Session{PID: 1234, DetailsFilePath: expectedSessionFile})
+
+	// Assert
+	assert.Contains(t, mockLogger.DebugLogs(), "Failed to remove shared MATLAB session file")
+}
+
+func TestSessionDiscoverer_RemoveSession_NoDetailsFile(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessFinder := &mocks.MockProcessFinder{}
+	defer mockProcessFinder.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer, mockProcessFinder)
+
+	// Act
+	discoverer.RemoveSession(mockLogger, sessiondiscovery.Session{PID: 1234})
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
 func marshallSessionDetails(t *testing.T, rawData map[string]any) []byte {
 	t.Helper()
 
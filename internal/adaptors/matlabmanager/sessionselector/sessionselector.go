@@ -3,15 +3,25 @@
 package sessionselector
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 )
 
-var ErrNoMATLABSessionDiscovered = errors.New("no MATLAB session discovered")
+var (
+	ErrNoMATLABSessionDiscovered = errors.New("no MATLAB session discovered")
+	ErrNoMatchingMATLABSession   = errors.New("no shared MATLAB session matches the session selector")
+	ErrNoRespondingMATLABSession = errors.New("no shared MATLAB session matching the session selector is responding")
+)
 
 type ConfigFactory interface {
 	Config() (config.Config, messages.Error)
@@ -19,22 +29,29 @@ type ConfigFactory interface {
 
 type SessionDiscoverer interface {
 	FromSessionDetails(logger entities.Logger, sessionDetails []byte) (embeddedconnector.ConnectionDetails, error)
-	DiscoverSessions(logger entities.Logger) []embeddedconnector.ConnectionDetails
+	DiscoverSessions(logger entities.Logger) []sessiondiscovery.Session
+	RemoveSession(logger entities.Logger, session sessiondiscovery.Session)
+}
+
+type MATLABSessionClientFactory interface {
+	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
 }
 
 type SessionSelector struct {
 	configFactory     ConfigFactory
 	sessionDiscoverer SessionDiscoverer
+	clientFactory     MATLABSessionClientFactory
 }
 
-func New(configFactory ConfigFactory, sessionDiscoverer SessionDiscoverer) *SessionSelector {
+func New(configFactory ConfigFactory, sessionDiscoverer SessionDiscoverer, clientFactory MATLABSessionClientFactory) *SessionSelector {
 	return &SessionSelector{
 		configFactory:     configFactory,
 		sessionDiscoverer: sessionDiscoverer,
+		clientFactory:     clientFactory,
 	}
 }
 
-func (s *SessionSelector) SelectSessionToAttachTo(logger entities.Logger) (embeddedconnector.ConnectionDetails, error) {
+func (s *SessionSelector) SelectSessionToAttachTo(ctx context.Context, logger entities.Logger, request entities.AttachToExistingSession) (embeddedconnector.ConnectionDetails, error) {
 	config, err := s.configFactory.Config()
 	if err != nil {
 		return embeddedconnector.ConnectionDetails{}, err
@@ -42,7 +59,7 @@ func (s *SessionSelector) SelectSessionToAttachTo(logger entities.Logger) (embed
 
 	sessionDetails := config.MATLABSessionConnectionDetails()
 
-	if sessionDetails != "" {
+	if sessionDetails != "" && request.ProcessID == 0 {
 		logger.Debug("Attaching to specified existing session")
 
 		connectionDetails, err := s.sessionDiscoverer.FromSessionDetails(logger, []byte(sessionDetails))
@@ -60,5 +77,124 @@ func (s *SessionSelector) SelectSessionToAttachTo(logger entities.Logger) (embed
 		return embeddedconnector.ConnectionDetails{}, ErrNoMATLABSessionDiscovered
 	}
 
-	return discoveredSessions[0], nil
+	selector := strconv.Itoa(request.ProcessID)
+	if request.ProcessID == 0 {
+		selector = config.MATLABSessionSelector()
+	}
+
+	candidates := filterSessions(discoveredSessions, selector)
+	if len(candidates) == 0 {
+		return embeddedconnector.ConnectionDetails{}, fmt.Errorf("%w %q; shared sessions: %s", ErrNoMatchingMATLABSession, selector, describeSessions(discoveredSessions))
+	}
+
+	// Candidates are ordered most recently shared first, so the first one that responds wins.
+	for _, candidate := range candidates {
+		candidateLogger := logger.With("pid", candidate.PID)
+		if s.isRespondingOrForget(ctx, candidateLogger, config, candidate) {
+			candidateLogger.Debug("Selected shared MATLAB session")
+			return candidate.ConnectionDetails, nil
+		}
+	}
+
+	return embeddedconnector.ConnectionDetails{}, ErrNoRespondingMATLABSession
+}
+
+// DiscoverSharedSessions returns the shared MATLAB sessions that can be attached to, most recently shared first.
+// Sessions that do not respond are forgotten and left out.
+func (s *SessionSelector) DiscoverSharedSessions(ctx context.Context, logger entities.Logger) []sessiondiscovery.Session {
+	config, err := s.configFactory.Config()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get configuration to discover shared MATLAB sessions")
+		return nil
+	}
+
+	discoveredSessions := s.sessionDiscoverer.DiscoverSessions(logger)
+
+	isResponding := make([]bool, len(discoveredSessions))
+
+	var wg sync.WaitGroup
+	for i, session := range discoveredSessions {
+		wg.Go(func() {
+			isResponding[i] = s.isRespondingOrForget(ctx, logger.With("pid", session.PID), config, session)
+		})
+	}
+	wg.Wait()
+
+	respondingSessions := make([]sessiondiscovery.Session, 0, len(discoveredSessions))
+	for i, session := range discoveredSessions {
+		if isResponding[i] {
+			respondingSessions = append(respondingSessions, session)
+		}
+	}
+
+	return respondingSessions
+}
+
+// FindSharedSession returns the discovered shared MATLAB session with the given process ID, without checking that it responds.
+func (s *SessionSelector) FindSharedSession(logger entities.Logger, processID int) (sessiondiscovery.Session, bool) {
+	for _, session := range s.sessionDiscoverer.DiscoverSessions(logger) {
+		if session.PID == processID {
+			return session, true
+		}
+	}
+	return sessiondiscovery.Session{}, false
+}
+
+// isRespondingOrForget reports whether the session responds. The process of a discovered session is running,
+// so a session that does not respond has stale details, for example because MATLAB stopped sharing the session
+// or the process ID was reused. Such a session is forgotten.
+func (s *SessionSelector) isRespondingOrForget(ctx context.Context, logger entities.Logger, config config.Config, session sessiondiscovery.Session) bool {
+	if s.isResponding(ctx, logger, config, session) {
+		return true
+	}
+
+	logger.Debug("Removing details of shared MATLAB session that does not respond")
+	s.sessionDiscoverer.RemoveSession(logger, session)
+	return false
+}
+
+func (s *SessionSelector) isResponding(ctx context.Context, logger entities.Logger, config config.Config, session sessiondiscovery.Session) bool {
+	client, err := s.clientFactory.New(session.ConnectionDetails)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to create client for shared MATLAB session")
+		return false
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, config.MATLABSessionConnectionTimeout())
+	defer cancel()
+
+	return client.Ping(pingCtx, logger).IsAlive
+}
+
+// filterSessions keeps the sessions matching the selector: latest matches every session,
+// a number matches the process ID, and anything else matches the session name.
+func filterSessions(sessions []sessiondiscovery.Session, selector string) []sessiondiscovery.Session {
+	if selector == "" || strings.EqualFold(selector, entities.MATLABSessionSelectorLatest) {
+		return sessions
+	}
+
+	pid, err := strconv.Atoi(selector)
+	isPIDSelector := err == nil
+
+	var matches []sessiondiscovery.Session
+	for _, session := range sessions {
+		if isPIDSelector && session.PID == pid {
+			matches = append(matches, session)
+		} else if !isPIDSelector && session.Name == selector {
+			matches = append(matches, session)
+		}
+	}
+
+	return matches
+}
+
+func describeSessions(sessions []sessiondiscovery.Session) string {
+	descriptions := make([]string, len(sessions))
+	for i, session := range sessions {
+		descriptions[i] = "pid " + strconv.Itoa(session.PID)
+		if session.Name != "" {
+			descriptions[i] += fmt.Sprintf(" (%q)", session.Name)
+		}
+	}
+	return strings.Join(descriptions, ", ")
 }
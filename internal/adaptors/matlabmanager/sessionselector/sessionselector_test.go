@@ -4,14 +4,19 @@ package sessionselector_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/sessionselector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,8 +28,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
 	// Act
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Assert
 	assert.NotNil(t, attacher)
@@ -43,6 +51,9 @@ func TestSessionSelector_SelectSessionToAttachTo_WithSessionDetails_HappyPath(t
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
 	sessionDetailsJSON := `{"port":31515,"certificate":"/path/to/cert.pem","apiKey":"test-api-key"}`
 	expectedConnectionDetails := embeddedconnector.ConnectionDetails{
 		Host:           "localhost",
@@ -66,10 +77,10 @@ func TestSessionSelector_SelectSessionToAttachTo_WithSessionDetails_HappyPath(t
 		Return(expectedConnectionDetails, nil).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
 
 	// Assert
 	require.NoError(t, err)
@@ -89,6 +100,12 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_HappyPath(t *testing.
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
 	expectedConnectionDetails := embeddedconnector.ConnectionDetails{
 		Host:           "localhost",
 		Port:           "31515",
@@ -106,22 +123,42 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_HappyPath(t *testing.
 		Return("").
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionSelector().
+		Return(entities.MATLABSessionSelectorLatest).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Once()
+
 	mockSessionDiscoverer.EXPECT().
 		DiscoverSessions(mockLogger.AsMockArg()).
-		Return([]embeddedconnector.ConnectionDetails{expectedConnectionDetails}).
+		Return([]sessiondiscovery.Session{{ConnectionDetails: expectedConnectionDetails, PID: 1234}}).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	mockClientFactory.EXPECT().
+		New(expectedConnectionDetails).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedConnectionDetails, connectionDetails)
 }
 
-func TestSessionSelector_SelectSessionToAttachTo_Discovery_MultipleSessionsUsesFirst(t *testing.T) {
+func TestSessionSelector_SelectSessionToAttachTo_Discovery_SkipsUnresponsiveSessions(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -134,19 +171,202 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_MultipleSessionsUsesF
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
-	firstConnectionDetails := embeddedconnector.ConnectionDetails{
-		Host:           "localhost",
-		Port:           "31515",
-		APIKey:         "first-api-key",
-		CertificatePEM: []byte("first-cert"),
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockUnresponsiveClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockUnresponsiveClient.AssertExpectations(t)
+
+	mockResponsiveClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockResponsiveClient.AssertExpectations(t)
+
+	brokenConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31514"}
+	unresponsiveConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31515"}
+	expectedConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31516"}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionSelector().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Twice()
+
+	brokenSession := sessiondiscovery.Session{ConnectionDetails: brokenConnectionDetails, PID: 1}
+	unresponsiveSession := sessiondiscovery.Session{ConnectionDetails: unresponsiveConnectionDetails, PID: 2}
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{
+			brokenSession,
+			unresponsiveSession,
+			{ConnectionDetails: expectedConnectionDetails, PID: 3},
+		}).
+		Once()
+
+	mockSessionDiscoverer.EXPECT().
+		RemoveSession(mock.Anything, brokenSession).
+		Return().
+		Once()
+
+	mockSessionDiscoverer.EXPECT().
+		RemoveSession(mock.Anything, unresponsiveSession).
+		Return().
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(brokenConnectionDetails).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(unresponsiveConnectionDetails).
+		Return(mockUnresponsiveClient, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(expectedConnectionDetails).
+		Return(mockResponsiveClient, nil).
+		Once()
+
+	mockUnresponsiveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockResponsiveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedConnectionDetails, connectionDetails)
+}
+
+func TestSessionSelector_SelectSessionToAttachTo_Discovery_SelectsBySelector(t *testing.T) {
+	firstConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31515"}
+	secondConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31516"}
+	discoveredSessions := []sessiondiscovery.Session{
+		{ConnectionDetails: firstConnectionDetails, PID: 1234, Name: "analysis"},
+		{ConnectionDetails: secondConnectionDetails, PID: 5678, Name: "simulation"},
 	}
-	secondConnectionDetails := embeddedconnector.ConnectionDetails{
-		Host:           "localhost",
-		Port:           "31516",
-		APIKey:         "second-api-key",
-		CertificatePEM: []byte("second-cert"),
+
+	testCases := []struct {
+		name                      string
+		selector                  string
+		expectedConnectionDetails embeddedconnector.ConnectionDetails
+	}{
+		{name: "latest", selector: "latest", expectedConnectionDetails: firstConnectionDetails},
+		{name: "by PID", selector: "5678", expectedConnectionDetails: secondConnectionDetails},
+		{name: "by name", selector: "simulation", expectedConnectionDetails: secondConnectionDetails},
 	}
-	expectedConnectionDetails := firstConnectionDetails
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+			defer mockSessionDiscoverer.AssertExpectations(t)
+
+			mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+			defer mockClientFactory.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionConnectionDetails().
+				Return("").
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionSelector().
+				Return(tc.selector).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionConnectionTimeout().
+				Return(time.Second).
+				Once()
+
+			mockSessionDiscoverer.EXPECT().
+				DiscoverSessions(mockLogger.AsMockArg()).
+				Return(discoveredSessions).
+				Once()
+
+			mockClientFactory.EXPECT().
+				New(tc.expectedConnectionDetails).
+				Return(mockClient, nil).
+				Once()
+
+			mockClient.EXPECT().
+				Ping(mock.Anything, mock.Anything).
+				Return(entities.PingResponse{IsAlive: true}).
+				Once()
+
+			attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+			// Act
+			connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConnectionDetails, connectionDetails)
+		})
+	}
+}
+
+func TestSessionSelector_SelectSessionToAttachTo_WithProcessID_OverridesConfiguredSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	firstConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31515"}
+	expectedConnectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31516"}
 
 	mockConfigFactory.EXPECT().
 		Config().
@@ -155,24 +375,165 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_MultipleSessionsUsesF
 
 	mockConfig.EXPECT().
 		MATLABSessionConnectionDetails().
-		Return("").
+		Return(`{"port":31515,"certificate":"/path/to/cert.pem","apiKey":"test-api-key"}`).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
 		Once()
 
 	mockSessionDiscoverer.EXPECT().
 		DiscoverSessions(mockLogger.AsMockArg()).
-		Return([]embeddedconnector.ConnectionDetails{firstConnectionDetails, secondConnectionDetails}).
+		Return([]sessiondiscovery.Session{
+			{ConnectionDetails: firstConnectionDetails, PID: 1234},
+			{ConnectionDetails: expectedConnectionDetails, PID: 5678},
+		}).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	mockClientFactory.EXPECT().
+		New(expectedConnectionDetails).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{ProcessID: 5678})
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedConnectionDetails, connectionDetails)
 }
 
+func TestSessionSelector_SelectSessionToAttachTo_Discovery_NoMatchingSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionSelector().
+		Return("missing").
+		Once()
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{
+			{PID: 1234, Name: "analysis"},
+			{PID: 5678},
+		}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
+
+	// Assert
+	require.ErrorIs(t, err, sessionselector.ErrNoMatchingMATLABSession)
+	assert.ErrorContains(t, err, `pid 1234 ("analysis"), pid 5678`)
+	assert.Empty(t, connectionDetails)
+}
+
+func TestSessionSelector_SelectSessionToAttachTo_Discovery_NoRespondingSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31515"}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionSelector().
+		Return(entities.MATLABSessionSelectorLatest).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Once()
+
+	unresponsiveSession := sessiondiscovery.Session{ConnectionDetails: connectionDetails, PID: 1234}
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{unresponsiveSession}).
+		Once()
+
+	mockSessionDiscoverer.EXPECT().
+		RemoveSession(mock.Anything, unresponsiveSession).
+		Return().
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	selectedConnectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
+
+	// Assert
+	require.ErrorIs(t, err, sessionselector.ErrNoRespondingMATLABSession)
+	assert.Empty(t, selectedConnectionDetails)
+}
+
 func TestSessionSelector_SelectSessionToAttachTo_ConfigFactoryError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -183,15 +544,18 @@ func TestSessionSelector_SelectSessionToAttachTo_ConfigFactoryError(t *testing.T
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
 	mockConfigFactory.EXPECT().
 		Config().
 		Return(nil, messages.AnError).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
 
 	// Assert
 	require.ErrorIs(t, err, messages.AnError)
@@ -211,6 +575,9 @@ func TestSessionSelector_SelectSessionToAttachTo_WithSessionDetails_FromSessionD
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
 	sessionDetailsJSON := `invalid json`
 
 	mockConfigFactory.EXPECT().
@@ -228,10 +595,10 @@ func TestSessionSelector_SelectSessionToAttachTo_WithSessionDetails_FromSessionD
 		Return(embeddedconnector.ConnectionDetails{}, assert.AnError).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
 
 	// Assert
 	require.ErrorIs(t, err, assert.AnError)
@@ -251,6 +618,9 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_NoSessionsDiscovered(
 	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
 	defer mockSessionDiscoverer.AssertExpectations(t)
 
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
 	mockConfigFactory.EXPECT().
 		Config().
 		Return(mockConfig, nil).
@@ -266,12 +636,180 @@ func TestSessionSelector_SelectSessionToAttachTo_Discovery_NoSessionsDiscovered(
 		Return(nil).
 		Once()
 
-	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer)
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
 
 	// Act
-	connectionDetails, err := attacher.SelectSessionToAttachTo(mockLogger)
+	connectionDetails, err := attacher.SelectSessionToAttachTo(t.Context(), mockLogger, entities.AttachToExistingSession{})
 
 	// Assert
 	require.ErrorIs(t, err, sessionselector.ErrNoMATLABSessionDiscovered)
 	assert.Empty(t, connectionDetails)
 }
+
+func TestSessionSelector_DiscoverSharedSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockResponsiveClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockResponsiveClient.AssertExpectations(t)
+
+	mockUnresponsiveClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockUnresponsiveClient.AssertExpectations(t)
+
+	responsiveSession := sessiondiscovery.Session{
+		ConnectionDetails: embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31515"},
+		PID:               1234,
+		Name:              "analysis",
+		Release:           "R2026a",
+	}
+	unresponsiveSession := sessiondiscovery.Session{
+		ConnectionDetails: embeddedconnector.ConnectionDetails{Host: "localhost", Port: "31516"},
+		PID:               5678,
+		Release:           "R2025b",
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(time.Second).
+		Twice()
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{responsiveSession, unresponsiveSession}).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(responsiveSession.ConnectionDetails).
+		Return(mockResponsiveClient, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(unresponsiveSession.ConnectionDetails).
+		Return(mockUnresponsiveClient, nil).
+		Once()
+
+	mockResponsiveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockUnresponsiveClient.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockSessionDiscoverer.EXPECT().
+		RemoveSession(mock.Anything, unresponsiveSession).
+		Return().
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	sessions := attacher.DiscoverSharedSessions(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, []sessiondiscovery.Session{responsiveSession}, sessions)
+}
+
+func TestSessionSelector_DiscoverSharedSessions_ConfigFactoryError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, messages.AnError).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	sessions := attacher.DiscoverSharedSessions(t.Context(), mockLogger)
+
+	// Assert
+	assert.Empty(t, sessions)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to get configuration to discover shared MATLAB sessions")
+}
+
+func TestSessionSelector_FindSharedSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	expectedSession := sessiondiscovery.Session{PID: 5678, Release: "R2025b"}
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{{PID: 1234, Release: "R2026a"}, expectedSession}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	session, found := attacher.FindSharedSession(mockLogger, 5678)
+
+	// Assert
+	require.True(t, found)
+	assert.Equal(t, expectedSession, session)
+}
+
+func TestSessionSelector_FindSharedSession_NotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockSessionDiscoverer := &mocks.MockSessionDiscoverer{}
+	defer mockSessionDiscoverer.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionDiscoverer.EXPECT().
+		DiscoverSessions(mockLogger.AsMockArg()).
+		Return([]sessiondiscovery.Session{{PID: 1234, Release: "R2026a"}}).
+		Once()
+
+	attacher := sessionselector.New(mockConfigFactory, mockSessionDiscoverer, mockClientFactory)
+
+	// Act
+	_, found := attacher.FindSharedSession(mockLogger, 5678)
+
+	// Assert
+	assert.False(t, found)
+}
@@ -6,8 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
@@ -17,12 +20,17 @@ var ErrMATLABSessionNotAlive = errors.New("session is not alive")
 func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	var zeroValue entities.SessionID
 	var client matlabsessionstore.MATLABSessionClientWithCleanup
+	var metadata matlabsessionstore.SessionMetadata
+
+	if err := m.sessionReaper.Start(); err != nil {
+		return zeroValue, err
+	}
 
 	switch request := startRequest.(type) {
 	case entities.LocalSessionDetails:
 		localSessionLogger := sessionLogger.With("matlab-root", request.MATLABRoot)
 		// For now, we return embedded connector details, to decouple the session start logic from the client creation.
-		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(
+		embeddedConnectorEndpoint, sessionCleanup, releaseSlot, err := m.startLocalMATLABSession(
 			ctx,
 			localSessionLogger,
 			datatypes.LocalSessionDetails{
@@ -30,11 +38,16 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 				IsStartingDirectorySet: request.IsStartingDirectorySet,
 				StartingDirectory:      request.StartingDirectory,
 				ShowMATLABDesktop:      request.ShowMATLABDesktop,
+				StartupScript:          request.StartupScript,
+				EnvironmentVariables:   request.EnvironmentVariables,
+				AdditionalFlags:        request.AdditionalFlags,
 			},
 		)
 		if err != nil {
 			return zeroValue, err
 		}
+		defer releaseSlot()
+
 		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
 		if err != nil {
 			if cleanupErr := sessionCleanup(); cleanupErr != nil {
@@ -43,10 +56,22 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 			return zeroValue, err
 		}
 		client = newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup)
+		metadata = matlabsessionstore.SessionMetadata{
+			MATLABRoot:       request.MATLABRoot,
+			Version:          m.matlabVersion(localSessionLogger, request.MATLABRoot),
+			ProcessID:        embeddedConnectorEndpoint.ProcessID,
+			SessionDirectory: embeddedConnectorEndpoint.SessionDirectory,
+		}
 	case entities.AttachToExistingSession:
 		sessionLogger.Info("Attaching to existing session")
 
-		connectionDetails, err := m.sessionSelector.SelectSessionToAttachTo(sessionLogger)
+		releaseSlot, err := m.sessionReaper.ReserveSlot(ctx, sessionLogger)
+		if err != nil {
+			return zeroValue, err
+		}
+		defer releaseSlot()
+
+		connectionDetails, err := m.sessionSelector.SelectSessionToAttachTo(ctx, sessionLogger, request)
 		if err != nil {
 			return zeroValue, err
 		}
@@ -62,9 +87,58 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 		}
 
 		client = newMATLABSessionClientWithoutCleanup(embeddedConnectorClient)
+		metadata = matlabsessionstore.SessionMetadata{
+			Version:   m.sharedSessionRelease(sessionLogger, connectionDetails.ProcessID),
+			ProcessID: connectionDetails.ProcessID,
+		}
 	default:
 		return zeroValue, fmt.Errorf("unknown request type: %T", request)
 	}
 
-	return m.sessionStore.Add(client), nil
+	metadata.StartedAt = time.Now()
+
+	return m.sessionStore.Add(client, metadata), nil
+}
+
+// startLocalMATLABSession hands out a pooled MATLAB session when one is ready, and starts MATLAB otherwise.
+// Either way, the session comes with a reserved slot, to release once the session is in the store.
+func (m *MATLABManager) startLocalMATLABSession(ctx context.Context, sessionLogger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, func(), error) {
+	if connectionDetails, sessionCleanup, releaseSlot, found := m.sessionPool.Take(ctx, sessionLogger, request); found {
+		return connectionDetails, sessionCleanup, releaseSlot, nil
+	}
+
+	releaseSlot, err := m.sessionReaper.ReserveSlot(ctx, sessionLogger)
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, nil, err
+	}
+
+	connectionDetails, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(ctx, sessionLogger, request)
+	if err != nil {
+		releaseSlot()
+		return embeddedconnector.ConnectionDetails{}, nil, nil, err
+	}
+
+	return connectionDetails, sessionCleanup, releaseSlot, nil
+}
+
+// matlabVersion returns the release of the discovered MATLAB installed in matlabRoot, or an empty string when it is not discovered.
+func (m *MATLABManager) matlabVersion(sessionLogger entities.Logger, matlabRoot string) string {
+	for _, matlabInfo := range m.matlabServices.ListDiscoveredMatlabInfo(sessionLogger).MatlabInfo {
+		if filepath.Clean(matlabInfo.Location) == filepath.Clean(matlabRoot) {
+			return matlabInfo.Version.ReleaseFamily
+		}
+	}
+	return ""
+}
+
+// sharedSessionRelease returns the release of the shared MATLAB session with the given process ID, or an empty string when it is not known.
+func (m *MATLABManager) sharedSessionRelease(sessionLogger entities.Logger, processID int) string {
+	if processID == 0 {
+		return ""
+	}
+	session, found := m.sessionSelector.FindSharedSession(sessionLogger, processID)
+	if !found {
+		return ""
+	}
+	return session.Release
 }
@@ -9,7 +9,11 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionreaper"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
@@ -34,6 +38,15 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -42,19 +55,45 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	expectedSessionID := entities.SessionID(123)
 
 	connectionDetails := embeddedconnector.ConnectionDetails{
-		Host: "localhost",
-		Port: "1234",
+		Host:      "localhost",
+		Port:      "1234",
+		ProcessID: 4321,
 	}
 
 	sessionCleanupFunc := func() error { return nil }
 
+	expectedStartingDirectory := filepath.Join("path", "to", "project")
+	expectedStartupScript := filepath.Join("path", "to", "project", "setup.m")
+	expectedEnvironmentVariables := map[string]string{"MY_VAR": "value"}
+	expectedAdditionalFlags := []string{"-singleCompThread"}
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
-		IsStartingDirectorySet: false,
+		IsStartingDirectorySet: true,
+		StartingDirectory:      expectedStartingDirectory,
+		ShowMATLABDesktop:      true,
+		StartupScript:          expectedStartupScript,
+		EnvironmentVariables:   expectedEnvironmentVariables,
+		AdditionalFlags:        expectedAdditionalFlags,
 	}
 
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	slotReleased := false
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() { slotReleased = true }, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
 		Return(connectionDetails, sessionCleanupFunc, nil).
@@ -65,16 +104,33 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 		Return(mockSessionClient, nil).
 		Once()
 
+	mockMATLABServices.EXPECT().
+		ListDiscoveredMatlabInfo(mockLogger.AsMockArg()).
+		Return(datatypes.ListMatlabInfo{MatlabInfo: []datatypes.MatlabInfo{
+			{Location: filepath.Join("path", "to", "matlab", "R2022b"), Version: datatypes.MatlabVersionInfo{ReleaseFamily: "R2022b"}},
+			{Location: expectedMATLABRoot, Version: datatypes.MatlabVersionInfo{ReleaseFamily: "R2023a"}},
+		}}).
+		Once()
+
+	var capturedMetadata matlabsessionstore.SessionMetadata
 	mockSessionStore.EXPECT().
-		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup"), mock.AnythingOfType("matlabsessionstore.SessionMetadata")).
+		Run(func(_ matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata) {
+			capturedMetadata = metadata
+		}).
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
-		IsStartingDirectorySet: false,
+		IsStartingDirectorySet: true,
+		StartingDirectory:      expectedStartingDirectory,
+		ShowMATLABDesktop:      true,
+		StartupScript:          expectedStartupScript,
+		EnvironmentVariables:   expectedEnvironmentVariables,
+		AdditionalFlags:        expectedAdditionalFlags,
 	}
 
 	// Act
@@ -83,6 +139,11 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedSessionID, sessionID)
+	assert.Equal(t, expectedMATLABRoot, capturedMetadata.MATLABRoot)
+	assert.Equal(t, "R2023a", capturedMetadata.Version)
+	assert.Equal(t, 4321, capturedMetadata.ProcessID)
+	assert.False(t, capturedMetadata.StartedAt.IsZero(), "Start time should be recorded")
+	assert.True(t, slotReleased, "Reserved slot should be released once the session is stored")
 }
 
 func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
@@ -101,6 +162,15 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -114,12 +184,28 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
+	slotReleased := false
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() { slotReleased = true }, nil).
+		Once()
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
 		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -132,6 +218,7 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, sessionID)
+	assert.True(t, slotReleased, "Reserved slot should be released when MATLAB fails to start")
 }
 
 func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
@@ -150,6 +237,15 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -169,6 +265,21 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() {}, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
 		Return(connectionDetails, sessionCleanupFunc, nil).
@@ -179,7 +290,7 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -214,6 +325,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockSessionClient.AssertExpectations(t)
 
@@ -223,11 +343,22 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 		Port:           "31515",
 		APIKey:         "test-api-key",
 		CertificatePEM: []byte("cert-content"),
+		ProcessID:      1234,
 	}
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() {}, nil).
+		Once()
+
 	mockSessionSelector.EXPECT().
-		SelectSessionToAttachTo(mockLogger.AsMockArg()).
+		SelectSessionToAttachTo(expectedCtx, mockLogger.AsMockArg(), entities.AttachToExistingSession{ProcessID: 1234}).
 		Return(expectedConnectionDetails, nil).
 		Once()
 
@@ -241,15 +372,25 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 		Return(entities.PingResponse{IsAlive: true}).
 		Once()
 
+	mockSessionSelector.EXPECT().
+		FindSharedSession(mockLogger.AsMockArg(), 1234).
+		Return(sessiondiscovery.Session{PID: 1234, Release: "R2026a"}, true).
+		Once()
+
 	mockSessionStore.EXPECT().
-		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithoutCleanup")).
+		Add(
+			mock.AnythingOfType("*matlabmanager.matlabSessionClientWithoutCleanup"),
+			mock.MatchedBy(func(metadata matlabsessionstore.SessionMetadata) bool {
+				return metadata.ProcessID == 1234 && metadata.Version == "R2026a" && metadata.MATLABRoot == "" && !metadata.StartedAt.IsZero()
+			}),
+		).
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
-	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{ProcessID: 1234})
 
 	// Assert
 	require.NoError(t, err)
@@ -275,14 +416,33 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_SessionSelecto
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() {}, nil).
+		Once()
+
 	mockSessionSelector.EXPECT().
-		SelectSessionToAttachTo(mockLogger.AsMockArg()).
+		SelectSessionToAttachTo(expectedCtx, mockLogger.AsMockArg(), entities.AttachToExistingSession{}).
 		Return(embeddedconnector.ConnectionDetails{}, assert.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -311,6 +471,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_ClientFactoryE
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	expectedConnectionDetails := embeddedconnector.ConnectionDetails{
 		Host:           "localhost",
 		Port:           "31515",
@@ -319,8 +488,18 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_ClientFactoryE
 	}
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() {}, nil).
+		Once()
+
 	mockSessionSelector.EXPECT().
-		SelectSessionToAttachTo(mockLogger.AsMockArg()).
+		SelectSessionToAttachTo(expectedCtx, mockLogger.AsMockArg(), entities.AttachToExistingSession{}).
 		Return(expectedConnectionDetails, nil).
 		Once()
 
@@ -329,7 +508,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_ClientFactoryE
 		Return(nil, assert.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -358,6 +537,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 	mockSessionSelector := &mocks.MockSessionSelector{}
 	defer mockSessionSelector.AssertExpectations(t)
 
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockSessionClient.AssertExpectations(t)
 
@@ -369,8 +557,18 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 	}
 	expectedCtx := t.Context()
 
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(func() {}, nil).
+		Once()
+
 	mockSessionSelector.EXPECT().
-		SelectSessionToAttachTo(mockLogger.AsMockArg()).
+		SelectSessionToAttachTo(expectedCtx, mockLogger.AsMockArg(), entities.AttachToExistingSession{}).
 		Return(expectedConnectionDetails, nil).
 		Once()
 
@@ -384,7 +582,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 		Return(entities.PingResponse{IsAlive: false}).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -393,3 +591,190 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 	require.ErrorIs(t, err, matlabmanager.ErrMATLABSessionNotAlive)
 	assert.Empty(t, sessionID)
 }
+
+func TestMATLABManager_StartMATLABSession_SessionReaperStartError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.LocalSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_MaxSessionsReached(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	expectedCtx := t.Context()
+	expectedError := sessionreaper.ErrMaxSessionsReached
+
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(expectedCtx, mockLogger.AsMockArg(), datatypes.LocalSessionDetails{}).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
+	mockSessionReaper.EXPECT().
+		ReserveSlot(expectedCtx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.LocalSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_UsesPooledSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionReaper := &mocks.MockSessionReaper{}
+	defer mockSessionReaper.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedSessionID := entities.SessionID(7)
+
+	pooledConnectionDetails := embeddedconnector.ConnectionDetails{
+		Host:      "localhost",
+		Port:      "5678",
+		ProcessID: 8765,
+	}
+
+	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
+		MATLABRoot: expectedMATLABRoot,
+	}
+
+	expectedCtx := t.Context()
+
+	mockSessionReaper.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	slotReleased := false
+	mockSessionPool.EXPECT().
+		Take(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(pooledConnectionDetails, func() error { return nil }, func() { slotReleased = true }, true).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(pooledConnectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		ListDiscoveredMatlabInfo(mockLogger.AsMockArg()).
+		Return(datatypes.ListMatlabInfo{}).
+		Once()
+
+	var capturedMetadata matlabsessionstore.SessionMetadata
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup"), mock.AnythingOfType("matlabsessionstore.SessionMetadata")).
+		Run(func(_ matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata) {
+			capturedMetadata = metadata
+		}).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockSessionReaper, mockSessionPool, mockSessionLogReader)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	assert.Equal(t, 8765, capturedMetadata.ProcessID)
+	assert.True(t, slotReleased, "Slot of the pooled session should be released once the session is stored")
+}
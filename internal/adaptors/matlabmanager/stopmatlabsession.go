@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package matlabmanager
 
@@ -14,7 +14,8 @@ func (m *MATLABManager) StopMATLABSession(ctx context.Context, sessionLogger ent
 		return err
 	}
 
-	defer m.sessionStore.Remove(sessionID)
+	// Remove the session before stopping it, so that its MATLAB exiting is not reported as a crash.
+	m.sessionStore.Remove(sessionID)
 
 	return client.StopSession(ctx, sessionLogger.With("session-id", sessionID))
 }
@@ -84,23 +84,34 @@ func (r *Resource) AddToServer(server resources.Server) error {
 }
 
 func (r *Resource) resourceHandler() mcp.ResourceHandler {
+	var handler ResourceTemplateHandler
+	if r.handler != nil {
+		handler = func(ctx context.Context, logger entities.Logger, _ string) (*ReadResourceResult, error) {
+			return r.handler(ctx, logger)
+		}
+	}
+
+	return newMCPResourceHandler(r.name, r.loggerFactory, handler)
+}
+
+func newMCPResourceHandler(name string, loggerFactory LoggerFactory, handler ResourceTemplateHandler) mcp.ResourceHandler {
 	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
-		logger, messagesErr := r.loggerFactory.NewMCPSessionLogger(req.Session)
+		logger, messagesErr := loggerFactory.NewMCPSessionLogger(req.Session)
 		if messagesErr != nil {
 			return nil, messagesErr
 		}
 
-		logger = logger.With("resource-name", r.name)
+		logger = logger.With("resource-name", name)
 		logger.Debug("Handling resource request")
 		defer logger.Debug("Handled resource request")
 
-		if r.handler == nil {
+		if handler == nil {
 			err := fmt.Errorf(UnexpectedErrorPrefix + "no resource handler available")
 			logger.WithError(err).Warn("Resource handler is nil")
 			return nil, err
 		}
 
-		result, err := r.handler(ctx, logger)
+		result, err := handler(ctx, logger, req.Params.URI)
 		if err != nil {
 			logger.WithError(err).Warn("Resource handler returned an error")
 			return nil, err
@@ -0,0 +1,84 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package baseresource
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ResourceTemplateHandler reads the resource with the given URI, which matches the URI template of the resource template.
+type ResourceTemplateHandler func(ctx context.Context, logger entities.Logger, uri string) (*ReadResourceResult, error)
+
+// ResourceTemplate is a family of resources, whose URIs match an RFC 6570 URI template.
+type ResourceTemplate struct {
+	name          string
+	title         string
+	description   string
+	mimeType      string
+	uriTemplate   string
+	loggerFactory LoggerFactory
+	handler       ResourceTemplateHandler
+}
+
+func NewTemplate(
+	name string,
+	title string,
+	description string,
+	mimeType string,
+	uriTemplate string,
+	loggerFactory LoggerFactory,
+	handler ResourceTemplateHandler,
+) *ResourceTemplate {
+	return &ResourceTemplate{
+		name:          name,
+		title:         title,
+		description:   description,
+		mimeType:      mimeType,
+		uriTemplate:   uriTemplate,
+		loggerFactory: loggerFactory,
+		handler:       handler,
+	}
+}
+
+func (r *ResourceTemplate) AddToServer(server resources.Server) error {
+	if err := validateMIMEType(r.mimeType); err != nil {
+		return err
+	}
+
+	server.AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        r.name,
+			Title:       r.title,
+			Description: r.description,
+			MIMEType:    r.mimeType,
+			URITemplate: r.uriTemplate,
+		},
+		newMCPResourceHandler(r.name, r.loggerFactory, r.handler),
+	)
+
+	return nil
+}
+
+func (r *ResourceTemplate) Name() string {
+	return r.name
+}
+
+func (r *ResourceTemplate) Title() string {
+	return r.title
+}
+
+func (r *ResourceTemplate) Description() string {
+	return r.description
+}
+
+func (r *ResourceTemplate) MimeType() string {
+	return r.mimeType
+}
+
+func (r *ResourceTemplate) URITemplate() string {
+	return r.uriTemplate
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package baseresource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplate_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_resource_template"
+		title       = "Test Resource Template"
+		description = "A test resource template"
+		mimeType    = "text/plain"
+		uriTemplate = "test://{id}/resource"
+	)
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	// Act
+	r := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, handler)
+
+	// Assert
+	assert.NotNil(t, r)
+	assert.Equal(t, name, r.Name())
+	assert.Equal(t, title, r.Title())
+	assert.Equal(t, description, r.Description())
+	assert.Equal(t, mimeType, r.MimeType())
+	assert.Equal(t, uriTemplate, r.URITemplate())
+}
+
+func TestResourceTemplate_AddToServer_InvalidMimeType(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	r := baseresource.NewTemplate("test_resource_template", "Test Resource Template", "A test resource template", "invalid-mime-type", "test://{id}/resource", mockLoggerFactory, nil)
+
+	// Act
+	err := r.AddToServer(mockServer)
+
+	// Assert
+	require.ErrorContains(t, err, "must be in format type/subtype")
+}
+
+func TestResourceTemplate_ResourceHandler_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_resource_template"
+		title       = "Test Resource Template"
+		description = "A test resource template"
+		mimeType    = "text/plain"
+		uriTemplate = "test://{id}/resource"
+		uri         = "test://1/resource"
+	)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger, nil).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, requestedURI string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{MIMEType: mimeType, Text: "content of " + requestedURI},
+			},
+		}, nil
+	}
+
+	r := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, handler)
+
+	var capturedHandler mcp.ResourceHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        name,
+			Title:       title,
+			Description: description,
+			MIMEType:    mimeType,
+			URITemplate: uriTemplate,
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Run(func(_ *mcp.ResourceTemplate, h mcp.ResourceHandler) {
+		capturedHandler = h
+	}).Return()
+
+	err := r.AddToServer(mockServer)
+	require.NoError(t, err)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "content of "+uri, result.Contents[0].Text)
+}
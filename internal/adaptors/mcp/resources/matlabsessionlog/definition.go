@@ -0,0 +1,14 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionlog
+
+const (
+	name        = "matlab_session_log"
+	title       = "MATLAB Session Log"
+	description = "The last lines of the output and errors of a MATLAB session started by this server, along with the startup error and the crash dumps written by MATLAB, if any."
+	mimeType    = "text/plain"
+	uriTemplate = "matlab-session://{id}/log"
+
+	uriScheme = "matlab-session"
+	uriPath   = "/log"
+)
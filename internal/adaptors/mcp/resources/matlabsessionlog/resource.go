@@ -0,0 +1,104 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionlog
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, args getmatlabsessionlog.Args) (getmatlabsessionlog.ReturnArgs, error)
+}
+
+type Resource struct {
+	*baseresource.ResourceTemplate
+}
+
+func New(
+	loggerFactory baseresource.LoggerFactory,
+	usecase Usecase,
+) *Resource {
+	return &Resource{
+		ResourceTemplate: baseresource.NewTemplate(
+			name,
+			title,
+			description,
+			mimeType,
+			uriTemplate,
+			loggerFactory,
+			Handler(usecase),
+		),
+	}
+}
+
+func Handler(usecase Usecase) baseresource.ResourceTemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		sessionID, ok := parseSessionID(uri)
+		if !ok {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+
+		logger.With("session_id", sessionID).Info("Returning MATLAB session log resource")
+
+		sessionLog, err := usecase.Execute(ctx, logger, getmatlabsessionlog.Args{SessionID: sessionID})
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     format(sessionLog),
+				},
+			},
+		}, nil
+	}
+}
+
+func parseSessionID(uri string) (entities.SessionID, bool) {
+	parsedURI, err := url.Parse(uri)
+	if err != nil || parsedURI.Scheme != uriScheme || parsedURI.Path != uriPath {
+		return 0, false
+	}
+
+	sessionID, err := strconv.Atoi(parsedURI.Host)
+	if err != nil {
+		return 0, false
+	}
+
+	return entities.SessionID(sessionID), true
+}
+
+func format(sessionLog getmatlabsessionlog.ReturnArgs) string {
+	var text strings.Builder
+
+	writeSection := func(heading string, content string) {
+		if text.Len() > 0 {
+			text.WriteString("\n\n")
+		}
+		text.WriteString("=== " + heading + " ===\n")
+		text.WriteString(content)
+	}
+
+	writeSection("stdout", sessionLog.Stdout)
+	writeSection("stderr", sessionLog.Stderr)
+
+	if sessionLog.StartupError != "" {
+		writeSection("startup error", sessionLog.StartupError)
+	}
+
+	for _, crashDump := range sessionLog.CrashDumps {
+		writeSection(crashDump.FileName, crashDump.Content)
+	}
+
+	return text.String()
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionlog_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+	mockUsecase := mocks.NewMockUsecase(t)
+
+	// Act
+	resource := matlabsessionlog.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	require.NotNil(t, resource)
+	assert.Equal(t, "matlab_session_log", resource.Name())
+	assert.Equal(t, "MATLAB Session Log", resource.Title())
+	assert.Equal(t, "text/plain", resource.MimeType())
+	assert.Equal(t, "matlab-session://{id}/log", resource.URITemplate())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	mockUsecase := mocks.NewMockUsecase(t)
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlog.Args{SessionID: entities.SessionID(4)}).
+		Return(getmatlabsessionlog.ReturnArgs{
+			Stdout:       "MATLAB is starting",
+			Stderr:       "Warning: low memory",
+			StartupError: "Failed to start connector",
+			CrashDumps: []entities.MATLABCrashDump{
+				{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+			},
+		}, nil).
+		Once()
+
+	handler := matlabsessionlog.Handler(mockUsecase)
+
+	// Act
+	result, err := handler(ctx, mockLogger, "matlab-session://4/log")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t,
+		"=== stdout ===\nMATLAB is starting\n\n"+
+			"=== stderr ===\nWarning: low memory\n\n"+
+			"=== startup error ===\nFailed to start connector\n\n"+
+			"=== matlab_crash_dump.1234-1 ===\nSegmentation violation",
+		result.Contents[0].Text,
+	)
+}
+
+func TestHandler_InvalidURI(t *testing.T) {
+	for _, uri := range []string{
+		"matlab-session://abc/log",
+		"matlab-session://4/other",
+		"other://4/log",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+			mockUsecase := mocks.NewMockUsecase(t)
+
+			handler := matlabsessionlog.Handler(mockUsecase)
+
+			// Act
+			result, err := handler(t.Context(), mockLogger, uri)
+
+			// Assert
+			require.ErrorContains(t, err, "not found")
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	mockUsecase := mocks.NewMockUsecase(t)
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlog.Args{SessionID: entities.SessionID(4)}).
+		Return(getmatlabsessionlog.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabsessionlog.Handler(mockUsecase)
+
+	// Act
+	result, err := handler(ctx, mockLogger, "matlab-session://4/log")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+)
+
+// The SDK rejects tokens without an expiration, but a static token never expires.
+// Report an expiration comfortably past the lifetime of a single request.
+const staticTokenValidity = time.Hour
+
+func newBearerTokenVerifier(expectedToken string) auth.TokenVerifier {
+	return func(_ context.Context, token string, _ *http.Request) (*auth.TokenInfo, error) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) != 1 {
+			return nil, auth.ErrInvalidToken
+		}
+
+		return &auth.TokenInfo{
+			Expiration: time.Now().Add(staticTokenValidity),
+		}, nil
+	}
+}
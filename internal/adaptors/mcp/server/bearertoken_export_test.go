@@ -0,0 +1,9 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import "github.com/modelcontextprotocol/go-sdk/auth"
+
+func NewBearerTokenVerifier(expectedToken string) auth.TokenVerifier {
+	return newBearerTokenVerifier(expectedToken)
+}
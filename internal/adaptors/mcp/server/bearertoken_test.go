@@ -0,0 +1,42 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBearerTokenVerifier_HappyPath(t *testing.T) {
+	// Arrange
+	expectedToken := "secret-token"
+	verifier := server.NewBearerTokenVerifier(expectedToken)
+	request := httptest.NewRequestWithContext(t.Context(), "POST", "/", nil)
+
+	// Act
+	tokenInfo, err := verifier(t.Context(), expectedToken, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, tokenInfo)
+	assert.True(t, tokenInfo.Expiration.After(time.Now()), "Token info should not be expired")
+}
+
+func TestBearerTokenVerifier_InvalidToken(t *testing.T) {
+	// Arrange
+	verifier := server.NewBearerTokenVerifier("secret-token")
+	request := httptest.NewRequestWithContext(t.Context(), "POST", "/", nil)
+
+	// Act
+	tokenInfo, err := verifier(t.Context(), "wrong-token", request)
+
+	// Assert
+	require.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.Nil(t, tokenInfo)
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package clientnotifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const loggerName = "matlab-mcp-core-server"
+
+// ClientNotifier sends notifications to every MCP client connected to the server,
+// for events that do not belong to a tool call, such as a MATLAB session stopping unexpectedly.
+type ClientNotifier struct {
+	mu     sync.RWMutex
+	server *mcp.Server
+}
+
+func New() *ClientNotifier {
+	return &ClientNotifier{}
+}
+
+// AttachServer sets the MCP server whose clients are notified.
+func (n *ClientNotifier) AttachServer(server *mcp.Server) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.server = server
+}
+
+// NotifyClients sends data as a notifications/message log entry to every connected client.
+// Clients only receive it when they have asked for log entries at the given level.
+func (n *ClientNotifier) NotifyClients(ctx context.Context, logger entities.Logger, level entities.LogLevel, data any) {
+	n.mu.RLock()
+	server := n.server
+	n.mu.RUnlock()
+
+	if server == nil {
+		return
+	}
+
+	params := &mcp.LoggingMessageParams{
+		Level:  toLoggingLevel(level),
+		Logger: loggerName,
+		Data:   data,
+	}
+
+	for session := range server.Sessions() {
+		if err := session.Log(ctx, params); err != nil {
+			logger.WithError(err).Warn("Failed to send notification to MCP client")
+		}
+	}
+}
+
+func toLoggingLevel(level entities.LogLevel) mcp.LoggingLevel {
+	switch level {
+	case entities.LogLevelDebug:
+		return "debug"
+	case entities.LogLevelWarn:
+		return "warning"
+	case entities.LogLevelError:
+		return "error"
+	default:
+		return "info"
+	}
+}
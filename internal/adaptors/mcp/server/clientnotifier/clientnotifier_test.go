@@ -0,0 +1,81 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package clientnotifier_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/clientnotifier"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientNotifier_New_HappyPath(t *testing.T) {
+	// Act
+	notifier := clientnotifier.New()
+
+	// Assert
+	assert.NotNil(t, notifier)
+}
+
+func TestClientNotifier_NotifyClients_NoServer(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	notifier := clientnotifier.New()
+
+	// Act
+	notifier.NotifyClients(t.Context(), mockLogger, entities.LogLevelWarn, "message")
+
+	// Assert
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestClientNotifier_NotifyClients_SendsLogEntryToConnectedClients(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	receivedC := make(chan *mcp.LoggingMessageParams, 1)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+			receivedC <- req.Params
+		},
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	require.NoError(t, clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "info"}))
+
+	notifier := clientnotifier.New()
+	notifier.AttachServer(server)
+
+	// Act
+	notifier.NotifyClients(ctx, mockLogger, entities.LogLevelWarn, "MATLAB session stopped")
+
+	// Assert
+	select {
+	case params := <-receivedC:
+		assert.Equal(t, mcp.LoggingLevel("warning"), params.Level)
+		assert.Equal(t, "matlab-mcp-core-server", params.Logger)
+		assert.Equal(t, "MATLAB session stopped", params.Data)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "client did not receive the notification")
+	}
+
+	assert.Empty(t, mockLogger.WarnLogs())
+}
@@ -9,17 +9,30 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	checkmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/checkmatlabcode"
+	detectmatlabtoolboxesmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/detectmatlabtoolboxes"
 	evalmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
+	fixmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/fixmatlabcode"
+	getmatlabsessionlogmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/getmatlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	runmatlabfilemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabfile"
+	runmatlabtestfilemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
+	checkmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	detectmatlabtoolboxessinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	fixmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+	getmatlabsessionlogsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
+	runmatlabfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	runmatlabtestfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 )
 
@@ -33,6 +46,11 @@ type ApplicationDefinition interface {
 
 type CustomToolFactory interface {
 	LoadTools(filePath string) ([]tools.Tool, messages.Error)
+	LoadMultiSessionTools(filePath string) ([]tools.Tool, messages.Error)
+}
+
+type ExtensionFileFinder interface {
+	Find() ([]string, messages.Error)
 }
 
 type Configurator struct {
@@ -42,13 +60,17 @@ type Configurator struct {
 	// Built-in tools
 	multiSessionTools  []tools.Tool
 	singleSessionTools []tools.Tool
+	// existingSessionTools are only added when attaching to MATLAB sessions shared by the user.
+	existingSessionTools []tools.Tool
 
 	// Resources
 	codingGuidelinesResource            resources.Resource
 	plaintextlivecodegenerationResource resources.Resource
+	matlabSessionLogResource            resources.Resource
 
 	// Custom tool dependencies
-	customToolFactory CustomToolFactory
+	customToolFactory   CustomToolFactory
+	extensionFileFinder ExtensionFileFinder
 }
 
 func New(
@@ -59,18 +81,32 @@ func New(
 	listAvailableMATLABsTool *listavailablematlabs.Tool,
 	startMATLABSessionTool *startmatlabsession.Tool,
 	stopMATLABSessionTool *stopmatlabsession.Tool,
+	listMATLABSessionsTool *listmatlabsessions.Tool,
+	getMATLABSessionLogTool *getmatlabsessionlogmultisession.Tool,
 	evalInMATLABSessionTool *evalmatlabcodemultisession.Tool,
+	checkMATLABCodeInMATLABSessionTool *checkmatlabcodemultisession.Tool,
+	fixMATLABCodeInMATLABSessionTool *fixmatlabcodemultisession.Tool,
+	detectMATLABToolboxesInMATLABSessionTool *detectmatlabtoolboxesmultisession.Tool,
+	runMATLABFileInMATLABSessionTool *runmatlabfilemultisession.Tool,
+	runMATLABTestFileInMATLABSessionTool *runmatlabtestfilemultisession.Tool,
 
 	evalInGlobalMATLABSessionTool *evalmatlabcodesinglesession.Tool,
-	checkMATLABCodeInGlobalMATLABSession *checkmatlabcode.Tool,
-	detectMATLABToolboxesInGlobalMATLABSessionTool *detectmatlabtoolboxes.Tool,
-	runMATLABFileInGlobalMATLABSessionTool *runmatlabfile.Tool,
-	runMATLABTestFileInGlobalMATLABSessionTool *runmatlabtestfile.Tool,
+	checkMATLABCodeInGlobalMATLABSession *checkmatlabcodesinglesession.Tool,
+	fixMATLABCodeInGlobalMATLABSessionTool *fixmatlabcodesinglesession.Tool,
+	detectMATLABToolboxesInGlobalMATLABSessionTool *detectmatlabtoolboxessinglesession.Tool,
+	runMATLABFileInGlobalMATLABSessionTool *runmatlabfilesinglesession.Tool,
+	runMATLABTestFileInGlobalMATLABSessionTool *runmatlabtestfilesinglesession.Tool,
+	getGlobalMATLABSessionLogTool *getmatlabsessionlogsinglesession.Tool,
+
+	listSharedMATLABSessionsTool *listsharedmatlabsessions.Tool,
+	attachToSharedMATLABSessionTool *attachsharedmatlabsession.Tool,
 
 	codingGuidelinesResource *codingguidelines.Resource,
 	plaintextlivecodegenerationResource *plaintextlivecodegeneration.Resource,
+	matlabSessionLogResource *matlabsessionlog.Resource,
 
 	customToolFactory CustomToolFactory,
+	extensionFileFinder ExtensionFileFinder,
 ) *Configurator {
 	return &Configurator{
 		configFactory: configFactory,
@@ -81,24 +117,41 @@ func New(
 			listAvailableMATLABsTool,
 			startMATLABSessionTool,
 			stopMATLABSessionTool,
+			listMATLABSessionsTool,
+			getMATLABSessionLogTool,
 			evalInMATLABSessionTool,
+			checkMATLABCodeInMATLABSessionTool,
+			fixMATLABCodeInMATLABSessionTool,
+			detectMATLABToolboxesInMATLABSessionTool,
+			runMATLABFileInMATLABSessionTool,
+			runMATLABTestFileInMATLABSessionTool,
 		},
 
 		singleSessionTools: []tools.Tool{
 			evalInGlobalMATLABSessionTool,
 			checkMATLABCodeInGlobalMATLABSession,
+			fixMATLABCodeInGlobalMATLABSessionTool,
 			detectMATLABToolboxesInGlobalMATLABSessionTool,
 			runMATLABFileInGlobalMATLABSessionTool,
 			runMATLABTestFileInGlobalMATLABSessionTool,
+			getGlobalMATLABSessionLogTool,
+		},
+
+		existingSessionTools: []tools.Tool{
+			listSharedMATLABSessionsTool,
+			attachToSharedMATLABSessionTool,
 		},
 
 		codingGuidelinesResource:            codingGuidelinesResource,
 		plaintextlivecodegenerationResource: plaintextlivecodegenerationResource,
+		matlabSessionLogResource:            matlabSessionLogResource,
 
-		customToolFactory: customToolFactory,
+		customToolFactory:   customToolFactory,
+		extensionFileFinder: extensionFileFinder,
 	}
 }
 
+// GetToolsToAdd returns the built-in tools for the configured MATLAB session mode.
 func (c *Configurator) GetToolsToAdd() ([]tools.Tool, error) {
 	if !c.featuresProvider.Features().MATLAB.Enabled {
 		return []tools.Tool{}, nil
@@ -110,42 +163,77 @@ func (c *Configurator) GetToolsToAdd() ([]tools.Tool, error) {
 	}
 
 	if cfg.UseSingleMATLABSession() {
-		customTools, err := c.loadCustomTools(cfg)
-		if err != nil {
-			return nil, err
+		singleSessionTools := slices.Clone(c.singleSessionTools)
+		if cfg.MATLABSessionMode() == entities.MATLABSessionModeExisting {
+			singleSessionTools = append(singleSessionTools, c.existingSessionTools...)
 		}
-
-		return slices.Concat(c.singleSessionTools, customTools), nil
+		return singleSessionTools, nil
 	}
 
 	return slices.Clone(c.multiSessionTools), nil
 }
 
-func (c *Configurator) loadCustomTools(cfg config.Config) ([]tools.Tool, error) {
-	extensionFilePath := cfg.ExtensionFile()
-	if extensionFilePath == "" {
-		return nil, nil
+// GetCustomToolsToAdd loads the custom tools from the configured extension files.
+// It loads the files again on each call, so it is also used to reload the custom tools when the files change.
+func (c *Configurator) GetCustomToolsToAdd() ([]tools.Tool, error) {
+	if !c.featuresProvider.Features().MATLAB.Enabled {
+		return []tools.Tool{}, nil
+	}
+
+	cfg, err := c.configFactory.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.UseSingleMATLABSession() {
+		return c.loadCustomTools(c.customToolFactory.LoadTools, slices.Concat(c.singleSessionTools, c.existingSessionTools))
 	}
 
-	customTools, err := c.customToolFactory.LoadTools(extensionFilePath)
+	return c.loadCustomTools(c.customToolFactory.LoadMultiSessionTools, c.multiSessionTools)
+}
+
+func (c *Configurator) loadCustomTools(load func(filePath string) ([]tools.Tool, messages.Error), builtInTools []tools.Tool) ([]tools.Tool, messages.Error) {
+	extensionFilePaths, err := c.extensionFileFinder.Find()
 	if err != nil {
 		return nil, err
 	}
 
-	for _, t := range customTools {
-		if c.isBuiltInSingleSessionToolName(t.Name()) {
-			return nil, messages.New_StartupErrors_CustomToolNameConflict_Error(
-				t.Name(),
-				extensionFilePath,
-			)
+	var customTools []tools.Tool
+	// The extension file of each custom tool, to report both files when two of them define the same tool name.
+	toolExtensionFilePaths := make(map[string]string)
+
+	for _, extensionFilePath := range extensionFilePaths {
+		extensionFileTools, err := load(extensionFilePath)
+		if err != nil {
+			return nil, err
 		}
+
+		for _, t := range extensionFileTools {
+			if isToolName(t.Name(), builtInTools) {
+				return nil, messages.New_StartupErrors_CustomToolNameConflict_Error(
+					t.Name(),
+					extensionFilePath,
+				)
+			}
+
+			if otherExtensionFilePath, found := toolExtensionFilePaths[t.Name()]; found {
+				return nil, messages.New_StartupErrors_DuplicateToolName_Error(
+					t.Name(),
+					extensionFilePath,
+					otherExtensionFilePath,
+				)
+			}
+			toolExtensionFilePaths[t.Name()] = extensionFilePath
+		}
+
+		customTools = append(customTools, extensionFileTools...)
 	}
 
 	return customTools, nil
 }
 
-func (c *Configurator) isBuiltInSingleSessionToolName(name string) bool {
-	for _, t := range c.singleSessionTools {
+func isToolName(name string, toolsToCheck []tools.Tool) bool {
+	for _, t := range toolsToCheck {
 		if t.Name() == name {
 			return true
 		}
@@ -161,5 +249,6 @@ func (c *Configurator) GetResourcesToAdd() []resources.Resource {
 	return []resources.Resource{
 		c.codingGuidelinesResource,
 		c.plaintextlivecodegenerationResource,
+		c.matlabSessionLogResource,
 	}
 }
@@ -9,18 +9,31 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	checkmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/checkmatlabcode"
+	detectmatlabtoolboxesmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/detectmatlabtoolboxes"
 	evalmatlabmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
+	fixmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/fixmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/getmatlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	runmatlabfilemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabfile"
+	runmatlabtestfilemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+	getmatlabsessionlogsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/configurator"
@@ -40,17 +53,32 @@ func TestNew_HappyPath(t *testing.T) {
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	// Act
 	result := configurator.New(
@@ -59,15 +87,28 @@ func TestNew_HappyPath(t *testing.T) {
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Assert
@@ -88,17 +129,32 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -121,15 +177,28 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
@@ -141,7 +210,14 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 	}, "GetToolsToAdd should return all the injected tools for multi session")
 }
 
@@ -156,17 +232,32 @@ func TestConfigurator_GetToolsToAdd_ConfigError(t *testing.T) {
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	expectedError := messages.AnError
 
@@ -186,15 +277,28 @@ func TestConfigurator_GetToolsToAdd_ConfigError(t *testing.T) {
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
@@ -219,17 +323,32 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -247,8 +366,8 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		Once()
 
 	mockConfig.EXPECT().
-		ExtensionFile().
-		Return("").
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
 		Once()
 
 	c := configurator.New(
@@ -257,15 +376,28 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
@@ -276,13 +408,124 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
 	}, "GetToolsToAdd should return all injected tools for single session")
 }
 
-func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPath(t *testing.T) {
+func TestConfigurator_GetToolsToAdd_SingleMATLABSession_ExistingSessionMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetToolsToAdd()
+
+	// Assert
+	require.NoError(t, err, "GetToolsToAdd should not return an error")
+	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+	}, "GetToolsToAdd should add the shared session tools in existing session mode")
+}
+
+func TestConfigurator_GetCustomToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -296,20 +539,35 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPat
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	mockCustomTool := &toolsmocks.MockTool{}
 	defer mockCustomTool.AssertExpectations(t)
 
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
 
@@ -332,9 +590,9 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPat
 		Return(true).
 		Once()
 
-	mockConfig.EXPECT().
-		ExtensionFile().
-		Return(expectedExtensionFilePath).
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath}, nil).
 		Once()
 
 	mockCustomToolFactory.EXPECT().
@@ -348,26 +606,39 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPat
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
-	toolsToAdd, err := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetCustomToolsToAdd()
 
 	// Assert
-	require.NoError(t, err, "GetToolsToAdd should not return an error")
-	assert.Contains(t, toolsToAdd, mockCustomTool, "GetToolsToAdd should include the custom tool")
+	require.NoError(t, err, "GetCustomToolsToAdd should not return an error")
+	assert.Equal(t, []tools.Tool{mockCustomTool}, toolsToAdd, "GetCustomToolsToAdd should return the custom tool")
 }
 
-func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t *testing.T) {
+func TestConfigurator_GetCustomToolsToAdd_MultipleExtensionFiles_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -381,27 +652,49 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	mockCustomTool := &toolsmocks.MockTool{}
 	defer mockCustomTool.AssertExpectations(t)
 
+	mockOtherCustomTool := &toolsmocks.MockTool{}
+	defer mockOtherCustomTool.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
-	evalInGlobalMATLABSessionTool := evalmatlabsinglesession.New(nil, nil, nil, nil)
-	checkMATLABCodeInGlobalMATLABSession := checkmatlabcode.New(nil, nil, nil)
-	detectMATLABToolboxesInSingleSessionTool := detectmatlabtoolboxes.New(nil, nil, nil)
-	runMATLABFileInGlobalMATLABSessionTool := runmatlabfile.New(nil, nil, nil, nil)
-	runMATLABTestFileInGlobalMATLABSessionTool := runmatlabtestfile.New(nil, nil, nil)
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
-	expectedConflictingToolName := "evaluate_matlab_code"
+	expectedOtherExtensionFilePath := filepath.Join("config", "signal", "tools.json")
 
 	mockCustomTool.EXPECT().
 		Name().
-		Return(expectedConflictingToolName)
+		Return("generate_magic_square")
+
+	mockOtherCustomTool.EXPECT().
+		Name().
+		Return("signal_generate_magic_square")
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -418,9 +711,9 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t
 		Return(true).
 		Once()
 
-	mockConfig.EXPECT().
-		ExtensionFile().
-		Return(expectedExtensionFilePath).
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath, expectedOtherExtensionFilePath}, nil).
 		Once()
 
 	mockCustomToolFactory.EXPECT().
@@ -428,36 +721,50 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t
 		Return([]tools.Tool{mockCustomTool}, nil).
 		Once()
 
+	mockCustomToolFactory.EXPECT().
+		LoadTools(expectedOtherExtensionFilePath).
+		Return([]tools.Tool{mockOtherCustomTool}, nil).
+		Once()
+
 	c := configurator.New(
 		mockConfigFactory,
 		mockApplicationDefinition,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
-	toolsToAdd, err := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetCustomToolsToAdd()
 
 	// Assert
-	require.Error(t, err, "GetToolsToAdd should return an error for conflicting tool name")
-	assert.Nil(t, toolsToAdd, "Tools should be nil when name conflict occurs")
-	var nameConflictError *messages.StartupErrors_CustomToolNameConflict_Error
-	require.ErrorAs(t, err, &nameConflictError)
-	assert.Equal(t, expectedConflictingToolName, nameConflictError.Attr0)
-	assert.Equal(t, expectedExtensionFilePath, nameConflictError.Attr1)
+	require.NoError(t, err, "GetCustomToolsToAdd should not return an error")
+	assert.Equal(t, []tools.Tool{mockCustomTool, mockOtherCustomTool}, toolsToAdd, "GetCustomToolsToAdd should return the custom tools of all extension files")
 }
 
-func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T) {
+func TestConfigurator_GetCustomToolsToAdd_DuplicateToolNameAcrossExtensionFiles(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -471,20 +778,49 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockCustomTool := &toolsmocks.MockTool{}
+	defer mockCustomTool.AssertExpectations(t)
+
+	mockOtherCustomTool := &toolsmocks.MockTool{}
+	defer mockOtherCustomTool.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
-	expectedError := messages.AnError
+	expectedOtherExtensionFilePath := filepath.Join("config", "other", "tools.json")
+
+	mockCustomTool.EXPECT().
+		Name().
+		Return("generate_magic_square")
+
+	mockOtherCustomTool.EXPECT().
+		Name().
+		Return("generate_magic_square")
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -501,14 +837,19 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T
 		Return(true).
 		Once()
 
-	mockConfig.EXPECT().
-		ExtensionFile().
-		Return(expectedExtensionFilePath).
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath, expectedOtherExtensionFilePath}, nil).
 		Once()
 
 	mockCustomToolFactory.EXPECT().
 		LoadTools(expectedExtensionFilePath).
-		Return(nil, expectedError).
+		Return([]tools.Tool{mockCustomTool}, nil).
+		Once()
+
+	mockCustomToolFactory.EXPECT().
+		LoadTools(expectedOtherExtensionFilePath).
+		Return([]tools.Tool{mockOtherCustomTool}, nil).
 		Once()
 
 	c := configurator.New(
@@ -517,26 +858,41 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
-	toolsToAdd, err := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetCustomToolsToAdd()
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "GetToolsToAdd should return the error from the loader")
-	assert.Nil(t, toolsToAdd, "Tools should be nil when loader error occurs")
+	expectedError := messages.New_StartupErrors_DuplicateToolName_Error("generate_magic_square", expectedOtherExtensionFilePath, expectedExtensionFilePath)
+
+	require.Equal(t, expectedError, err, "GetCustomToolsToAdd should name both extension files")
+	assert.Nil(t, toolsToAdd)
 }
 
-func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
+func TestConfigurator_GetCustomToolsToAdd_ExtensionFileFinderError(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -544,51 +900,100 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
 	defer mockApplicationDefinition.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	expectedError := messages.New_StartupErrors_FailedToReadExtensionDir_Error(filepath.Join("config", "tools"))
 
 	mockApplicationDefinition.EXPECT().
 		Features().
 		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
 		Once()
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return(nil, expectedError).
+		Once()
+
 	c := configurator.New(
 		mockConfigFactory,
 		mockApplicationDefinition,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
-	result := c.GetResourcesToAdd()
+	toolsToAdd, err := c.GetCustomToolsToAdd()
 
 	// Assert
-	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource}, result)
+	require.Equal(t, expectedError, err, "GetCustomToolsToAdd should return the error from the extension file finder")
+	assert.Nil(t, toolsToAdd)
 }
 
-func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
+func TestConfigurator_GetCustomToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -596,24 +1001,72 @@ func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
 	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
 	defer mockApplicationDefinition.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockCustomTool := &toolsmocks.MockTool{}
+	defer mockCustomTool.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
-	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
-	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
-	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
-	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
-	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := evalmatlabsinglesession.New(nil, nil, nil, nil)
+	checkMATLABCodeInGlobalMATLABSession := checkmatlabcode.New(nil, nil, nil)
+	fixMATLABCodeInGlobalMATLABSessionTool := fixmatlabcode.New(nil, nil, nil)
+	detectMATLABToolboxesInSingleSessionTool := detectmatlabtoolboxes.New(nil, nil, nil)
+	runMATLABFileInGlobalMATLABSessionTool := runmatlabfile.New(nil, nil, nil, nil)
+	runMATLABTestFileInGlobalMATLABSessionTool := runmatlabtestfile.New(nil, nil, nil, nil)
+	getMATLABSessionLogInGlobalMATLABSessionTool := getmatlabsessionlogsinglesession.New(nil, nil, nil)
+	listSharedMATLABSessionsTool := listsharedmatlabsessions.New(nil, nil)
+	attachToSharedMATLABSessionTool := attachsharedmatlabsession.New(nil, nil)
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	expectedExtensionFilePath := filepath.Join("config", "tools.json")
+	expectedConflictingToolName := "evaluate_matlab_code"
+
+	mockCustomTool.EXPECT().
+		Name().
+		Return(expectedConflictingToolName)
 
 	mockApplicationDefinition.EXPECT().
 		Features().
-		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: false}}).
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath}, nil).
+		Once()
+
+	mockCustomToolFactory.EXPECT().
+		LoadTools(expectedExtensionFilePath).
+		Return([]tools.Tool{mockCustomTool}, nil).
 		Once()
 
 	c := configurator.New(
@@ -622,26 +1075,43 @@ func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
-	toolsToAdd, err := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetCustomToolsToAdd()
 
 	// Assert
-	require.NoError(t, err)
-	assert.Empty(t, toolsToAdd)
+	require.Error(t, err, "GetCustomToolsToAdd should return an error for conflicting tool name")
+	assert.Nil(t, toolsToAdd, "Tools should be nil when name conflict occurs")
+	var nameConflictError *messages.StartupErrors_CustomToolNameConflict_Error
+	require.ErrorAs(t, err, &nameConflictError)
+	assert.Equal(t, expectedConflictingToolName, nameConflictError.Attr0)
+	assert.Equal(t, expectedExtensionFilePath, nameConflictError.Attr1)
 }
 
-func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
+func TestConfigurator_GetCustomToolsToAdd_MultipleMATLABSession_WithCustomTools_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -649,20 +1119,633 @@ func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
 	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
 	defer mockApplicationDefinition.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockCustomTool := &toolsmocks.MockTool{}
+	defer mockCustomTool.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	expectedExtensionFilePath := filepath.Join("config", "tools.json")
+
+	mockCustomTool.EXPECT().
+		Name().
+		Return("generate_magic_square")
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath}, nil).
+		Once()
+
+	mockCustomToolFactory.EXPECT().
+		LoadMultiSessionTools(expectedExtensionFilePath).
+		Return([]tools.Tool{mockCustomTool}, nil).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetCustomToolsToAdd()
+
+	// Assert
+	require.NoError(t, err, "GetCustomToolsToAdd should not return an error")
+	assert.Equal(t, []tools.Tool{mockCustomTool}, toolsToAdd, "GetCustomToolsToAdd should return the custom tool")
+}
+
+func TestConfigurator_GetCustomToolsToAdd_NoExtensionFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{}, nil).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetCustomToolsToAdd()
+
+	// Assert
+	require.NoError(t, err, "GetCustomToolsToAdd should not return an error")
+	assert.Empty(t, toolsToAdd, "GetCustomToolsToAdd should return no tools without an extension file")
+}
+
+func TestConfigurator_GetCustomToolsToAdd_MultipleMATLABSession_CustomToolNameConflict(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockCustomTool := &toolsmocks.MockTool{}
+	defer mockCustomTool.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := evalmatlabmultisession.New(nil, nil, nil, nil)
+	checkMATLABCodeInMATLABSessionTool := checkmatlabcodemultisession.New(nil, nil, nil)
+	fixMATLABCodeInMATLABSessionTool := fixmatlabcodemultisession.New(nil, nil, nil)
+	detectMATLABToolboxesInMATLABSessionTool := detectmatlabtoolboxesmultisession.New(nil, nil, nil)
+	runMATLABFileInMATLABSessionTool := runmatlabfilemultisession.New(nil, nil, nil, nil)
+	runMATLABTestFileInMATLABSessionTool := runmatlabtestfilemultisession.New(nil, nil, nil, nil)
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	expectedExtensionFilePath := filepath.Join("config", "tools.json")
+	expectedConflictingToolName := "run_matlab_file_in_matlab_session"
+
+	mockCustomTool.EXPECT().
+		Name().
+		Return(expectedConflictingToolName)
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath}, nil).
+		Once()
+
+	mockCustomToolFactory.EXPECT().
+		LoadMultiSessionTools(expectedExtensionFilePath).
+		Return([]tools.Tool{mockCustomTool}, nil).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetCustomToolsToAdd()
+
+	// Assert
+	require.Error(t, err, "GetCustomToolsToAdd should return an error for conflicting tool name")
+	assert.Nil(t, toolsToAdd, "Tools should be nil when name conflict occurs")
+	var nameConflictError *messages.StartupErrors_CustomToolNameConflict_Error
+	require.ErrorAs(t, err, &nameConflictError)
+	assert.Equal(t, expectedConflictingToolName, nameConflictError.Attr0)
+	assert.Equal(t, expectedExtensionFilePath, nameConflictError.Attr1)
+}
+
+func TestConfigurator_GetCustomToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	expectedExtensionFilePath := filepath.Join("config", "tools.json")
+	expectedError := messages.AnError
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{expectedExtensionFilePath}, nil).
+		Once()
+
+	mockCustomToolFactory.EXPECT().
+		LoadTools(expectedExtensionFilePath).
+		Return(nil, expectedError).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetCustomToolsToAdd()
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "GetCustomToolsToAdd should return the error from the loader")
+	assert.Nil(t, toolsToAdd, "Tools should be nil when loader error occurs")
+}
+
+func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	result := c.GetResourcesToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource, matlabSessionLogResource}, result)
+}
+
+func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: false}}).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
+		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
+		mockCustomToolFactory,
+		mockExtensionFileFinder,
+	)
+
+	// Act
+	toolsToAdd, err := c.GetToolsToAdd()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, toolsToAdd)
+}
+
+func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	getMATLABSessionLogTool := &getmatlabsessionlog.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	checkMATLABCodeInMATLABSessionTool := &checkmatlabcodemultisession.Tool{}
+	fixMATLABCodeInMATLABSessionTool := &fixmatlabcodemultisession.Tool{}
+	detectMATLABToolboxesInMATLABSessionTool := &detectmatlabtoolboxesmultisession.Tool{}
+	runMATLABFileInMATLABSessionTool := &runmatlabfilemultisession.Tool{}
+	runMATLABTestFileInMATLABSessionTool := &runmatlabtestfilemultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	fixMATLABCodeInGlobalMATLABSessionTool := &fixmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	getMATLABSessionLogInGlobalMATLABSessionTool := &getmatlabsessionlogsinglesession.Tool{}
+	listSharedMATLABSessionsTool := &listsharedmatlabsessions.Tool{}
+	attachToSharedMATLABSessionTool := &attachsharedmatlabsession.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabSessionLogResource := &matlabsessionlog.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -675,15 +1758,28 @@ func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
+		listMATLABSessionsTool,
+		getMATLABSessionLogTool,
 		evalInMATLABSessionTool,
+		checkMATLABCodeInMATLABSessionTool,
+		fixMATLABCodeInMATLABSessionTool,
+		detectMATLABToolboxesInMATLABSessionTool,
+		runMATLABFileInMATLABSessionTool,
+		runMATLABTestFileInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
+		fixMATLABCodeInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		getMATLABSessionLogInGlobalMATLABSessionTool,
+		listSharedMATLABSessionsTool,
+		attachToSharedMATLABSessionTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabSessionLogResource,
 		mockCustomToolFactory,
+		mockExtensionFileFinder,
 	)
 
 	// Act
@@ -0,0 +1,231 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package extensionfilewatcher
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const defaultPollInterval = 2 * time.Second
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type CustomToolLoader interface {
+	GetCustomToolsToAdd() ([]tools.Tool, error)
+}
+
+type ExtensionFileFinder interface {
+	Find() ([]string, messages.Error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Watcher reloads the custom tools when the extension files change, and swaps them on the MCP server.
+// Extension files added to or removed from the extension folders are also changes.
+// The MCP SDK notifies connected MCP clients that the list of tools changed.
+// When the changed extension files are invalid, the previous custom tools are kept.
+type Watcher struct {
+	configFactory       ConfigFactory
+	loggerFactory       LoggerFactory
+	customToolLoader    CustomToolLoader
+	extensionFileFinder ExtensionFileFinder
+	osLayer             OSLayer
+	lifecycleSignaler   LifecycleSignaler
+
+	pollInterval time.Duration
+
+	// The fields below are only set by Watch, and then only used by the watch loop.
+	logger       entities.Logger
+	mcpServer    *mcp.Server
+	fileVersions map[string]fileVersion
+	toolNames    []string
+}
+
+// fileVersion identifies the content of an extension file, as seen by the file system.
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+func (v fileVersion) equal(other fileVersion) bool {
+	return v.modTime.Equal(other.modTime) && v.size == other.size
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	customToolLoader CustomToolLoader,
+	extensionFileFinder ExtensionFileFinder,
+	osLayer OSLayer,
+	lifecycleSignaler LifecycleSignaler,
+) *Watcher {
+	return &Watcher{
+		configFactory:       configFactory,
+		loggerFactory:       loggerFactory,
+		customToolLoader:    customToolLoader,
+		extensionFileFinder: extensionFileFinder,
+		osLayer:             osLayer,
+		lifecycleSignaler:   lifecycleSignaler,
+
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Watch checks the extension files for changes in the background, until the application shuts down.
+// customTools are the custom tools already added to mcpServer.
+// Nothing is watched when no extension file or extension folder is configured.
+func (w *Watcher) Watch(mcpServer *mcp.Server, customTools []tools.Tool) error {
+	config, messagesErr := w.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	if len(config.ExtensionFiles()) == 0 && len(config.ExtensionDirs()) == 0 {
+		return nil
+	}
+
+	logger, messagesErr := w.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	versions, err := w.stat()
+	if err != nil {
+		return err
+	}
+
+	w.logger = logger
+	w.mcpServer = mcpServer
+	w.fileVersions = versions
+	w.toolNames = toolNames(customTools)
+
+	stopC := make(chan struct{})
+	doneC := make(chan struct{})
+	w.lifecycleSignaler.AddShutdownFunction(func() error {
+		close(stopC)
+		<-doneC
+		return nil
+	})
+
+	w.logger.With("count", len(versions)).Info("Watching extension files for changes")
+
+	go func() {
+		defer close(doneC)
+
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+				w.checkForChanges()
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (w *Watcher) checkForChanges() {
+	versions, err := w.stat()
+	if err != nil {
+		// Editors can briefly remove a file while saving it, so wait for it to come back.
+		w.logger.WithError(err).Debug("Failed to check extension files for changes")
+		return
+	}
+
+	if maps.EqualFunc(versions, w.fileVersions, fileVersion.equal) {
+		return
+	}
+	w.fileVersions = versions
+
+	w.reload()
+}
+
+// reload swaps the custom tools on the MCP server for the ones in the extension files.
+// Tools that are no longer defined are removed, and the others are added again, replacing their previous definition.
+func (w *Watcher) reload() {
+	customTools, err := w.customToolLoader.GetCustomToolsToAdd()
+	if err != nil {
+		w.logger.WithError(err).Warn("Failed to reload custom tools from changed extension files, keeping the previous custom tools")
+		return
+	}
+
+	newToolNames := toolNames(customTools)
+
+	var removedToolNames []string
+	for _, name := range w.toolNames {
+		if !slices.Contains(newToolNames, name) {
+			removedToolNames = append(removedToolNames, name)
+		}
+	}
+	if len(removedToolNames) > 0 {
+		w.mcpServer.RemoveTools(removedToolNames...)
+	}
+
+	for _, tool := range customTools {
+		if err := tool.AddToServer(w.mcpServer); err != nil {
+			w.logger.WithError(err).With("tool-name", tool.Name()).Warn("Failed to add reloaded custom tool")
+		}
+	}
+
+	w.toolNames = newToolNames
+
+	w.logger.
+		With("count", len(customTools)).
+		With("removed", len(removedToolNames)).
+		Info("Reloaded custom tools from changed extension files")
+}
+
+// stat returns the version of each extension file.
+func (w *Watcher) stat() (map[string]fileVersion, error) {
+	extensionFiles, messagesErr := w.extensionFileFinder.Find()
+	if messagesErr != nil {
+		return nil, messagesErr
+	}
+
+	versions := make(map[string]fileVersion, len(extensionFiles))
+	for _, extensionFile := range extensionFiles {
+		info, err := w.osLayer.Stat(extensionFile)
+		if err != nil {
+			return nil, err
+		}
+		versions[extensionFile] = fileVersion{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+		}
+	}
+
+	return versions, nil
+}
+
+func toolNames(customTools []tools.Tool) []string {
+	names := make([]string, 0, len(customTools))
+	for _, tool := range customTools {
+		names = append(names, tool.Name())
+	}
+	return names
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package extensionfilewatcher
+
+import "time"
+
+func (w *Watcher) SetPollInterval(pollInterval time.Duration) {
+	w.pollInterval = pollInterval
+}
+
+// CheckForChanges checks the extension file for changes once, as the watch loop does on each tick.
+func (w *Watcher) CheckForChanges() {
+	w.checkForChanges()
+}
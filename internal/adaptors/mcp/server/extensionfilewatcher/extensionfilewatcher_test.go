@@ -0,0 +1,841 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package extensionfilewatcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/extensionfilewatcher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/extensionfilewatcher"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Act
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, watcher)
+}
+
+func TestWatcher_Watch_NoExtensionFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{}).
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+
+	// Act
+	err := watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestWatcher_Watch_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+
+	// Act
+	err := watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestWatcher_Watch_StatError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(nil, expectedError).
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+
+	// Act
+	err := watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestWatcher_CheckForChanges_UnchangedFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	mockLogger := testutils.NewInspectableLogger()
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockFileInfo, nil).
+		Twice()
+
+	mockFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Twice()
+
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Twice()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+	watcher.SetPollInterval(time.Hour)
+
+	require.NoError(t, watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil))
+
+	// Act
+	watcher.CheckForChanges()
+
+	// Assert
+	require.NoError(t, capturedShutdownFunc())
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestWatcher_CheckForChanges_ReloadsWhenExtensionFileIsAdded(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockAddedFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockAddedFileInfo.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	const addedExtensionFile = "/path/to/tools/signal.json"
+	mockLogger := testutils.NewInspectableLogger()
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile, addedExtensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockFileInfo, nil).
+		Twice()
+
+	mockFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Twice()
+
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		Stat(addedExtensionFile).
+		Return(mockAddedFileInfo, nil).
+		Once()
+
+	mockAddedFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Once()
+
+	mockAddedFileInfo.EXPECT().
+		Size().
+		Return(int64(50)).
+		Once()
+
+	mockCustomToolLoader.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+	watcher.SetPollInterval(time.Hour)
+
+	require.NoError(t, watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil))
+
+	// Act
+	watcher.CheckForChanges()
+
+	// Assert
+	require.NoError(t, capturedShutdownFunc())
+	assert.Empty(t, mockLogger.WarnLogs())
+
+	_, found := mockLogger.InfoLogs()["Reloaded custom tools from changed extension files"]
+	assert.True(t, found, "Expected the custom tools to be reloaded when an extension file is added")
+}
+
+func TestWatcher_CheckForChanges_ReloadsChangedFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOriginalFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockOriginalFileInfo.AssertExpectations(t)
+
+	mockChangedFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockChangedFileInfo.AssertExpectations(t)
+
+	mockKeptTool := &toolsmocks.MockTool{}
+	defer mockKeptTool.AssertExpectations(t)
+
+	mockRemovedTool := &toolsmocks.MockTool{}
+	defer mockRemovedTool.AssertExpectations(t)
+
+	mockReloadedKeptTool := &toolsmocks.MockTool{}
+	defer mockReloadedKeptTool.AssertExpectations(t)
+
+	mockAddedTool := &toolsmocks.MockTool{}
+	defer mockAddedTool.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	mockLogger := testutils.NewInspectableLogger()
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mcpServer := newTestServer()
+	addRawTool(mcpServer, "kept_tool")
+	addRawTool(mcpServer, "removed_tool")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockOriginalFileInfo, nil).
+		Once()
+
+	mockOriginalFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Once()
+
+	mockOriginalFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockChangedFileInfo, nil).
+		Once()
+
+	mockChangedFileInfo.EXPECT().
+		ModTime().
+		Return(modTime.Add(time.Second)).
+		Once()
+
+	mockChangedFileInfo.EXPECT().
+		Size().
+		Return(int64(120)).
+		Once()
+
+	mockKeptTool.EXPECT().
+		Name().
+		Return("kept_tool").
+		Once()
+
+	mockRemovedTool.EXPECT().
+		Name().
+		Return("removed_tool").
+		Once()
+
+	mockReloadedKeptTool.EXPECT().
+		Name().
+		Return("kept_tool").
+		Once()
+
+	mockAddedTool.EXPECT().
+		Name().
+		Return("added_tool").
+		Once()
+
+	mockCustomToolLoader.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{mockReloadedKeptTool, mockAddedTool}, nil).
+		Once()
+
+	mockReloadedKeptTool.EXPECT().
+		AddToServer(mcpServer).
+		Run(func(mcpServer *mcp.Server) {
+			addRawTool(mcpServer, "kept_tool")
+		}).
+		Return(nil).
+		Once()
+
+	mockAddedTool.EXPECT().
+		AddToServer(mcpServer).
+		Run(func(mcpServer *mcp.Server) {
+			addRawTool(mcpServer, "added_tool")
+		}).
+		Return(nil).
+		Once()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+	watcher.SetPollInterval(time.Hour)
+
+	require.NoError(t, watcher.Watch(mcpServer, []tools.Tool{mockKeptTool, mockRemovedTool}))
+
+	// Act
+	watcher.CheckForChanges()
+
+	// Assert
+	require.NoError(t, capturedShutdownFunc())
+	assert.ElementsMatch(t, []string{"kept_tool", "added_tool"}, listToolNames(t, mcpServer))
+
+	logs := mockLogger.InfoLogs()
+	fields, found := logs["Reloaded custom tools from changed extension files"]
+	require.True(t, found, "Expected a log after reloading the custom tools")
+	assert.Equal(t, 2, fields["count"])
+	assert.Equal(t, 1, fields["removed"])
+}
+
+func TestWatcher_CheckForChanges_KeepsPreviousToolsWhenReloadFails(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOriginalFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockOriginalFileInfo.AssertExpectations(t)
+
+	mockChangedFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockChangedFileInfo.AssertExpectations(t)
+
+	mockTool := &toolsmocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	mockLogger := testutils.NewInspectableLogger()
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mcpServer := newTestServer()
+	addRawTool(mcpServer, "custom_tool")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockOriginalFileInfo, nil).
+		Once()
+
+	mockOriginalFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Once()
+
+	mockOriginalFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockChangedFileInfo, nil).
+		Once()
+
+	mockChangedFileInfo.EXPECT().
+		ModTime().
+		Return(modTime.Add(time.Second)).
+		Once()
+
+	mockChangedFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Once()
+
+	mockTool.EXPECT().
+		Name().
+		Return("custom_tool").
+		Once()
+
+	mockCustomToolLoader.EXPECT().
+		GetCustomToolsToAdd().
+		Return(nil, assert.AnError).
+		Once()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+	watcher.SetPollInterval(time.Hour)
+
+	require.NoError(t, watcher.Watch(mcpServer, []tools.Tool{mockTool}))
+
+	// Act
+	watcher.CheckForChanges()
+
+	// Assert
+	require.NoError(t, capturedShutdownFunc())
+
+	logs := mockLogger.WarnLogs()
+	_, found := logs["Failed to reload custom tools from changed extension files, keeping the previous custom tools"]
+	assert.True(t, found, "Expected a warning when the changed extension file cannot be loaded")
+	assert.Equal(t, []string{"custom_tool"}, listToolNames(t, mcpServer))
+}
+
+func TestWatcher_CheckForChanges_IgnoresStatError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCustomToolLoader := &mocks.MockCustomToolLoader{}
+	defer mockCustomToolLoader.AssertExpectations(t)
+
+	mockExtensionFileFinder := &mocks.MockExtensionFileFinder{}
+	defer mockExtensionFileFinder.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	const extensionFile = "/path/to/tools.json"
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		ModTime().
+		Return(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Once()
+
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(100)).
+		Once()
+
+	mockExtensionFileFinder.EXPECT().
+		Find().
+		Return([]string{extensionFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	watcher := extensionfilewatcher.New(mockConfigFactory, mockLoggerFactory, mockCustomToolLoader, mockExtensionFileFinder, mockOSLayer, mockLifecycleSignaler)
+	watcher.SetPollInterval(time.Hour)
+
+	require.NoError(t, watcher.Watch(mcp.NewServer(&mcp.Implementation{Name: "test"}, nil), nil))
+
+	// Act
+	watcher.CheckForChanges()
+
+	// Assert
+	require.NoError(t, capturedShutdownFunc())
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func addRawTool(mcpServer *mcp.Server, name string) {
+	mcpServer.AddTool(&mcp.Tool{
+		Name:        name,
+		InputSchema: &jsonschema.Schema{Type: "object"},
+	}, func(context.Context, *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+}
+
+func listToolNames(t *testing.T, mcpServer *mcp.Server) []string {
+	t.Helper()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	clientSession, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	result, err := clientSession.ListTools(t.Context(), nil)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(result.Tools))
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+// newTestServer returns an MCP server that does not notify clients when its tools change.
+// The notifications are sent from a timer, which would race with the mocks recording the server as an argument.
+func newTestServer() *mcp.Server {
+	return mcp.NewServer(&mcp.Implementation{Name: "test-server"}, &mcp.ServerOptions{
+		Capabilities: &mcp.ServerCapabilities{
+			Tools: &mcp.ToolCapabilities{ListChanged: false},
+		},
+	})
+}
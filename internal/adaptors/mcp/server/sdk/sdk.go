@@ -31,6 +31,10 @@ type LoggerFactory interface {
 	GetGlobalLogger() (entities.Logger, messages.Error)
 }
 
+type ClientNotifier interface {
+	AttachServer(server *mcp.Server)
+}
+
 type GlobalMATLAB interface {
 	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
 }
@@ -41,11 +45,12 @@ type MCPSession interface {
 }
 
 type Factory struct {
-	configFactory ConfigFactory
-	definition    Definition
-	rootStore     RootStore
-	loggerFactory LoggerFactory
-	globalMATLAB  GlobalMATLAB
+	configFactory  ConfigFactory
+	definition     Definition
+	rootStore      RootStore
+	loggerFactory  LoggerFactory
+	globalMATLAB   GlobalMATLAB
+	clientNotifier ClientNotifier
 }
 
 type serverCallbackHandler struct {
@@ -62,13 +67,15 @@ func NewFactory(
 	rootStore RootStore,
 	loggerFactory LoggerFactory,
 	globalMATLAB GlobalMATLAB,
+	clientNotifier ClientNotifier,
 ) *Factory {
 	return &Factory{
-		configFactory: configFactory,
-		definition:    definition,
-		rootStore:     rootStore,
-		loggerFactory: loggerFactory,
-		globalMATLAB:  globalMATLAB,
+		configFactory:  configFactory,
+		definition:     definition,
+		rootStore:      rootStore,
+		loggerFactory:  loggerFactory,
+		globalMATLAB:   globalMATLAB,
+		clientNotifier: clientNotifier,
 	}
 }
 
@@ -102,7 +109,10 @@ func (f *Factory) NewServer() (*mcp.Server, messages.Error) {
 		RootsListChangedHandler: s.handleRootsListChanged,
 	}
 
-	return mcp.NewServer(impl, options), nil
+	server := mcp.NewServer(impl, options)
+	f.clientNotifier.AttachServer(server)
+
+	return server, nil
 }
 
 func (s *serverCallbackHandler) handleInitialized(ctx context.Context, req *mcp.InitializedRequest) {
@@ -13,6 +13,7 @@ import (
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/sdk"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,8 +34,11 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	// Act
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockClientNotifier)
 
 	// Assert
 	assert.NotNil(t, factory, "Factory should not be nil")
@@ -60,6 +64,9 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedVersion := "1.0.0"
 	expectedName := "test-server"
@@ -101,7 +108,12 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 		Return(expectedInstructions).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB)
+	mockClientNotifier.EXPECT().
+		AttachServer(mock.AnythingOfType("*mcp.Server")).
+		Return().
+		Once()
+
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockClientNotifier)
 
 	// Act
 	server, err := factory.NewServer()
@@ -128,6 +140,9 @@ func TestFactory_NewServer_ConfigError(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -135,7 +150,7 @@ func TestFactory_NewServer_ConfigError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockClientNotifier)
 
 	// Act
 	server, err := factory.NewServer()
@@ -165,6 +180,9 @@ func TestFactory_NewServer_LoggerError(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -177,7 +195,7 @@ func TestFactory_NewServer_LoggerError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockClientNotifier)
 
 	// Act
 	server, err := factory.NewServer()
@@ -195,6 +213,9 @@ func TestHandleInitialized_EagerMATLABInit_HappyPath(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -234,6 +255,9 @@ func TestHandleInitialized_NilRequest(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -258,6 +282,9 @@ func TestHandleInitialized_NilSession(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -362,6 +389,9 @@ func TestHandleInitialized_EagerMATLABInit_MATLABFeatureDisabled(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -386,6 +416,9 @@ func TestHandleInitialized_EagerMATLABInit_MultipleSession(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -415,6 +448,9 @@ func TestHandleInitialized_EagerMATLABInit_InitializeMATLABOnStartupFalse(t *tes
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -449,6 +485,9 @@ func TestHandleInitialized_EagerMATLABInit_ErrorLogsWarning(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -492,6 +531,9 @@ func TestUpdateRoots_NilInitializeParams_ReturnsNil(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -525,6 +567,9 @@ func TestUpdateRoots_NilCapabilities_ReturnsNil(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -558,6 +603,9 @@ func TestUpdateRoots_NilRootsV2_ReturnsNil(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -593,6 +641,9 @@ func TestUpdateRoots_ListRootsError_ReturnsError(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -636,6 +687,9 @@ func TestUpdateRoots_HappyPath_UpdatesRootStore(t *testing.T) {
 	mockRootStore := &mocks.MockRootStore{}
 	defer mockRootStore.AssertExpectations(t)
 
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
 	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
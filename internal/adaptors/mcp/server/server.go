@@ -4,14 +4,33 @@ package server
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	httpserver "github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/auth"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+const (
+	initializedNotificationMethod = "notifications/initialized"
+	setLoggingLevelMethod         = "logging/setLevel"
+	callToolMethod                = "tools/call"
+	httpServerShutdownTimeout     = 5 * time.Second
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
 type LoggerFactory interface {
 	GetGlobalLogger() (entities.Logger, messages.Error)
 	NewMCPSessionLogger(session *mcp.ServerSession) (entities.Logger, messages.Error)
@@ -27,15 +46,27 @@ type MCPSDKServerFactory interface {
 
 type MCPServerConfigurator interface {
 	GetToolsToAdd() ([]tools.Tool, error)
+	GetCustomToolsToAdd() ([]tools.Tool, error)
 	GetResourcesToAdd() []resources.Resource
 }
 
+type ExtensionFileWatcher interface {
+	Watch(mcpServer *mcp.Server, customTools []tools.Tool) error
+}
+
+type HTTPServerFactory interface {
+	NewServerOverTCP(handler http.Handler) (httpserver.HttpServerOverTCP, error)
+}
+
 type Server struct {
-	mcpSDKServerFactory MCPSDKServerFactory
-	loggerFactory       LoggerFactory
-	lifecycleSignaler   LifecycleSignaler
-	configurator        MCPServerConfigurator
-	serverTransport     mcp.Transport
+	mcpSDKServerFactory  MCPSDKServerFactory
+	loggerFactory        LoggerFactory
+	lifecycleSignaler    LifecycleSignaler
+	configurator         MCPServerConfigurator
+	configFactory        ConfigFactory
+	httpServerFactory    HTTPServerFactory
+	extensionFileWatcher ExtensionFileWatcher
+	serverTransport      mcp.Transport
 }
 
 func New(
@@ -43,13 +74,19 @@ func New(
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 	configurator MCPServerConfigurator,
+	configFactory ConfigFactory,
+	httpServerFactory HTTPServerFactory,
+	extensionFileWatcher ExtensionFileWatcher,
 ) *Server {
 	return &Server{
-		mcpSDKServerFactory: mcpSDKServerfactory,
-		loggerFactory:       loggerFactory,
-		lifecycleSignaler:   lifecycleSignaler,
-		configurator:        configurator,
-		serverTransport:     &mcp.StdioTransport{},
+		mcpSDKServerFactory:  mcpSDKServerfactory,
+		loggerFactory:        loggerFactory,
+		lifecycleSignaler:    lifecycleSignaler,
+		configurator:         configurator,
+		configFactory:        configFactory,
+		httpServerFactory:    httpServerFactory,
+		extensionFileWatcher: extensionFileWatcher,
+		serverTransport:      &mcp.StdioTransport{},
 	}
 }
 
@@ -76,6 +113,18 @@ func (s *Server) Run(sdkUserTools []tools.Tool) error {
 	}
 	logger.With("count", len(toolsToAdd)).Info("Added tools to MCP SDK server")
 
+	customTools, err := s.configurator.GetCustomToolsToAdd()
+	if err != nil {
+		return err
+	}
+
+	for _, tool := range customTools {
+		if err := tool.AddToServer(mcpServer); err != nil {
+			return err
+		}
+	}
+	logger.With("count", len(customTools)).Info("Added custom tools to MCP SDK server")
+
 	for _, tool := range sdkUserTools {
 		if err := tool.AddToServer(mcpServer); err != nil {
 			return err
@@ -91,6 +140,25 @@ func (s *Server) Run(sdkUserTools []tools.Tool) error {
 	}
 	logger.With("count", len(resourcesToAdd)).Info("Added resources to MCP SDK server")
 
+	if err := s.extensionFileWatcher.Watch(mcpServer, customTools); err != nil {
+		return err
+	}
+
+	cfg, messagesErr := s.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	mcpServer.AddReceivingMiddleware(sessionLifecycleMiddleware(logger), clientLoggingMiddleware())
+
+	if cfg.Transport() == entities.TransportHTTP {
+		return s.runOverHTTP(logger, cfg, mcpServer)
+	}
+
+	return s.runOverStdio(logger, mcpServer)
+}
+
+func (s *Server) runOverStdio(logger entities.Logger, mcpServer *mcp.Server) error {
 	logger.Debug("Starting MCP server")
 
 	ctx, stopServer := context.WithCancel(context.Background())
@@ -120,3 +188,165 @@ func (s *Server) Run(sdkUserTools []tools.Tool) error {
 
 	return nil
 }
+
+func (s *Server) runOverHTTP(logger entities.Logger, cfg config.Config, mcpServer *mcp.Server) error {
+	var handler http.Handler = mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return mcpServer
+	}, nil)
+
+	if authToken := cfg.HTTPAuthToken(); authToken != "" {
+		handler = auth.RequireBearerToken(newBearerTokenVerifier(authToken), nil)(handler)
+	}
+
+	httpServer, err := s.httpServerFactory.NewServerOverTCP(handler)
+	if err != nil {
+		return err
+	}
+
+	address := cfg.HTTPListenAddress()
+	useTLS := cfg.HTTPTLSCertFile() != ""
+	logger = logger.
+		With("address", address).
+		With("tls", useTLS).
+		With("authentication", cfg.HTTPAuthToken() != "")
+
+	if cfg.HTTPAuthToken() == "" && !isLoopbackAddress(address) {
+		logger.Warn("MCP server over HTTP is reachable from other machines without authentication, set an HTTP auth token or listen on a loopback address")
+	}
+
+	logger.Debug("Starting MCP server over HTTP")
+
+	if err := httpServer.Listen(address); err != nil {
+		logger.WithError(err).Error("Failed to listen for MCP clients over HTTP")
+		return err
+	}
+
+	serverShutdownC := make(chan struct{})
+	defer close(serverShutdownC)
+
+	serverErrC := make(chan error)
+	go func() {
+		if useTLS {
+			serverErrC <- httpServer.ServeTLS(cfg.HTTPTLSCertFile(), cfg.HTTPTLSKeyFile())
+			return
+		}
+		serverErrC <- httpServer.Serve()
+	}()
+	logger.Info("Started MCP server over HTTP")
+
+	s.lifecycleSignaler.AddShutdownFunction(func() error {
+		logger.Debug("Stopping MCP server over HTTP")
+
+		// Streamable HTTP sessions hold long-lived requests open, close them so the HTTP server can drain.
+		for session := range mcpServer.Sessions() {
+			if err := session.Close(); err != nil {
+				logger.WithError(err).Warn("Failed to close MCP client session")
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), httpServerShutdownTimeout)
+		defer cancel()
+
+		err := httpServer.Shutdown(ctx)
+		<-serverShutdownC
+		logger.Debug("Stopped MCP server over HTTP")
+		return err
+	})
+
+	if err := <-serverErrC; err != nil {
+		logger.WithError(err).Error("MCP HTTP server returned an unexpected error")
+		return err
+	}
+
+	return nil
+}
+
+// isLoopbackAddress reports whether a host:port address only accepts connections from this machine.
+// An empty host listens on every interface, so it is not a loopback address.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func sessionLifecycleMiddleware(logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method == initializedNotificationMethod {
+				if session, ok := req.GetSession().(*mcp.ServerSession); ok {
+					logSessionLifecycle(logger, session)
+				}
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// logSessionLifecycle logs when an MCP client session is initialized, and when it is closed.
+// Tool and resource handlers create their own session loggers, so these entries only go to the global logger.
+func logSessionLifecycle(logger entities.Logger, session *mcp.ServerSession) {
+	sessionLogger := logger.With("session_id", session.ID())
+	sessionLogger.Debug("MCP client session initialized")
+
+	go func() {
+		_ = session.Wait()
+		sessionLogger.Debug("MCP client session closed")
+	}()
+}
+
+// clientLoggingMiddleware marks the tool calls of sessions whose client has set a logging level,
+// since the MCP SDK drops the log entries sent to any other client.
+func clientLoggingMiddleware() mcp.Middleware {
+	var mu sync.Mutex
+	loggingSessions := make(map[*mcp.ServerSession]struct{})
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok {
+				return next(ctx, method, req)
+			}
+
+			switch method {
+			case callToolMethod:
+				mu.Lock()
+				_, clientLogging := loggingSessions[session]
+				mu.Unlock()
+
+				if clientLogging {
+					ctx = progressreporter.ContextWithClientLogging(ctx)
+				}
+			case setLoggingLevelMethod:
+				result, err := next(ctx, method, req)
+				if err != nil {
+					return result, err
+				}
+
+				mu.Lock()
+				_, known := loggingSessions[session]
+				loggingSessions[session] = struct{}{}
+				mu.Unlock()
+
+				if !known {
+					go func() {
+						_ = session.Wait()
+						mu.Lock()
+						delete(loggingSessions, session)
+						mu.Unlock()
+					}()
+				}
+				return result, nil
+			}
+
+			return next(ctx, method, req)
+		}
+	}
+}
@@ -3,9 +3,14 @@
 package server
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 func (s *Server) SetServerTransport(serverTransport mcp.Transport) {
 	s.serverTransport = serverTransport
 }
+
+func LogSessionLifecycle(logger entities.Logger, session *mcp.ServerSession) {
+	logSessionLifecycle(logger, session)
+}
@@ -3,16 +3,26 @@
 package server_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	httpserver "github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	httpservermocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/http/server"
 	resourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
 	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,8 +43,17 @@ func TestNew_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	// Act
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Assert
 	assert.NotNil(t, svr, "Server should not be nil")
@@ -54,6 +73,15 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
@@ -66,6 +94,9 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockAdditionalTool := &toolsmocks.MockTool{}
 	defer mockAdditionalTool.AssertExpectations(t)
 
+	mockCustomTool := &toolsmocks.MockTool{}
+	defer mockCustomTool.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
@@ -84,11 +115,21 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return([]tools.Tool{mockFirstTool, mockSecondTool}, nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{mockCustomTool}, nil).
+		Once()
+
 	mockConfigurator.EXPECT().
 		GetResourcesToAdd().
 		Return([]resources.Resource{mockResource}).
 		Once()
 
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{mockCustomTool}).
+		Return(nil).
+		Once()
+
 	mockFirstTool.EXPECT().
 		AddToServer(expectedMCPServer).
 		Return(nil).
@@ -99,6 +140,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockCustomTool.EXPECT().
+		AddToServer(expectedMCPServer).
+		Return(nil).
+		Once()
+
 	mockAdditionalTool.EXPECT().
 		AddToServer(expectedMCPServer).
 		Return(nil).
@@ -109,6 +155,19 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportStdio).
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
@@ -118,7 +177,7 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	_, serverTransport := mcp.NewInMemoryTransports()
 	svr.SetServerTransport(serverTransport)
@@ -153,6 +212,15 @@ func TestServer_Run_GetGlobalLoggerError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockLoggerFactory.EXPECT().
@@ -160,7 +228,7 @@ func TestServer_Run_GetGlobalLoggerError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Act
 	err := svr.Run(nil)
@@ -183,6 +251,15 @@ func TestServer_Run_MCPSDKServerFactoryError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedError := messages.AnError
 
@@ -196,7 +273,7 @@ func TestServer_Run_MCPSDKServerFactoryError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Act
 	err := svr.Run(nil)
@@ -219,6 +296,15 @@ func TestServer_Run_ToolAddToServerReturnsError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockTool := &toolsmocks.MockTool{}
 	defer mockTool.AssertExpectations(t)
 
@@ -246,7 +332,7 @@ func TestServer_Run_ToolAddToServerReturnsError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Act
 	err := svr.Run(nil)
@@ -270,6 +356,15 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
@@ -292,6 +387,11 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 		Return(nil, nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
 	mockConfigurator.EXPECT().
 		GetResourcesToAdd().
 		Return([]resources.Resource{mockResource}).
@@ -302,7 +402,7 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Act
 	err := svr.Run(nil)
@@ -326,6 +426,15 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
@@ -344,11 +453,34 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 		Return(nil, nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
 	mockConfigurator.EXPECT().
 		GetResourcesToAdd().
 		Return(nil).
 		Once()
 
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportStdio).
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
@@ -358,7 +490,7 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 		Return().
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	_, serverTransport := mcp.NewInMemoryTransports()
 	svr.SetServerTransport(serverTransport)
@@ -393,6 +525,15 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 	expectedError := assert.AnError
@@ -412,7 +553,7 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
 
 	// Act
 	err := svr.Run(nil)
@@ -420,3 +561,1036 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Run should return the error from GetToolsToAdd")
 }
+
+func TestServer_Run_GetCustomToolsToAddError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedError := assert.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return(nil, expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	// Act
+	err := svr.Run(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from GetCustomToolsToAdd")
+}
+
+func TestServer_Run_ExtensionFileWatcherError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedError := assert.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	// Act
+	err := svr.Run(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from Watch")
+}
+
+func TestServer_Run_ConfigError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedError := messages.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	// Act
+	err := svr.Run(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from Config")
+}
+
+func TestServer_Run_HTTPTransport_HappyPath(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockHTTPServer := &httpservermocks.MockHttpServerOverTCP{}
+	defer mockHTTPServer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedAddress := "127.0.0.1:8080"
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPAuthToken().
+		Return("")
+
+	mockConfig.EXPECT().
+		HTTPListenAddress().
+		Return(expectedAddress).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPTLSCertFile().
+		Return("").
+		Once()
+
+	mockHTTPServerFactory.EXPECT().
+		NewServerOverTCP(mock.Anything).
+		Return(mockHTTPServer, nil).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Listen(expectedAddress).
+		Return(nil).
+		Once()
+
+	serverStoppedC := make(chan struct{})
+	mockHTTPServer.EXPECT().
+		Serve().
+		RunAndReturn(func() error {
+			<-serverStoppedC
+			return nil
+		}).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Shutdown(mock.Anything).
+		RunAndReturn(func(context.Context) error {
+			close(serverStoppedC)
+			return nil
+		}).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	errC := make(chan error)
+	go func() {
+		errC <- svr.Run(nil)
+	}()
+
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err, "Shutdown function should not return an error")
+	serverErr := <-errC
+	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+}
+
+func TestServer_Run_HTTPTransport_WarnsWhenReachableFromOtherMachinesWithoutAuthentication(t *testing.T) {
+	const unauthenticatedHTTPWarning = "MCP server over HTTP is reachable from other machines without authentication, set an HTTP auth token or listen on a loopback address"
+
+	testCases := []struct {
+		name          string
+		address       string
+		authToken     string
+		expectWarning bool
+	}{
+		{name: "IPv4 loopback", address: "127.0.0.1:8080", expectWarning: false},
+		{name: "IPv6 loopback", address: "[::1]:8080", expectWarning: false},
+		{name: "localhost", address: "localhost:8080", expectWarning: false},
+		{name: "all interfaces", address: "0.0.0.0:8080", expectWarning: true},
+		{name: "empty host", address: ":8080", expectWarning: true},
+		{name: "other machine address", address: "192.168.1.10:8080", expectWarning: true},
+		{name: "all interfaces with auth token", address: "0.0.0.0:8080", authToken: "secret-token", expectWarning: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+			defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockConfigurator := &mocks.MockMCPServerConfigurator{}
+			defer mockConfigurator.AssertExpectations(t)
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+			defer mockHTTPServerFactory.AssertExpectations(t)
+
+			mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+			defer mockExtensionFileWatcher.AssertExpectations(t)
+
+			mockHTTPServer := &httpservermocks.MockHttpServerOverTCP{}
+			defer mockHTTPServer.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+
+			mockLoggerFactory.EXPECT().
+				GetGlobalLogger().
+				Return(mockLogger, nil).
+				Once()
+
+			mockMCPSDKServerFactory.EXPECT().
+				NewServer().
+				Return(expectedMCPServer, nil).
+				Once()
+
+			mockConfigurator.EXPECT().
+				GetToolsToAdd().
+				Return(nil, nil).
+				Once()
+
+			mockConfigurator.EXPECT().
+				GetCustomToolsToAdd().
+				Return([]tools.Tool{}, nil).
+				Once()
+
+			mockConfigurator.EXPECT().
+				GetResourcesToAdd().
+				Return(nil).
+				Once()
+
+			mockExtensionFileWatcher.EXPECT().
+				Watch(expectedMCPServer, []tools.Tool{}).
+				Return(nil).
+				Once()
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				Transport().
+				Return(entities.TransportHTTP).
+				Once()
+
+			mockConfig.EXPECT().
+				HTTPAuthToken().
+				Return(tc.authToken)
+
+			mockConfig.EXPECT().
+				HTTPListenAddress().
+				Return(tc.address).
+				Once()
+
+			mockConfig.EXPECT().
+				HTTPTLSCertFile().
+				Return("").
+				Once()
+
+			mockHTTPServerFactory.EXPECT().
+				NewServerOverTCP(mock.Anything).
+				Return(mockHTTPServer, nil).
+				Once()
+
+			mockHTTPServer.EXPECT().
+				Listen(tc.address).
+				Return(nil).
+				Once()
+
+			serverStoppedC := make(chan struct{})
+			mockHTTPServer.EXPECT().
+				Serve().
+				RunAndReturn(func() error {
+					<-serverStoppedC
+					return nil
+				}).
+				Once()
+
+			mockHTTPServer.EXPECT().
+				Shutdown(mock.Anything).
+				RunAndReturn(func(context.Context) error {
+					close(serverStoppedC)
+					return nil
+				}).
+				Once()
+
+			capturedShutdownFuncC := make(chan func() error)
+			mockLifecycleSignaler.EXPECT().
+				AddShutdownFunction(mock.AnythingOfType("func() error")).
+				Run(func(shutdownFcn func() error) {
+					capturedShutdownFuncC <- shutdownFcn
+				}).
+				Return().
+				Once()
+
+			svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+			errC := make(chan error)
+			go func() {
+				errC <- svr.Run(nil)
+			}()
+
+			capturedShutdownFunc := <-capturedShutdownFuncC
+
+			// Act
+			err := capturedShutdownFunc()
+
+			// Assert
+			require.NoError(t, err, "Shutdown function should not return an error")
+			serverErr := <-errC
+			require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+
+			if tc.expectWarning {
+				assert.Contains(t, mockLogger.WarnLogs(), unauthenticatedHTTPWarning)
+			} else {
+				assert.NotContains(t, mockLogger.WarnLogs(), unauthenticatedHTTPWarning)
+			}
+		})
+	}
+}
+
+func TestServer_Run_HTTPTransport_TLS(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockHTTPServer := &httpservermocks.MockHttpServerOverTCP{}
+	defer mockHTTPServer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedAddress := "127.0.0.1:8443"
+	expectedCertFile := "cert.pem"
+	expectedKeyFile := "key.pem"
+	expectedError := assert.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPAuthToken().
+		Return("")
+
+	mockConfig.EXPECT().
+		HTTPListenAddress().
+		Return(expectedAddress).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPTLSCertFile().
+		Return(expectedCertFile)
+
+	mockConfig.EXPECT().
+		HTTPTLSKeyFile().
+		Return(expectedKeyFile).
+		Once()
+
+	mockHTTPServerFactory.EXPECT().
+		NewServerOverTCP(mock.Anything).
+		Return(mockHTTPServer, nil).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Listen(expectedAddress).
+		Return(nil).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		ServeTLS(expectedCertFile, expectedKeyFile).
+		Return(expectedError).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	// Act
+	err := svr.Run(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from ServeTLS")
+}
+
+func TestServer_Run_HTTPTransport_ListenError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockHTTPServer := &httpservermocks.MockHttpServerOverTCP{}
+	defer mockHTTPServer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedAddress := "127.0.0.1:8080"
+	expectedError := assert.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPAuthToken().
+		Return("")
+
+	mockConfig.EXPECT().
+		HTTPListenAddress().
+		Return(expectedAddress).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPTLSCertFile().
+		Return("").
+		Once()
+
+	mockHTTPServerFactory.EXPECT().
+		NewServerOverTCP(mock.Anything).
+		Return(mockHTTPServer, nil).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Listen(expectedAddress).
+		Return(expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	// Act
+	err := svr.Run(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from Listen")
+	assert.NotContains(t, mockLogger.InfoLogs(), "Started MCP server over HTTP", "The server should not be reported as started")
+}
+
+func TestServer_Run_HTTPTransport_RequiresBearerToken(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockHTTPServer := &httpservermocks.MockHttpServerOverTCP{}
+	defer mockHTTPServer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	expectedAddress := "127.0.0.1:8080"
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPAuthToken().
+		Return("secret-token")
+
+	mockConfig.EXPECT().
+		HTTPListenAddress().
+		Return(expectedAddress).
+		Once()
+
+	mockConfig.EXPECT().
+		HTTPTLSCertFile().
+		Return("").
+		Once()
+
+	var capturedHandler http.Handler
+	mockHTTPServerFactory.EXPECT().
+		NewServerOverTCP(mock.Anything).
+		RunAndReturn(func(handler http.Handler) (httpserver.HttpServerOverTCP, error) {
+			capturedHandler = handler
+			return mockHTTPServer, nil
+		}).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Listen(expectedAddress).
+		Return(nil).
+		Once()
+
+	mockHTTPServer.EXPECT().
+		Serve().
+		Return(nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	err := svr.Run(nil)
+	require.NoError(t, err)
+	require.NotNil(t, capturedHandler)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", nil)
+	capturedHandler.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, "Requests without a bearer token should be rejected")
+}
+
+func TestLogSessionLifecycle_LogsSessionInitializedAndClosed(t *testing.T) {
+	// Arrange
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockSessionLogger := &entitiesmocks.MockLogger{}
+	defer mockSessionLogger.AssertExpectations(t)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil).Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+
+	clientSession, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	mockLogger.EXPECT().
+		With("session_id", serverSession.ID()).
+		Return(mockSessionLogger).
+		Once()
+
+	mockSessionLogger.EXPECT().
+		Debug("MCP client session initialized").
+		Return().
+		Once()
+
+	closedC := make(chan struct{})
+	mockSessionLogger.EXPECT().
+		Debug("MCP client session closed").
+		Run(func(string) {
+			close(closedC)
+		}).
+		Return().
+		Once()
+
+	// Act
+	server.LogSessionLifecycle(mockLogger, serverSession)
+	require.NoError(t, clientSession.Close())
+
+	// Assert
+	select {
+	case <-closedC:
+	case <-time.After(time.Second):
+		t.Fatal("Session closing should be logged")
+	}
+}
+
+func TestServer_Run_ToolCallsCarryWhetherClientSetLoggingLevel(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockHTTPServerFactory := &mocks.MockHTTPServerFactory{}
+	defer mockHTTPServerFactory.AssertExpectations(t)
+
+	mockExtensionFileWatcher := &mocks.MockExtensionFileWatcher{}
+	defer mockExtensionFileWatcher.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTool := &toolsmocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	// Adding a tool would otherwise notify the sessions from a timer, which races with the mocks printing the server.
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, &mcp.ServerOptions{
+		Capabilities: &mcp.ServerCapabilities{Tools: &mcp.ToolCapabilities{}},
+	})
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer().
+		Return(expectedMCPServer, nil).
+		Once()
+
+	var consoleOutputWanted []bool
+	mockTool.EXPECT().
+		AddToServer(expectedMCPServer).
+		RunAndReturn(func(mcpServer *mcp.Server) error {
+			mcpServer.AddTool(&mcp.Tool{Name: "tool", InputSchema: map[string]any{"type": "object"}}, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				reporter := entities.EvalProgressReporterFromContext(progressreporter.ContextForToolCall(ctx, req, mockLogger))
+				consoleOutputWanted = append(consoleOutputWanted, reporter != nil && reporter.WantsConsoleOutput())
+				return &mcp.CallToolResult{}, nil
+			})
+			return nil
+		}).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return([]tools.Tool{mockTool}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetCustomToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockExtensionFileWatcher.EXPECT().
+		Watch(expectedMCPServer, []tools.Tool{}).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportStdio).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfigFactory, mockHTTPServerFactory, mockExtensionFileWatcher)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	svr.SetServerTransport(serverTransport)
+
+	errC := make(chan error)
+	go func() {
+		errC <- svr.Run(nil)
+	}()
+
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	// Act
+	_, err = clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "tool"})
+	require.NoError(t, err)
+
+	require.NoError(t, clientSession.SetLoggingLevel(t.Context(), &mcp.SetLoggingLevelParams{Level: "info"}))
+
+	_, err = clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "tool"})
+	require.NoError(t, err)
+
+	// Assert
+	require.NoError(t, clientSession.Close())
+	require.NoError(t, capturedShutdownFunc())
+	require.NoError(t, <-errC)
+	assert.Equal(t, []bool{false, true}, consoleOutputWanted, "Only tool calls made after the client set a logging level should mirror console output")
+}
@@ -49,3 +49,14 @@ func NewDestructiveAnnotations() annotations {
 		openWorld:   true,
 	}
 }
+
+// NewNonDestructiveAnnotations creates annotations for tools that change server state,
+// such as which MATLAB session is used, without modifying data or executing user code.
+func NewNonDestructiveAnnotations() annotations {
+	return annotations{
+		readOnly:    false,
+		destructive: false,
+		idempotent:  true,
+		openWorld:   false,
+	}
+}
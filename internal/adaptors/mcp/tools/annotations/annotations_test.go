@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package annotations
 
@@ -31,6 +31,17 @@ func TestNewDestructiveAnnotations(t *testing.T) {
 	assert.True(t, result.openWorld, "openWorld should be true")
 }
 
+func TestNewNonDestructiveAnnotations(t *testing.T) {
+	// Act
+	result := NewNonDestructiveAnnotations()
+
+	// Assert
+	assert.False(t, result.readOnly, "readOnly should be false")
+	assert.False(t, result.destructive, "destructive should be false")
+	assert.True(t, result.idempotent, "idempotent should be true")
+	assert.False(t, result.openWorld, "openWorld should be false")
+}
+
 func TestToToolAnnotations_ReadOnly(t *testing.T) {
 	// Arrange
 	annotations := NewReadOnlyAnnotations()
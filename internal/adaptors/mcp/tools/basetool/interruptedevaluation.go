@@ -0,0 +1,79 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package basetool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	cancelledEvaluationMessage              = "The tool call was cancelled, and MATLAB execution was interrupted."
+	cancelledUninterruptedEvaluationMessage = "The tool call was cancelled. MATLAB execution was not interrupted, so the code may have run to completion."
+)
+
+// InterruptedEvaluation describes a MATLAB evaluation that did not run to completion.
+// It is the structured content of the tool result, unless the tool declares an output schema.
+type InterruptedEvaluation struct {
+	TimedOut       bool    `json:"timed_out"`
+	TimeoutSeconds float64 `json:"timeout_seconds,omitempty"`
+	Cancelled      bool    `json:"cancelled"`
+	PartialOutput  string  `json:"partial_output"`
+}
+
+// InterruptedEvaluationResult returns the tool result for a tool call whose MATLAB evaluation did not run to completion.
+// The result is an error result that keeps the output MATLAB produced before it was interrupted.
+// It returns false when err is not about an interrupted evaluation.
+func InterruptedEvaluationResult(err error) (*mcp.CallToolResult, bool) {
+	var timeoutErr *entities.EvalTimeoutError
+	switch {
+	case errors.As(err, &timeoutErr):
+		partialResponse := timeoutErr.PartialResponse
+		message := fmt.Sprintf("MATLAB execution timed out after %s and was interrupted.", timeoutErr.Timeout)
+		if timeoutErr.NotStarted {
+			message = fmt.Sprintf("MATLAB execution timed out after %s while waiting for MATLAB, so the code did not run.", timeoutErr.Timeout)
+		}
+		content := []mcp.Content{
+			&mcp.TextContent{Text: message},
+		}
+		if partialResponse.ConsoleOutput != "" {
+			content = append(content, &mcp.TextContent{Text: partialResponse.ConsoleOutput})
+		}
+		for _, image := range partialResponse.Images {
+			content = append(content, &mcp.ImageContent{MIMEType: "image/png", Data: image})
+		}
+
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: content,
+			StructuredContent: InterruptedEvaluation{
+				TimedOut:       true,
+				TimeoutSeconds: timeoutErr.Timeout.Seconds(),
+				PartialOutput:  partialResponse.ConsoleOutput,
+			},
+		}, true
+
+	case errors.Is(err, entities.ErrEvaluationCancelled):
+		message := cancelledUninterruptedEvaluationMessage
+		var cancelledErr *entities.EvalCancelledError
+		if errors.As(err, &cancelledErr) && cancelledErr.Interrupted {
+			message = cancelledEvaluationMessage
+		}
+
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: message},
+			},
+			StructuredContent: InterruptedEvaluation{
+				Cancelled: true,
+			},
+		}, true
+
+	default:
+		return nil, false
+	}
+}
@@ -4,11 +4,15 @@ package basetool
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/toolnotices"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -90,13 +94,61 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 			return nil, toolOutputZeroValue, err
 		}
 
+		ctx = progressreporter.ContextForToolCall(ctx, req, logger)
+		ctx, notices := toolnotices.ContextForToolCall(ctx)
+
 		toolOutput, err := t.structuredContentHandler(ctx, logger, input)
+		if result, interrupted := InterruptedEvaluationResult(err); interrupted {
+			// The SDK replaces the structured content with the tool output, and validates it against the output schema,
+			// so return the output the handler returned with the error, which can describe the interruption itself.
+			logger.WithError(err).Info("MATLAB evaluation was interrupted")
+			result.Content = append(result.Content, notices.Content()...)
+			return result, toolOutput, nil
+		}
 		if err != nil {
 			logger.WithError(err).Warn("Structured handler returned an error")
+			if result, hasNotices := errorResultWithNotices(err, notices.Content()); hasNotices {
+				return result, toolOutputZeroValue, nil
+			}
 			return nil, toolOutputZeroValue, err
 		}
-		return nil, toolOutput, nil
+
+		noticeContent := notices.Content()
+		if len(noticeContent) == 0 {
+			return nil, toolOutput, nil
+		}
+
+		return resultWithNotices(toolOutput, noticeContent), toolOutput, nil
+	}
+}
+
+// resultWithNotices returns the serialized tool output as text, followed by the notices.
+// The SDK only fills in the text content itself when the result has none.
+func resultWithNotices(toolOutput any, noticeContent []mcp.Content) *mcp.CallToolResult {
+	result := &mcp.CallToolResult{}
+	if outputJSON, err := json.Marshal(toolOutput); err == nil {
+		result.Content = append(result.Content, &mcp.TextContent{Text: string(outputJSON)})
+	}
+	result.Content = append(result.Content, noticeContent...)
+	return result
+}
+
+// errorResultWithNotices returns the error result the SDK would make of err, followed by the notices,
+// so that notices such as a MATLAB restart are not lost when the tool call fails.
+// It returns false when there are no notices, or when err is a JSON-RPC error, which is not reported as a tool result.
+func errorResultWithNotices(err error, noticeContent []mcp.Content) (*mcp.CallToolResult, bool) {
+	if len(noticeContent) == 0 {
+		return nil, false
 	}
+
+	if _, isWireErr := err.(*jsonrpc.Error); isWireErr {
+		return nil, false
+	}
+
+	result := &mcp.CallToolResult{}
+	result.SetError(err)
+	result.Content = append(result.Content, noticeContent...)
+	return result, true
 }
 
 func (_ ToolWithStructuredContentOutput[_, ToolOutput]) GetOutputSchema() (any, error) {
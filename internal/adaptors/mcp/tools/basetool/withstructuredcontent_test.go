@@ -4,6 +4,8 @@ package basetool_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
@@ -33,6 +35,8 @@ const (
 	testToolDescription = "A test tool for unit testing"
 )
 
+type testContextKey struct{}
+
 func TestNewToolWithStructuredContent_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -164,6 +168,56 @@ func TestToolWithStructuredContentOutput_Handler_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedOutput, output, "Output should match expected output")
 }
 
+func TestToolWithStructuredContentOutput_Handler_AppendsNotices(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedOutput := TestOutput{Result: "processed: test message"}
+	const expectedNotice = "MATLAB was restarted"
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		entities.ToolCallNoticeRecorderFromContext(ctx).AddNotice(expectedNotice)
+		return TestOutput{Result: "processed: " + input.Message}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	expectedOutputJSON, err := json.Marshal(expectedOutput)
+	require.NoError(t, err)
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedOutput, output, "Output should match expected output")
+	require.NotNil(t, result, "Result should carry the notices")
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: string(expectedOutputJSON)},
+		&mcp.TextContent{Text: expectedNotice},
+	}, result.Content, "Notice should follow the serialized output")
+}
+
 func TestToolWithStructuredContentOutput_Handler_StructuredHandlerError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -205,6 +259,99 @@ func TestToolWithStructuredContentOutput_Handler_StructuredHandlerError(t *testi
 	assert.Empty(t, output, "Output should be zero value when error occurs")
 }
 
+func TestToolWithStructuredContentOutput_Handler_StructuredHandlerErrorWithNotices(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedError := assert.AnError
+	const expectedNotice = "MATLAB was restarted"
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		entities.ToolCallNoticeRecorderFromContext(ctx).AddNotice(expectedNotice)
+		return TestOutput{}, expectedError
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "The error should be reported as a tool result carrying the notices")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	assert.ErrorIs(t, result.GetError(), expectedError)
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: expectedError.Error()},
+		&mcp.TextContent{Text: expectedNotice},
+	}, result.Content, "Notice should follow the error")
+	assert.Empty(t, output, "Output should be zero value when error occurs")
+}
+
+func TestToolWithStructuredContentOutput_Handler_EvaluationCancelled(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedOutput := TestOutput{Result: ""}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		return expectedOutput, fmt.Errorf("failed to run code: %w", entities.ErrEvaluationCancelled)
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A cancelled evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 1)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "cancelled")
+	assert.Equal(t, expectedOutput, output)
+}
+
 func TestToolWithStructuredContentOutput_Handler_NewMCPSessionLoggerError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -255,6 +402,7 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 	expectedOutput := TestOutput{Result: "success"}
 	mockSessionLogger := testutils.NewInspectableLogger()
 	var capturedContext context.Context
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test value")
 
 	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
 		capturedContext = ctx
@@ -277,14 +425,18 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"progressToken": "progress-token"},
+		},
 	}
 
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), capturedContext, "Context should be propagated to handler")
+	assert.Equal(t, "test value", capturedContext.Value(testContextKey{}), "Context should be propagated to handler")
+	assert.NotNil(t, entities.EvalProgressReporterFromContext(capturedContext), "Context should carry a progress reporter")
 }
 
 func TestToolWithStructuredContent_Annotations(t *testing.T) {
@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/toolnotices"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -85,11 +87,25 @@ func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerF
 			return nil, nil, err
 		}
 
+		ctx = progressreporter.ContextForToolCall(ctx, req, logger)
+		ctx, notices := toolnotices.ContextForToolCall(ctx)
+
 		richContent, err := t.unstructuredContentHandler(ctx, logger, input)
+		if result, interrupted := InterruptedEvaluationResult(err); interrupted {
+			logger.WithError(err).Info("MATLAB evaluation was interrupted")
+			result.Content = append(result.Content, notices.Content()...)
+			return result, nil, nil
+		}
 		if err != nil {
 			logger.WithError(err).Warn("Unstructured handler returned an error")
+			if result, hasNotices := errorResultWithNotices(err, notices.Content()); hasNotices {
+				return result, nil, nil
+			}
 			return nil, nil, err
 		}
-		return responseconverter.ConvertRichContentToCallToolResult(richContent), nil, nil
+
+		result := responseconverter.ConvertRichContentToCallToolResult(richContent)
+		result.Content = append(result.Content, notices.Content()...)
+		return result, nil, nil
 	}
 }
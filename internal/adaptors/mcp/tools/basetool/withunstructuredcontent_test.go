@@ -5,6 +5,7 @@ package basetool_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
@@ -170,6 +171,52 @@ func TestToolWithUnstructuredContentOutput_Handler_HappyPath(t *testing.T) {
 	assert.Equal(t, []byte(expectedRichContent.ImageContent[0]), imageContent.Data, "Image data should match")
 }
 
+func TestToolWithUnstructuredContentOutput_Handler_AppendsNotices(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	const expectedNotice = "MATLAB was restarted"
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		entities.ToolCallNoticeRecorderFromContext(ctx).AddNotice(expectedNotice)
+		return tools.RichContent{TextContent: []string{"text response"}}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	require.NotNil(t, result, "Result should not be nil")
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: "text response"},
+		&mcp.TextContent{Text: expectedNotice},
+	}, result.Content, "Notice should follow the tool content")
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_TextContentOnly(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -368,6 +415,286 @@ func TestToolWithUnstructuredContentOutput_Handler_UnstructuredHandlerError(t *t
 	assert.Nil(t, output, "Output should be nil when error occurs")
 }
 
+func TestToolWithUnstructuredContentOutput_Handler_EvaluationCancelled(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, entities.ErrEvaluationCancelled
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A cancelled evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 1)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "cancelled")
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "MATLAB execution was not interrupted", "MATLAB should not be said to be interrupted when no interrupt was sent")
+	assert.Equal(t, basetool.InterruptedEvaluation{Cancelled: true}, result.StructuredContent)
+	assert.Nil(t, output)
+	assert.Contains(t, mockSessionLogger.InfoLogs(), "MATLAB evaluation was interrupted")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_UnstructuredHandlerErrorWithNotices(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedError := assert.AnError
+	const expectedNotice = "MATLAB was restarted"
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		entities.ToolCallNoticeRecorderFromContext(ctx).AddNotice(expectedNotice)
+		return tools.RichContent{}, expectedError
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "The error should be reported as a tool result carrying the notices")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: expectedError.Error()},
+		&mcp.TextContent{Text: expectedNotice},
+	}, result.Content, "Notice should follow the error")
+	assert.Nil(t, output)
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_EvaluationCancelledWithNotices(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	const expectedNotice = "MATLAB was restarted"
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		entities.ToolCallNoticeRecorderFromContext(ctx).AddNotice(expectedNotice)
+		return tools.RichContent{}, entities.ErrEvaluationCancelled
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 2)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "cancelled")
+	assert.Equal(t, &mcp.TextContent{Text: expectedNotice}, result.Content[1], "Notice should follow the interruption message")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_EvaluationCancelledAndInterrupted(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, &entities.EvalCancelledError{Interrupted: true}
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A cancelled evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 1)
+	assert.Equal(t, "The tool call was cancelled, and MATLAB execution was interrupted.", result.Content[0].(*mcp.TextContent).Text)
+	assert.Equal(t, basetool.InterruptedEvaluation{Cancelled: true}, result.StructuredContent)
+	assert.Nil(t, output)
+	assert.Contains(t, mockSessionLogger.InfoLogs(), "MATLAB evaluation was interrupted")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_EvaluationTimedOut(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, &entities.EvalTimeoutError{
+			Timeout:         30 * time.Second,
+			PartialResponse: entities.EvalResponse{ConsoleOutput: "iteration 1", Images: [][]byte{[]byte("png")}},
+		}
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A timed out evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 3)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "timed out after 30s")
+	assert.Equal(t, &mcp.TextContent{Text: "iteration 1"}, result.Content[1])
+	assert.Equal(t, &mcp.ImageContent{MIMEType: "image/png", Data: []byte("png")}, result.Content[2])
+	assert.Equal(t, basetool.InterruptedEvaluation{
+		TimedOut:       true,
+		TimeoutSeconds: 30,
+		PartialOutput:  "iteration 1",
+	}, result.StructuredContent)
+	assert.Nil(t, output)
+	assert.Contains(t, mockSessionLogger.InfoLogs(), "MATLAB evaluation was interrupted")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_EvaluationTimedOutBeforeStarting(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, &entities.EvalTimeoutError{Timeout: 30 * time.Second, NotStarted: true}
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A timed out evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError, "Result should be an error result")
+	require.Len(t, result.Content, 1)
+	assert.Equal(t, &mcp.TextContent{Text: "MATLAB execution timed out after 30s while waiting for MATLAB, so the code did not run."}, result.Content[0])
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_NewMCPSessionLoggerError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -420,6 +747,7 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 	}
 	mockSessionLogger := testutils.NewInspectableLogger()
 
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test value")
 	contextReceived := make(chan context.Context, 1) // Buffering to avoid deadlock
 	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
 		contextReceived <- ctx
@@ -442,14 +770,19 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"progressToken": "progress-token"},
+		},
 	}
 
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), <-contextReceived, "Context should be propagated to handler")
+	capturedContext := <-contextReceived
+	assert.Equal(t, "test value", capturedContext.Value(testContextKey{}), "Context should be propagated to handler")
+	assert.NotNil(t, entities.EvalProgressReporterFromContext(capturedContext), "Context should carry a progress reporter")
 }
 
 func TestToolWithUnstructuredContent_Annotations(t *testing.T) {
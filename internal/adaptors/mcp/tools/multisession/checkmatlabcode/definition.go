@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package checkmatlabcode
+
+import checkmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+
+const (
+	name        = "check_matlab_code_in_matlab_session"
+	title       = "Check MATLAB Code in a MATLAB Session"
+	description = "Perform static code analysis on MATLAB code using MATLAB's built-in Code Analyzer function in an existing MATLAB session, given its session ID (`session_id`). Analyze a single script (`script_path`), or several files and folders at once (`paths`); folders are analyzed recursively. Returns warnings about coding style, potential errors, deprecated functions, performance issues, and best practice violations, grouped by file, together with severity counts. Use `configuration_file` to choose a Code Analyzer configuration other than the active one. Checking the same code in sessions of different MATLAB releases shows how the analysis differs between releases. This is a non-destructive, read-only operation that does not execute the code."
+)
+
+// Args holds the arguments of the single-session tool, together with the session to run it in.
+type Args struct {
+	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session in which to analyze the code."`
+	checkmatlabcodesinglesession.Args
+}
+
+type ReturnArgs = checkmatlabcodesinglesession.ReturnArgs
@@ -0,0 +1,46 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package checkmatlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	checkmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request checkmatlabcode.Args) (checkmatlabcode.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	matlabManager entities.MATLABManager,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, matlabManager)),
+	}
+}
+
+// Handler runs the single-session tool in the MATLAB session given by the session_id argument.
+func Handler(usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionID := entities.SessionID(inputs.SessionID)
+
+		sessionLogger = sessionLogger.With("session_id", sessionID)
+
+		sessionLogger.Info("Executing Check MATLAB code in MATLAB Session tool")
+		defer sessionLogger.Info("Done - Executing Check MATLAB code in MATLAB Session tool")
+
+		return checkmatlabcodesinglesession.Run(ctx, sessionLogger, usecase, sessionclient.ForSession(matlabManager, sessionID), inputs.Args)
+	}
+}
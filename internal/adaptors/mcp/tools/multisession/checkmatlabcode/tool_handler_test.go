@@ -0,0 +1,170 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package checkmatlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/checkmatlabcode"
+	checkmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	checkmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/checkmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const scriptPath = "/path/to/script.m"
+	usecaseResponse := checkmatlabcodeusecase.ReturnArgs{
+		Files: []checkmatlabcodeusecase.FileCodeIssues{
+			{
+				File: scriptPath,
+				CodeIssues: []checkmatlabcodeusecase.CodeIssue{
+					{
+						File:        scriptPath,
+						Description: "Warning message",
+						Line:        1,
+						StartColumn: 1,
+						EndColumn:   10,
+						Severity:    "warning",
+					},
+				},
+				SeverityCounts: map[string]int{"warning": 1},
+			},
+		},
+		SeverityCounts:    map[string]int{"warning": 1},
+		AnalyzedFileCount: 1,
+	}
+	expectedResult := checkmatlabcode.ReturnArgs{
+		Files: []checkmatlabcodesinglesession.FileCodeIssues{
+			{
+				File:           scriptPath,
+				SeverityCounts: map[string]int{"warning": 1},
+				CodeIssues: []checkmatlabcodesinglesession.CodeIssue{
+					{
+						Description: "Warning message",
+						Line:        1,
+						StartColumn: 1,
+						EndColumn:   10,
+						Severity:    "warning",
+					},
+				},
+			},
+		},
+		SeverityCounts:    map[string]int{"warning": 1},
+		AnalyzedFileCount: 1,
+	}
+	args := checkmatlabcode.Args{
+		SessionID: sessionID,
+		Args:      checkmatlabcodesinglesession.Args{ScriptPath: scriptPath},
+	}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkmatlabcodeusecase.Args{ScriptPath: scriptPath}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := checkmatlabcode.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match")
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const scriptPath = "/path/to/script.m"
+	expectedError := assert.AnError
+	args := checkmatlabcode.Args{
+		SessionID: sessionID,
+		Args:      checkmatlabcodesinglesession.Args{ScriptPath: scriptPath},
+	}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := checkmatlabcode.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Files, "Files should not be nil")
+	assert.Empty(t, result.Files, "Files should be empty on error")
+	assert.NotNil(t, result.SeverityCounts, "Severity counts should not be nil")
+}
+
+func TestCheckMATLABCodeInMATLABSession_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewReadOnlyAnnotations()
+
+	// Act
+	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
+}
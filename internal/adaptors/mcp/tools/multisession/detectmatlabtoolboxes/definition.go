@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package detectmatlabtoolboxes
+
+import detectmatlabtoolboxessinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
+
+const (
+	name        = "detect_matlab_toolboxes_in_matlab_session"
+	title       = "Detect MATLAB Toolboxes in a MATLAB Session"
+	description = "Returns information about the MATLAB installation and toolboxes, including version numbers, of an existing MATLAB session, given its session ID (`session_id`)."
+)
+
+type Args struct {
+	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session whose installation to inspect."`
+}
+
+type ReturnArgs = detectmatlabtoolboxessinglesession.ReturnArgs
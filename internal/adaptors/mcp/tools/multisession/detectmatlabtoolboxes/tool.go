@@ -0,0 +1,46 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package detectmatlabtoolboxes
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	detectmatlabtoolboxessinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (detectmatlabtoolboxes.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	matlabManager entities.MATLABManager,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, matlabManager)),
+	}
+}
+
+// Handler runs the single-session tool in the MATLAB session given by the session_id argument.
+func Handler(usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionID := entities.SessionID(inputs.SessionID)
+
+		sessionLogger = sessionLogger.With("session_id", sessionID)
+
+		sessionLogger.Info("Executing detect MATLAB toolboxes in MATLAB Session tool")
+		defer sessionLogger.Info("Done - Executing detect MATLAB toolboxes in MATLAB Session tool")
+
+		return detectmatlabtoolboxessinglesession.Run(ctx, sessionLogger, usecase, sessionclient.ForSession(matlabManager, sessionID))
+	}
+}
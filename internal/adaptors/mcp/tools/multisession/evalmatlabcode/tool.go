@@ -9,6 +9,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/evaltimeout"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
@@ -52,6 +53,11 @@ func Handler(configFactory ConfigFactory, usecase Usecase, matlabManager entitie
 			return tools.RichContent{}, messagesErr
 		}
 
+		timeout, err := evaltimeout.Resolve(inputs.TimeoutSeconds, config.DefaultEvalTimeout())
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
 		client, err := matlabManager.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
 		if err != nil {
 			return tools.RichContent{}, err
@@ -61,6 +67,7 @@ func Handler(configFactory ConfigFactory, usecase Usecase, matlabManager entitie
 			Code:          inputs.Code,
 			ProjectPath:   inputs.ProjectPath,
 			CaptureOutput: !config.ShouldShowMATLABDesktop(),
+			Timeout:       timeout,
 		})
 		if err != nil {
 			return tools.RichContent{}, err
@@ -4,6 +4,7 @@ package evalmatlabcode_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
@@ -78,6 +79,11 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -150,6 +156,11 @@ func TestTool_Handler_GetMATLABSessionClientErrors(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockMATLABManager.EXPECT().
 		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
 		Return(nil, expectedError).
@@ -198,6 +209,11 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -268,6 +284,11 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -336,6 +357,189 @@ func TestTool_Handler_ConfigError(t *testing.T) {
 	assert.Empty(t, result, "Result should be empty in an error case")
 }
 
+func TestTool_Handler_TimeoutSecondsOverridesDefault(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const code = "for i = 1:10, pause(1), end"
+	const projectPath = "/some/path"
+	const expectedTimeout = 30 * time.Second
+	args := evalmatlabcode.Args{
+		SessionID:      sessionID,
+		Code:           code,
+		ProjectPath:    projectPath,
+		TimeoutSeconds: 30,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalmatlabcodeusecase.Args{
+				Code:          code,
+				ProjectPath:   projectPath,
+				CaptureOutput: true,
+				Timeout:       expectedTimeout,
+			},
+		).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := evalmatlabcode.Handler(mockConfigFactory, mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_UsesDefaultEvalTimeout(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const code = "for i = 1:10, pause(1), end"
+	const projectPath = "/some/path"
+	const expectedTimeout = 2 * time.Minute
+	args := evalmatlabcode.Args{
+		SessionID:   sessionID,
+		Code:        code,
+		ProjectPath: projectPath,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(expectedTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalmatlabcodeusecase.Args{
+				Code:          code,
+				ProjectPath:   projectPath,
+				CaptureOutput: true,
+				Timeout:       expectedTimeout,
+			},
+		).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := evalmatlabcode.Handler(mockConfigFactory, mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_NegativeTimeoutSeconds(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		SessionID:      123,
+		Code:           "disp(1)",
+		TimeoutSeconds: -1,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockConfigFactory, mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorContains(t, err, "timeout_seconds must not be negative")
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
+
 func TestEvalInMATLABSession_Annotations(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
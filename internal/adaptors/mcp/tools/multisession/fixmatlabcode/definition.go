@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode
+
+import fixmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+
+const (
+	name        = "fix_matlab_code_in_matlab_session"
+	title       = "Fix MATLAB Code in a MATLAB Session"
+	description = "Apply the automatic fixes suggested by MATLAB's Code Analyzer to a MATLAB script (`script_path`) in an existing MATLAB session, given its session ID (`session_id`), and return a unified diff of the file before and after the fixes. By default every issue that `check_matlab_code_in_matlab_session` reports as fixable is fixed; specify `check_ids` to fix only issues with those Code Analyzer check IDs. The file is modified in place. Requires MATLAB R2023a or later."
+)
+
+// Args holds the arguments of the single-session tool, together with the session to run it in.
+type Args struct {
+	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session in which to fix the code."`
+	fixmatlabcodesinglesession.Args
+}
+
+type ReturnArgs = fixmatlabcodesinglesession.ReturnArgs
@@ -0,0 +1,131 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/fixmatlabcode"
+	fixmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	fixmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/fixmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	tool := fixmatlabcode.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const scriptPath = "/path/to/script.m"
+	const diff = "--- /path/to/script.m\n+++ /path/to/script.m\n@@ -1 +1 @@\n-x = 1\n+x = 1;\n"
+	checkIDs := []string{"NOPRT"}
+	args := fixmatlabcode.Args{
+		SessionID: sessionID,
+		Args:      fixmatlabcodesinglesession.Args{ScriptPath: scriptPath, CheckIDs: checkIDs},
+	}
+	expectedResult := fixmatlabcode.ReturnArgs{
+		FixedIssueCount: 1,
+		Diff:            diff,
+	}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, fixmatlabcodeusecase.Args{ScriptPath: scriptPath, CheckIDs: checkIDs}).
+		Return(fixmatlabcodeusecase.ReturnArgs{FixedIssueCount: 1, Diff: diff}, nil).
+		Once()
+
+	// Act
+	result, err := fixmatlabcode.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match")
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	expectedError := assert.AnError
+	args := fixmatlabcode.Args{
+		SessionID: sessionID,
+		Args:      fixmatlabcodesinglesession.Args{ScriptPath: "/path/to/script.m"},
+	}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := fixmatlabcode.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestFixMATLABCodeInMATLABSession_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	// Act
+	tool := fixmatlabcode.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog
+
+import getmatlabsessionlogsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+
+const (
+	name        = "get_matlab_session_log"
+	title       = "Get MATLAB Session Log"
+	description = "Returns the last lines of the output (`stdout`) and errors (`stderr`) of a MATLAB session started by this server, given its session ID (`session_id`), " +
+		"along with the startup error (`startup_error`) and the crash dumps (`crash_dumps`) written by MATLAB, if any. " +
+		"Use it to find out why a MATLAB session failed or stopped responding."
+)
+
+type Args struct {
+	SessionID int `json:"session_id"          jsonschema:"The ID of the MATLAB session."`
+	MaxLines  int `json:"max_lines,omitempty" jsonschema:"(Optional) The number of lines of output and errors to return. Defaults to 100."`
+}
+
+type ReturnArgs = getmatlabsessionlogsinglesession.ReturnArgs
+
+type CrashDump = getmatlabsessionlogsinglesession.CrashDump
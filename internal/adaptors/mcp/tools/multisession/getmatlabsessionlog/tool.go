@@ -0,0 +1,40 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	getmatlabsessionlogsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, args getmatlabsessionlog.Args) (getmatlabsessionlog.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase)),
+	}
+}
+
+// Handler returns the log of the MATLAB session given by the session_id argument.
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing get MATLAB session log tool")
+		defer sessionLogger.Info("Done - Executing get MATLAB session log tool")
+
+		return getmatlabsessionlogsinglesession.Run(ctx, sessionLogger, usecase, entities.SessionID(inputs.SessionID), inputs.MaxLines)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/getmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getmatlabsessionlogusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/getmatlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := getmatlabsessionlog.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(3), MaxLines: 50}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{
+			Stdout:       "MATLAB is starting",
+			Stderr:       "Warning: low memory",
+			StartupError: "Failed to start connector",
+			CrashDumps: []entities.MATLABCrashDump{
+				{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase)(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: 3, MaxLines: 50})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabsessionlog.ReturnArgs{
+		SessionID:    3,
+		Stdout:       "MATLAB is starting",
+		Stderr:       "Warning: low memory",
+		StartupError: "Failed to start connector",
+		CrashDumps: []getmatlabsessionlog.CrashDump{
+			{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+		},
+	}, result)
+}
+
+func TestTool_Handler_NoCrashDumps(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(3)}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{Stdout: "MATLAB is ready"}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase)(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: 3})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.CrashDumps, "CrashDumps should be an empty list rather than null")
+	assert.Empty(t, result.CrashDumps)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(3)}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase)(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: 3})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestGetMATLABSessionLog_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := getmatlabsessionlog.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.Equal(t, annotations.NewReadOnlyAnnotations(), tool.Annotations(), "Tool should have read-only annotations")
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabsessions
+
+const (
+	name        = "list_matlab_sessions"
+	title       = "List MATLAB Sessions"
+	description = "List the MATLAB sessions managed by this server, with their session ID (`session_id`), MATLAB root and version, process ID, start time, last used time, whether they are busy and whether they respond. Use a `session_id` from this list with the other tools."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Sessions []Session `json:"sessions" jsonschema:"The MATLAB sessions managed by this server, ordered by session ID."`
+}
+
+type Session struct {
+	SessionID  int    `json:"session_id"             jsonschema:"The ID of the MATLAB session."`
+	MATLABRoot string `json:"matlab_root,omitempty"  jsonschema:"The MATLAB root folder of the session. Empty for sessions that were attached to."`
+	Version    string `json:"version,omitempty"      jsonschema:"The MATLAB release, for example R2026a."`
+	PID        int    `json:"pid,omitempty"          jsonschema:"The process ID of the MATLAB session."`
+	StartedAt  string `json:"started_at"             jsonschema:"When the session was started or attached to, in RFC 3339 format."`
+	LastUsedAt string `json:"last_used_at,omitempty" jsonschema:"When the session last ran code, in RFC 3339 format. Empty if the session has not been used."`
+	State      string `json:"state"                  jsonschema:"Either busy, when the session is running code, or idle."`
+	IsAlive    bool   `json:"is_alive"               jsonschema:"Whether the session responded to a liveness check. Busy sessions are not checked, and are reported as alive."`
+}
+
+const (
+	stateBusy = "busy"
+	stateIdle = "idle"
+)
@@ -0,0 +1,75 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) (listmatlabsessions.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing list MATLAB sessions tool")
+		defer sessionLogger.Info("Done - Executing list MATLAB sessions tool")
+
+		sessions, err := usecase.Execute(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Sessions: convertToAnnotatedEquivalentType(sessions),
+		}, nil
+	}
+}
+
+func convertToAnnotatedEquivalentType(sessions listmatlabsessions.ReturnArgs) []Session {
+	convertedSessions := make([]Session, len(sessions))
+	for i, session := range sessions {
+		state := stateIdle
+		if session.IsBusy {
+			state = stateBusy
+		}
+
+		convertedSessions[i] = Session{
+			SessionID:  int(session.SessionID),
+			MATLABRoot: session.MATLABRoot,
+			Version:    session.Version,
+			PID:        session.ProcessID,
+			StartedAt:  formatTime(session.StartedAt),
+			LastUsedAt: formatTime(session.LastUsedAt),
+			State:      state,
+			IsAlive:    session.IsAlive,
+		}
+	}
+	return convertedSessions
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	listmatlabsessionsusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := listmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2026a")
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(listmatlabsessionsusecase.ReturnArgs{
+			{
+				SessionID:  1,
+				MATLABRoot: matlabRoot,
+				Version:    "R2026a",
+				ProcessID:  1234,
+				StartedAt:  time.Unix(1767225600, 0),
+				LastUsedAt: time.Unix(1767225660, 0),
+				IsBusy:     true,
+				IsAlive:    true,
+			},
+			{
+				SessionID: 2,
+				Version:   "R2025b",
+				ProcessID: 5678,
+				StartedAt: time.Unix(1767225600, 0),
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []listmatlabsessions.Session{
+		{SessionID: 1, MATLABRoot: matlabRoot, Version: "R2026a", PID: 1234, StartedAt: "2026-01-01T00:00:00Z", LastUsedAt: "2026-01-01T00:01:00Z", State: "busy", IsAlive: true},
+		{SessionID: 2, Version: "R2025b", PID: 5678, StartedAt: "2026-01-01T00:00:00Z", State: "idle", IsAlive: false},
+	}, result.Sessions)
+	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be created")
+}
+
+func TestTool_Handler_NoSessions(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(nil, nil).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Sessions, "Sessions should be an empty list rather than null")
+	assert.Empty(t, result.Sessions)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result.Sessions)
+}
+
+func TestListMATLABSessions_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := listmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.Equal(t, annotations.NewReadOnlyAnnotations(), tool.Annotations(), "Tool should have read-only annotations")
+}
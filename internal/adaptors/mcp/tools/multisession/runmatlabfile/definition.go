@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabfile
+
+import runmatlabfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+
+const (
+	name        = "run_matlab_file_in_matlab_session"
+	title       = "Run MATLAB File in a MATLAB Session"
+	description = "Execute a MATLAB script file (`script_path`) in an existing MATLAB session, given its session ID (`session_id`), and capture its command window output. The script runs with the working folder automatically set to the script's location. The script must exist and be a valid .m file. Returns the command window output or a success message if no output is generated."
+)
+
+// Args holds the arguments of the single-session tool, together with the session to run it in.
+type Args struct {
+	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session in which to run the script."`
+	runmatlabfilesinglesession.Args
+}
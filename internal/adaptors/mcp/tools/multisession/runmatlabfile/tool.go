@@ -0,0 +1,54 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabfile
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	runmatlabfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabfile.Args) (entities.EvalResponse, error)
+}
+
+type Tool struct {
+	basetool.ToolWithUnstructuredContentOutput[Args]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
+	usecase Usecase,
+	matlabManager entities.MATLABManager,
+) *Tool {
+	return &Tool{
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, matlabManager)),
+	}
+}
+
+// Handler runs the single-session tool in the MATLAB session given by the session_id argument.
+func Handler(configFactory ConfigFactory, usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithUnstructuredContentOutput[Args] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
+		sessionID := entities.SessionID(inputs.SessionID)
+
+		sessionLogger = sessionLogger.With("session_id", sessionID)
+
+		sessionLogger.Info("Executing Run MATLAB File in MATLAB Session tool")
+		defer sessionLogger.Info("Done - Executing Run MATLAB File in MATLAB Session tool")
+
+		return runmatlabfilesinglesession.Run(ctx, sessionLogger, configFactory, usecase, sessionclient.ForSession(matlabManager, sessionID), inputs.Args)
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabtestfile
+
+import runmatlabtestfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+
+const (
+	name        = "run_matlab_test_file_in_matlab_session"
+	title       = "Run MATLAB Test File in a MATLAB Session"
+	description = "Run MATLAB unit tests using the MATLAB testing framework in an existing MATLAB session, given its session ID (`session_id`), and return structured test results. Specify exactly one of a test file (`script_path`), a test folder (`test_folder`, including subfolders), or a test class or namespace on the MATLAB path (`test_suite`). Optionally narrow the tests by procedure name (`procedure_names`) and by tag (`include_tags`, `exclude_tags`), run them in parallel (`use_parallel`), fail tests that issue warnings (`strict`), measure code coverage of source folders (`coverage_folders`), and write JUnit and Cobertura XML reports to a folder (`artifacts_folder`). Returns a summary of passed, failed and incomplete tests, the status and duration of each test, the diagnostics of every failure, including the file and line where it occurred, per-file code coverage, and the console output of the test run."
+)
+
+// Args holds the arguments of the single-session tool, together with the session to run it in.
+type Args struct {
+	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session in which to run the tests."`
+	runmatlabtestfilesinglesession.Args
+}
+
+type ReturnArgs = runmatlabtestfilesinglesession.ReturnArgs
@@ -0,0 +1,201 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabtestfile_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabtestfile"
+	runmatlabtestfilesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	runmatlabtestfileusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/runmatlabtestfile"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const scriptPath = "/some/script/tofile/testFile.m"
+	usecaseResponse := runmatlabtestfileusecase.ReturnArgs{
+		Summary: runmatlabtestfileusecase.TestSummary{
+			Total:    1,
+			Passed:   1,
+			Duration: 500 * time.Millisecond,
+		},
+		Tests: []runmatlabtestfileusecase.TestResult{
+			{
+				Name:        "testFile/testAddition",
+				Status:      runmatlabtestfileusecase.TestStatusPassed,
+				Duration:    500 * time.Millisecond,
+				Diagnostics: []runmatlabtestfileusecase.TestDiagnostic{},
+			},
+		},
+		ConsoleOutput: "Running testFile\n.\nDone testFile",
+	}
+	expectedResult := runmatlabtestfile.ReturnArgs{
+		Summary: runmatlabtestfilesinglesession.TestSummary{
+			Total:           1,
+			Passed:          1,
+			DurationSeconds: 0.5,
+		},
+		Tests: []runmatlabtestfilesinglesession.TestResult{
+			{
+				Name:            "testFile/testAddition",
+				Status:          "passed",
+				DurationSeconds: 0.5,
+				Diagnostics:     []runmatlabtestfilesinglesession.TestDiagnostic{},
+			},
+		},
+		Coverage:      []runmatlabtestfilesinglesession.FileCoverage{},
+		ConsoleOutput: "Running testFile\n.\nDone testFile",
+	}
+	args := runmatlabtestfile.Args{SessionID: sessionID, Args: runmatlabtestfilesinglesession.Args{ScriptPath: scriptPath}}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{ScriptPath: scriptPath},
+		).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match expected value")
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 123
+	const scriptPath = "/some/path"
+	expectedError := assert.AnError
+	args := runmatlabtestfile.Args{SessionID: sessionID, Args: runmatlabtestfilesinglesession.Args{ScriptPath: scriptPath}}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{Tests: []runmatlabtestfilesinglesession.TestResult{}, Coverage: []runmatlabtestfilesinglesession.FileCoverage{}}, result, "Result should be empty in an error case")
+}
+
+func TestRunMATLABTestFileInMATLABSession_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	// Act
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
+}
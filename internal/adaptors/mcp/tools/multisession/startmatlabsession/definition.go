@@ -5,11 +5,23 @@ package startmatlabsession
 const (
 	name        = "start_matlab_session"
 	title       = "Start MATLAB Session"
-	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`)."
+	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`). " +
+		"Optionally sets the starting folder (`starting_folder`), the display mode (`display_mode`), a script to run once the session is ready (`startup_script`), " +
+		"extra environment variables (`environment_variables`) and extra MATLAB command line flags (`matlab_flags`)."
+)
+
+const (
+	displayModeDesktop   = "desktop"
+	displayModeNoDesktop = "nodesktop"
 )
 
 type Args struct {
-	MATLABRoot string `json:"matlab_root" jsonschema:"MATLAB root folder for session."`
+	MATLABRoot           string            `json:"matlab_root"                     jsonschema:"MATLAB root folder for session."`
+	StartingFolder       string            `json:"starting_folder,omitempty"       jsonschema:"(Optional) The full absolute path to the folder MATLAB starts in. Example: C:\\Users\\username\\project or /home/user/project."`
+	DisplayMode          string            `json:"display_mode,omitempty"          jsonschema:"(Optional) Either desktop, to show the MATLAB desktop, or nodesktop. Defaults to the server configuration."`
+	StartupScript        string            `json:"startup_script,omitempty"        jsonschema:"(Optional) The full absolute path to a MATLAB script (.m) to run once the session is initialized."`
+	EnvironmentVariables map[string]string `json:"environment_variables,omitempty" jsonschema:"(Optional) Environment variables to set in the MATLAB process, in addition to the environment of the server. Example: {\"MY_DATA\": \"/data\"}."`
+	MATLABFlags          []string          `json:"matlab_flags,omitempty"          jsonschema:"(Optional) Extra MATLAB command line flags. Supported flags are -softwareopengl, -nosoftwareopengl, -singleCompThread, -noFigureWindows and -nosplash. They replace the default flags they conflict with."`
 }
 
 type ReturnArgs struct {
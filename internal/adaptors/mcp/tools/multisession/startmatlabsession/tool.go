@@ -4,6 +4,7 @@ package startmatlabsession
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
@@ -45,10 +46,19 @@ func Handler(configFactory ConfigFactory, usecase Usecase) basetool.HandlerWithS
 			return ReturnArgs{}, messagesErr
 		}
 
+		showMATLABDesktop, err := shouldShowMATLABDesktop(inputs.DisplayMode, config.ShouldShowMATLABDesktop())
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
 		startSessionRequest := entities.LocalSessionDetails{
 			MATLABRoot:             inputs.MATLABRoot,
-			IsStartingDirectorySet: false,
-			ShowMATLABDesktop:      config.ShouldShowMATLABDesktop(),
+			IsStartingDirectorySet: inputs.StartingFolder != "",
+			StartingDirectory:      inputs.StartingFolder,
+			ShowMATLABDesktop:      showMATLABDesktop,
+			StartupScript:          inputs.StartupScript,
+			EnvironmentVariables:   inputs.EnvironmentVariables,
+			AdditionalFlags:        inputs.MATLABFlags,
 		}
 
 		response, err := usecase.Execute(ctx, sessionLogger, startSessionRequest)
@@ -60,6 +70,19 @@ func Handler(configFactory ConfigFactory, usecase Usecase) basetool.HandlerWithS
 	}
 }
 
+func shouldShowMATLABDesktop(displayMode string, defaultValue bool) (bool, error) {
+	switch displayMode {
+	case "":
+		return defaultValue, nil
+	case displayModeDesktop:
+		return true, nil
+	case displayModeNoDesktop:
+		return false, nil
+	default:
+		return false, fmt.Errorf("display_mode must be %q or %q, got %q", displayModeDesktop, displayModeNoDesktop, displayMode)
+	}
+}
+
 func convertToAnnotatedEquivalentType(response startmatlabsession.ReturnArgs) ReturnArgs {
 	return ReturnArgs{
 		ResponseText: responseTextIfMATLABSessionStartedSuccesfully,
@@ -251,3 +251,101 @@ func TestStartMATLABSession_Annotations(t *testing.T) {
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
 }
+
+func TestTool_Handler_WithSessionOptions(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	matlabRoot := filepath.Join("path", "to", "matlab")
+	startingFolder := filepath.Join("home", "user", "project")
+	startupScript := filepath.Join("home", "user", "project", "setup.m")
+	environmentVariables := map[string]string{"MY_DATA": "/data"}
+	matlabFlags := []string{"-softwareopengl", "-singleCompThread"}
+	expectedSessionID := entities.SessionID(123)
+
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:             matlabRoot,
+		IsStartingDirectorySet: true,
+		StartingDirectory:      startingFolder,
+		ShowMATLABDesktop:      false,
+		StartupScript:          startupScript,
+		EnvironmentVariables:   environmentVariables,
+		AdditionalFlags:        matlabFlags,
+	}
+	args := startmatlabsession.Args{
+		MATLABRoot:           matlabRoot,
+		StartingFolder:       startingFolder,
+		DisplayMode:          "nodesktop",
+		StartupScript:        startupScript,
+		EnvironmentVariables: environmentVariables,
+		MATLABFlags:          matlabFlags,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(startmatlabsessionusecase.ReturnArgs{SessionID: expectedSessionID}, nil).
+		Once()
+
+	// Act
+	result, err := startmatlabsession.Handler(mockConfigFactory, mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, int(expectedSessionID), result.SessionID, "Session ID should match")
+}
+
+func TestTool_Handler_InvalidDisplayMode(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	args := startmatlabsession.Args{
+		MATLABRoot:  filepath.Join("path", "to", "matlab"),
+		DisplayMode: "minimized",
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true).
+		Once()
+
+	// Act
+	result, err := startmatlabsession.Handler(mockConfigFactory, mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorContains(t, err, "display_mode", "Handler should reject the display mode")
+	assert.Empty(t, result.ResponseText, "Response text should be empty on error")
+}
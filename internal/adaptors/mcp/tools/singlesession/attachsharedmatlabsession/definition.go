@@ -0,0 +1,25 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package attachsharedmatlabsession
+
+const (
+	name        = "attach_to_shared_matlab_session"
+	title       = "Attach to Shared MATLAB Session"
+	description = "Switches the MATLAB session used by the other tools to a shared MATLAB session, given its process ID (`pid`) from `list_shared_matlab_sessions`. The previous MATLAB session is detached from and keeps running."
+)
+
+type Args struct {
+	PID int `json:"pid" jsonschema:"The process ID of the shared MATLAB session to attach to."`
+}
+
+type ReturnArgs struct {
+	ResponseText  string `json:"response_text"  jsonschema:"A message indicating the result of the operation."`
+	PID           int    `json:"pid"            jsonschema:"The process ID of the shared MATLAB session now in use."`
+	Name          string `json:"name,omitempty" jsonschema:"The name given to the session when it was shared."`
+	Release       string `json:"release"        jsonschema:"The MATLAB release, for example R2026a."`
+	WorkingFolder string `json:"working_folder" jsonschema:"The MATLAB current folder at the time the session was shared."`
+}
+
+const (
+	responseTextIfAttachedSuccessfully = "Attached to shared MATLAB session."
+)
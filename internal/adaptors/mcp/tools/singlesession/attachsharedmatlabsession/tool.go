@@ -0,0 +1,51 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package attachsharedmatlabsession
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachsharedmatlabsession"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request attachsharedmatlabsession.Args) (attachsharedmatlabsession.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewNonDestructiveAnnotations(), loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing attach to shared MATLAB session tool")
+		defer sessionLogger.Info("Done - Executing attach to shared MATLAB session tool")
+
+		session, err := usecase.Execute(ctx, sessionLogger, attachsharedmatlabsession.Args{
+			ProcessID: inputs.PID,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			ResponseText:  responseTextIfAttachedSuccessfully,
+			PID:           session.ProcessID,
+			Name:          session.Name,
+			Release:       session.Release,
+			WorkingFolder: session.WorkingFolder,
+		}, nil
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package attachsharedmatlabsession_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	attachsharedmatlabsessionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/attachsharedmatlabsession"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := attachsharedmatlabsession.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const processID = 5678
+	workingFolder := filepath.Join("home", "user", "work")
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), attachsharedmatlabsessionusecase.Args{ProcessID: processID}).
+		Return(attachsharedmatlabsessionusecase.ReturnArgs{ProcessID: processID, Name: "simulation", Release: "R2026a", WorkingFolder: workingFolder}, nil).
+		Once()
+
+	// Act
+	result, err := attachsharedmatlabsession.Handler(mockUsecase)(ctx, mockLogger, attachsharedmatlabsession.Args{PID: processID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, attachsharedmatlabsession.ReturnArgs{
+		ResponseText:  "Attached to shared MATLAB session.",
+		PID:           processID,
+		Name:          "simulation",
+		Release:       "R2026a",
+		WorkingFolder: workingFolder,
+	}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const processID = 5678
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), attachsharedmatlabsessionusecase.Args{ProcessID: processID}).
+		Return(attachsharedmatlabsessionusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := attachsharedmatlabsession.Handler(mockUsecase)(ctx, mockLogger, attachsharedmatlabsession.Args{PID: processID})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestAttachSharedMATLABSession_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := attachsharedmatlabsession.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.Equal(t, annotations.NewNonDestructiveAnnotations(), tool.Annotations(), "Tool should have non-destructive annotations")
+}
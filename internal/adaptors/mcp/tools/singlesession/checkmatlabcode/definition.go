@@ -5,22 +5,34 @@ package checkmatlabcode
 const (
 	name        = "check_matlab_code"
 	title       = "Check MATLAB Code"
-	description = "Perform static code analysis on a MATLAB script (`script_path`) using MATLAB's built-in Code Analyzer function in an existing MATLAB session. Returns warnings about coding style, potential errors, deprecated functions, performance issues, and best practice violations. It also includes information about where each issue occurs and how it can be fixed in MATLAB. This is a non-destructive, read-only operation that helps identify code quality issues without executing the script."
+	description = "Perform static code analysis on MATLAB code using MATLAB's built-in Code Analyzer function in an existing MATLAB session. Analyze a single script (`script_path`), or several files and folders at once (`paths`); folders are analyzed recursively, so you can check a whole project in a single call. Returns warnings about coding style, potential errors, deprecated functions, performance issues, and best practice violations, grouped by file, together with severity counts. It also includes information about where each issue occurs and how it can be fixed in MATLAB. By default, MATLAB applies the active Code Analyzer configuration, including any codeAnalyzerConfiguration.json file in a resources folder of the analyzed code; use `configuration_file` to choose a different one. This is a non-destructive, read-only operation that helps identify code quality issues without executing the code."
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to analyze. Must be a .m file that exists. File is not modified during analysis. Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+	ScriptPath        string   `json:"script_path,omitempty"        jsonschema:"The full absolute path to a MATLAB script file to analyze. Must be a .m file that exists. File is not modified during analysis. Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+	Paths             []string `json:"paths,omitempty"              jsonschema:"Full absolute paths to MATLAB .m files or folders to analyze, in addition to script_path. Folders are analyzed recursively. Example: [\"/home/user/project/src\", \"/home/user/project/main.m\"]."`
+	ConfigurationFile string   `json:"configuration_file,omitempty" jsonschema:"Optional. Full absolute path to a Code Analyzer configuration file (codeAnalyzerConfiguration.json). When omitted, MATLAB uses the active configuration."`
 }
 
 type ReturnArgs struct {
-	CodeIssues []CodeIssue `json:"code_issues" jsonschema:"Detailed information about each code issue including location, severity, and fixability."`
+	Files             []FileCodeIssues `json:"files"               jsonschema:"Code issues grouped by file. Files without any issues are omitted."`
+	SeverityCounts    map[string]int   `json:"severity_counts"     jsonschema:"Number of issues of each severity level across all files."`
+	AnalyzedFileCount int              `json:"analyzed_file_count" jsonschema:"Number of files that Code Analyzer analyzed."`
+	Configuration     string           `json:"configuration"       jsonschema:"Code Analyzer configuration that was applied, when MATLAB reports it."`
+}
+
+type FileCodeIssues struct {
+	File           string         `json:"file"            jsonschema:"Full path of the file."`
+	SeverityCounts map[string]int `json:"severity_counts" jsonschema:"Number of issues of each severity level in this file."`
+	CodeIssues     []CodeIssue    `json:"code_issues"     jsonschema:"Detailed information about each code issue in this file including location, severity, and fixability."`
 }
 
 type CodeIssue struct {
+	CheckID     string `json:"check_id"     jsonschema:"Code Analyzer check ID of the issue (e.g., NASGU). Pass it to the Fix MATLAB Code tool to fix only this kind of issue. Empty on MATLAB releases earlier than R2022b."`
 	Description string `json:"description"  jsonschema:"Description of the code issue."`
 	Line        int    `json:"line"         jsonschema:"Line number where the issue occurs."`
 	StartColumn int    `json:"start_column" jsonschema:"Starting column position of the issue."`
 	EndColumn   int    `json:"end_column"   jsonschema:"Ending column position of the issue."`
 	Severity    string `json:"severity"     jsonschema:"Severity level of the issue (e.g., warning, error)."`
-	Fixable     bool   `json:"fixable"      jsonschema:"Whether the issue can be automatically fixed using MATLAB in-built 'fix' method."`
+	Fixable     bool   `json:"fixable"      jsonschema:"Whether the issue can be automatically fixed using MATLAB in-built 'fix' method, for example with the Fix MATLAB Code tool."`
 }
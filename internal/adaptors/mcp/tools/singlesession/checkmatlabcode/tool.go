@@ -7,6 +7,7 @@ import (
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
 )
@@ -34,31 +35,54 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		sessionLogger.Info("Executing Check MATLAB code tool")
 		defer sessionLogger.Info("Done - Executing Check MATLAB code tool")
 
-		// Not returning nil for empty slices, to comply with MCP spec.
-		mcpCompliantZeroValue := ReturnArgs{
-			CodeIssues: []CodeIssue{},
-		}
+		return Run(ctx, sessionLogger, usecase, globalMATLAB.Client, inputs)
+	}
+}
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
-		if err != nil {
-			return mcpCompliantZeroValue, err
-		}
+// Run checks MATLAB code in the MATLAB session returned by getClient.
+func Run(ctx context.Context, sessionLogger entities.Logger, usecase Usecase, getClient sessionclient.Getter, inputs Args) (ReturnArgs, error) {
+	// Not returning nil for empty slices, to comply with MCP spec.
+	mcpCompliantZeroValue := ReturnArgs{
+		Files:          []FileCodeIssues{},
+		SeverityCounts: map[string]int{},
+	}
 
-		checkcodeResponse, err := usecase.Execute(ctx, sessionLogger, client, checkmatlabcode.Args{
-			ScriptPath: inputs.ScriptPath,
-		})
-		if err != nil {
-			return mcpCompliantZeroValue, err
-		}
+	client, err := getClient(ctx, sessionLogger)
+	if err != nil {
+		return mcpCompliantZeroValue, err
+	}
+
+	checkcodeResponse, err := usecase.Execute(ctx, sessionLogger, client, checkmatlabcode.Args{
+		ScriptPath:        inputs.ScriptPath,
+		Paths:             inputs.Paths,
+		ConfigurationFile: inputs.ConfigurationFile,
+	})
+	if err != nil {
+		return mcpCompliantZeroValue, err
+	}
+
+	return convertReturnArgs(checkcodeResponse), nil
+}
+
+// convertReturnArgs converts the usecase response to our tool response format
+func convertReturnArgs(response checkmatlabcode.ReturnArgs) ReturnArgs {
+	result := ReturnArgs{
+		Files:             make([]FileCodeIssues, len(response.Files)),
+		SeverityCounts:    copySeverityCounts(response.SeverityCounts),
+		AnalyzedFileCount: response.AnalyzedFileCount,
+		Configuration:     response.Configuration,
+	}
 
-		// Convert the usecase response to our tool response format
-		result := ReturnArgs{
-			CodeIssues: make([]CodeIssue, len(checkcodeResponse.CodeIssues)),
+	for i, file := range response.Files {
+		result.Files[i] = FileCodeIssues{
+			File:           file.File,
+			SeverityCounts: copySeverityCounts(file.SeverityCounts),
+			CodeIssues:     make([]CodeIssue, len(file.CodeIssues)),
 		}
 
-		// Copy the code issues from the usecase response
-		for i, issue := range checkcodeResponse.CodeIssues {
-			result.CodeIssues[i] = CodeIssue{
+		for j, issue := range file.CodeIssues {
+			result.Files[i].CodeIssues[j] = CodeIssue{
+				CheckID:     issue.CheckID,
 				Description: issue.Description,
 				Line:        issue.Line,
 				StartColumn: issue.StartColumn,
@@ -67,7 +91,15 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 				Fixable:     issue.Fixable,
 			}
 		}
+	}
+
+	return result
+}
 
-		return result, nil
+func copySeverityCounts(severityCounts map[string]int) map[string]int {
+	result := make(map[string]int, len(severityCounts))
+	for severity, count := range severityCounts {
+		result[severity] = count
 	}
+	return result
 }
@@ -48,47 +48,87 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	const scriptPath = "/path/to/script.m"
-	usecaseCodeIssues := []checkmatlabcodeusecase.CodeIssue{
-		{
-			Description: "Warning message",
-			Line:        1,
-			StartColumn: 1,
-			EndColumn:   10,
-			Severity:    "warning",
-			Fixable:     false,
-		},
-		{
-			Description: "Error message",
-			Line:        3,
-			StartColumn: 5,
-			EndColumn:   15,
-			Severity:    "error",
-			Fixable:     true,
-		},
-	}
+	const folderPath = "/path/to/src"
+	const configurationFile = "/path/to/resources/codeAnalyzerConfiguration.json"
+	const helperPath = "/path/to/src/helper.m"
 	usecaseResponse := checkmatlabcodeusecase.ReturnArgs{
-		CodeIssues: usecaseCodeIssues,
-	}
-	expectedCodeIssues := []checkmatlabcode.CodeIssue{
-		{
-			Description: "Warning message",
-			Line:        1,
-			StartColumn: 1,
-			EndColumn:   10,
-			Severity:    "warning",
-			Fixable:     false,
+		Files: []checkmatlabcodeusecase.FileCodeIssues{
+			{
+				File: scriptPath,
+				CodeIssues: []checkmatlabcodeusecase.CodeIssue{
+					{
+						File:        scriptPath,
+						Description: "Warning message",
+						Line:        1,
+						StartColumn: 1,
+						EndColumn:   10,
+						Severity:    "warning",
+						Fixable:     false,
+					},
+				},
+				SeverityCounts: map[string]int{"warning": 1},
+			},
+			{
+				File: helperPath,
+				CodeIssues: []checkmatlabcodeusecase.CodeIssue{
+					{
+						File:        helperPath,
+						CheckID:     "NOPRT",
+						Description: "Error message",
+						Line:        3,
+						StartColumn: 5,
+						EndColumn:   15,
+						Severity:    "error",
+						Fixable:     true,
+					},
+				},
+				SeverityCounts: map[string]int{"error": 1},
+			},
 		},
-		{
-			Description: "Error message",
-			Line:        3,
-			StartColumn: 5,
-			EndColumn:   15,
-			Severity:    "error",
-			Fixable:     true,
+		SeverityCounts:    map[string]int{"warning": 1, "error": 1},
+		AnalyzedFileCount: 3,
+		Configuration:     configurationFile,
+	}
+	expectedResult := checkmatlabcode.ReturnArgs{
+		Files: []checkmatlabcode.FileCodeIssues{
+			{
+				File:           scriptPath,
+				SeverityCounts: map[string]int{"warning": 1},
+				CodeIssues: []checkmatlabcode.CodeIssue{
+					{
+						Description: "Warning message",
+						Line:        1,
+						StartColumn: 1,
+						EndColumn:   10,
+						Severity:    "warning",
+						Fixable:     false,
+					},
+				},
+			},
+			{
+				File:           helperPath,
+				SeverityCounts: map[string]int{"error": 1},
+				CodeIssues: []checkmatlabcode.CodeIssue{
+					{
+						CheckID:     "NOPRT",
+						Description: "Error message",
+						Line:        3,
+						StartColumn: 5,
+						EndColumn:   15,
+						Severity:    "error",
+						Fixable:     true,
+					},
+				},
+			},
 		},
+		SeverityCounts:    map[string]int{"warning": 1, "error": 1},
+		AnalyzedFileCount: 3,
+		Configuration:     configurationFile,
 	}
 	args := checkmatlabcode.Args{
-		ScriptPath: scriptPath,
+		ScriptPath:        scriptPath,
+		Paths:             []string{folderPath},
+		ConfigurationFile: configurationFile,
 	}
 
 	mockGlobalMATLAB.EXPECT().
@@ -97,7 +137,11 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		Once()
 
 	mockUsecase.EXPECT().
-		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkmatlabcodeusecase.Args{ScriptPath: scriptPath}).
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkmatlabcodeusecase.Args{
+			ScriptPath:        scriptPath,
+			Paths:             []string{folderPath},
+			ConfigurationFile: configurationFile,
+		}).
 		Return(usecaseResponse, nil).
 		Once()
 
@@ -106,7 +150,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, expectedCodeIssues, result.CodeIssues, "Code issues should match")
+	assert.Equal(t, expectedResult, result, "Result should match")
 }
 
 func TestTool_Handler_EmptyOutput(t *testing.T) {
@@ -123,9 +167,8 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	const scriptPath = "/path/to/script.m"
-	var expectedCodeIssues []checkmatlabcodeusecase.CodeIssue
 	expectedResponse := checkmatlabcodeusecase.ReturnArgs{
-		CodeIssues: expectedCodeIssues,
+		AnalyzedFileCount: 1,
 	}
 	args := checkmatlabcode.Args{
 		ScriptPath: scriptPath,
@@ -146,8 +189,10 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
-	assert.Empty(t, result.CodeIssues, "Code issues should be empty")
+	assert.NotNil(t, result.Files, "Files should not be nil")
+	assert.Empty(t, result.Files, "Files should be empty")
+	assert.NotNil(t, result.SeverityCounts, "Severity counts should not be nil")
+	assert.Equal(t, 1, result.AnalyzedFileCount, "Analyzed file count should match")
 }
 
 func TestTool_Handler_ClientError(t *testing.T) {
@@ -179,8 +224,9 @@ func TestTool_Handler_ClientError(t *testing.T) {
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
-	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
-	assert.Empty(t, result.CodeIssues, "Code issues should be empty on error")
+	assert.NotNil(t, result.Files, "Files should not be nil")
+	assert.Empty(t, result.Files, "Files should be empty on error")
+	assert.NotNil(t, result.SeverityCounts, "Severity counts should not be nil")
 }
 
 func TestTool_Handler_UsecaseError(t *testing.T) {
@@ -217,8 +263,9 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
-	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
-	assert.Empty(t, result.CodeIssues, "Code issues should be empty on error")
+	assert.NotNil(t, result.Files, "Files should not be nil")
+	assert.Empty(t, result.Files, "Files should be empty on error")
+	assert.NotNil(t, result.SeverityCounts, "Severity counts should not be nil")
 }
 
 func TestCheckMATLABCode_Annotations(t *testing.T) {
@@ -8,11 +8,12 @@ import (
 )
 
 type Tool struct {
-	Name        string               `json:"name"`
-	Title       string               `json:"title"`
-	Description string               `json:"description"`
-	InputSchema *jsonschema.Schema   `json:"inputSchema"`
-	Annotations *mcp.ToolAnnotations `json:"annotations,omitempty"`
+	Name         string               `json:"name"`
+	Title        string               `json:"title"`
+	Description  string               `json:"description"`
+	InputSchema  *jsonschema.Schema   `json:"inputSchema"`
+	OutputSchema *jsonschema.Schema   `json:"outputSchema,omitempty"`
+	Annotations  *mcp.ToolAnnotations `json:"annotations,omitempty"`
 }
 
 type ValidatedTool interface {
@@ -21,10 +22,18 @@ type ValidatedTool interface {
 }
 
 type Signature struct {
-	Function string         `json:"function"`
-	Input    SignatureInput `json:"input"`
+	Function string           `json:"function"`
+	Input    SignatureInput   `json:"input"`
+	Output   *SignatureOutput `json:"output,omitempty"`
 }
 
 type SignatureInput struct {
-	Order []string `json:"order"`
+	Order     []string `json:"order"`
+	NameValue []string `json:"nameValue,omitempty"`
+}
+
+// SignatureOutput names the outputs of the MATLAB function, in order.
+// The function is called with one output argument per name.
+type SignatureOutput struct {
+	Names []string `json:"names"`
 }
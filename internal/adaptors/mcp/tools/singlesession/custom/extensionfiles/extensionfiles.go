@@ -0,0 +1,89 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package extensionfiles
+
+import (
+	"path/filepath"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+)
+
+const extensionFilePattern = "*.json"
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	Glob(pattern string) ([]string, error)
+}
+
+// Finder lists the extension files that define custom tools.
+type Finder struct {
+	configFactory ConfigFactory
+	osLayer       OSLayer
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+) *Finder {
+	return &Finder{
+		configFactory: configFactory,
+		osLayer:       osLayer,
+	}
+}
+
+// Find returns the extension files given with --extension-file, followed by the JSON files of each folder given with --extension-dir, in alphabetical order.
+// A file given more than once is only returned once, and empty arguments, such as --extension-file=, are ignored.
+// The folders are listed on each call, so files added to a folder later are found.
+func (f *Finder) Find() ([]string, messages.Error) {
+	cfg, err := f.configFactory.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	var extensionFiles []string
+	seen := make(map[string]struct{})
+	add := func(extensionFile string) {
+		if extensionFile == "" {
+			return
+		}
+		key := filepath.Clean(extensionFile)
+		if _, found := seen[key]; found {
+			return
+		}
+		seen[key] = struct{}{}
+		extensionFiles = append(extensionFiles, extensionFile)
+	}
+
+	for _, extensionFile := range cfg.ExtensionFiles() {
+		add(extensionFile)
+	}
+
+	for _, extensionDir := range cfg.ExtensionDirs() {
+		if extensionDir == "" {
+			continue
+		}
+
+		info, statErr := f.osLayer.Stat(extensionDir)
+		if statErr != nil || !info.IsDir() {
+			return nil, messages.New_StartupErrors_FailedToReadExtensionDir_Error(extensionDir)
+		}
+
+		// Glob returns the matches in lexical order, so tools are always loaded in the same order.
+		matches, globErr := f.osLayer.Glob(filepath.Join(extensionDir, extensionFilePattern))
+		if globErr != nil {
+			return nil, messages.New_StartupErrors_FailedToReadExtensionDir_Error(extensionDir)
+		}
+
+		for _, extensionFile := range matches {
+			add(extensionFile)
+		}
+	}
+
+	return extensionFiles, nil
+}
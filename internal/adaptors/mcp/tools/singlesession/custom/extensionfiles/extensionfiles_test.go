@@ -0,0 +1,283 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package extensionfiles_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/extensionfiles"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/custom/extensionfiles"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, finder)
+}
+
+func TestFinder_Find_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirInfo := &osfacademocks.MockFileInfo{}
+	defer mockDirInfo.AssertExpectations(t)
+
+	extensionFile := filepath.Join("config", "tools.json")
+	extensionDir := filepath.Join("config", "toolsets")
+	signalExtensionFile := filepath.Join(extensionDir, "signal.json")
+	imageExtensionFile := filepath.Join(extensionDir, "image.json")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile, signalExtensionFile}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{extensionDir}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionDir).
+		Return(mockDirInfo, nil).
+		Once()
+
+	mockDirInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(extensionDir, "*.json")).
+		Return([]string{imageExtensionFile, signalExtensionFile}, nil).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{extensionFile, signalExtensionFile, imageExtensionFile}, extensionFiles, "Each extension file should only be returned once")
+}
+
+func TestFinder_Find_NothingConfigured(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{}).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, extensionFiles)
+}
+
+func TestFinder_Find_IgnoresEmptyArguments(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	extensionFile := filepath.Join("config", "tools.json")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{"", extensionFile}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{""}).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{extensionFile}, extensionFiles)
+}
+
+func TestFinder_Find_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, extensionFiles)
+}
+
+func TestFinder_Find_ExtensionDirNotFound(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	extensionDir := filepath.Join("config", "toolsets")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{extensionDir}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionDir).
+		Return(nil, assert.AnError).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToReadExtensionDir_Error(extensionDir), err)
+	assert.Nil(t, extensionFiles)
+}
+
+func TestFinder_Find_ExtensionDirIsAFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	extensionDir := filepath.Join("config", "tools.json")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionDirs().
+		Return([]string{extensionDir}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(extensionDir).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	finder := extensionfiles.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	extensionFiles, err := finder.Find()
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToReadExtensionDir_Error(extensionDir), err)
+	assert.Nil(t, extensionFiles)
+}
@@ -19,6 +19,7 @@ type Factory struct {
 	loggerFactory basetool.LoggerFactory
 	usecase       Usecase
 	globalMATLAB  entities.GlobalMATLAB
+	matlabManager MATLABManager
 	configFactory ConfigFactory
 }
 
@@ -27,6 +28,7 @@ func NewFactory(
 	loggerFactory basetool.LoggerFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
+	matlabManager MATLABManager,
 	configFactory ConfigFactory,
 ) *Factory {
 	return &Factory{
@@ -34,6 +36,7 @@ func NewFactory(
 		loggerFactory: loggerFactory,
 		usecase:       usecase,
 		globalMATLAB:  globalMATLAB,
+		matlabManager: matlabManager,
 		configFactory: configFactory,
 	}
 }
@@ -51,3 +54,26 @@ func (f *Factory) LoadTools(filePath string) ([]tools.Tool, messages.Error) {
 
 	return result, nil
 }
+
+// LoadMultiSessionTools loads the tools for multi-session mode, where each tool takes an optional session_id argument.
+// Without session_id, a tool runs in the only MATLAB session, and fails when there is none or more than one.
+func (f *Factory) LoadMultiSessionTools(filePath string) ([]tools.Tool, messages.Error) {
+	validatedTools, err := f.loader.Load(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tools.Tool, 0, len(validatedTools))
+	for _, vt := range validatedTools {
+		toolDef := vt.Definition()
+		if toolDef.InputSchema != nil {
+			if _, found := toolDef.InputSchema.Properties[sessionIDArgument]; found {
+				return nil, messages.New_StartupErrors_ReservedCustomToolArgument_Error(toolDef.Name, filePath, sessionIDArgument)
+			}
+		}
+
+		result = append(result, NewMultiSessionTool(vt, f.loggerFactory, f.configFactory, f.usecase, f.matlabManager))
+	}
+
+	return result, nil
+}
@@ -5,6 +5,7 @@ package custom_test
 import (
 	"testing"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
@@ -64,7 +65,7 @@ func TestFactory_LoadTools_HappyPath(t *testing.T) {
 		Return(validatedTools, nil).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockConfigFactory)
+	factory := custom.NewFactory(mockLoader, mockLoggerFactory, mockUsecase, mockGlobalMATLAB, nil, mockConfigFactory)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
@@ -88,7 +89,7 @@ func TestFactory_LoadTools_EmptyList(t *testing.T) {
 		Return([]definition.ValidatedTool{}, nil).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil)
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
@@ -111,7 +112,7 @@ func TestFactory_LoadTools_LoaderError_ReturnsError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil)
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
@@ -120,3 +121,100 @@ func TestFactory_LoadTools_LoaderError_ReturnsError(t *testing.T) {
 	assert.Nil(t, tools)
 	require.Equal(t, expectedError, err)
 }
+
+func TestFactory_LoadMultiSessionTools_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoader := &custommocks.MockLoader{}
+	defer mockLoader.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedFilePath := "tools.json"
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{Name: "tool1"}).
+		Times(3)
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	mockLoader.EXPECT().
+		Load(expectedFilePath).
+		Return([]definition.ValidatedTool{mockValidatedTool}, nil).
+		Once()
+
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil)
+
+	// Act
+	tools, err := factory.LoadMultiSessionTools(expectedFilePath)
+
+	// Assert
+	require.Nil(t, err)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "tool1", tools[0].Name())
+}
+
+func TestFactory_LoadMultiSessionTools_ReservedArgument_ReturnsError(t *testing.T) {
+	// Arrange
+	mockLoader := &custommocks.MockLoader{}
+	defer mockLoader.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedFilePath := "tools.json"
+	expectedError := messages.New_StartupErrors_ReservedCustomToolArgument_Error("tool1", expectedFilePath, "session_id")
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{
+			Name: "tool1",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"session_id": {Type: "string"},
+				},
+			},
+		}).
+		Once()
+
+	mockLoader.EXPECT().
+		Load(expectedFilePath).
+		Return([]definition.ValidatedTool{mockValidatedTool}, nil).
+		Once()
+
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil)
+
+	// Act
+	tools, err := factory.LoadMultiSessionTools(expectedFilePath)
+
+	// Assert
+	assert.Nil(t, tools)
+	require.Equal(t, expectedError, err)
+}
+
+func TestFactory_LoadMultiSessionTools_LoaderError_ReturnsError(t *testing.T) {
+	// Arrange
+	mockLoader := &custommocks.MockLoader{}
+	defer mockLoader.AssertExpectations(t)
+
+	expectedFilePath := "tools.json"
+	expectedError := messages.New_StartupErrors_FailedToParseExtensionFile_Error(expectedFilePath)
+
+	mockLoader.EXPECT().
+		Load(expectedFilePath).
+		Return(nil, expectedError).
+		Once()
+
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil)
+
+	// Act
+	tools, err := factory.LoadMultiSessionTools(expectedFilePath)
+
+	// Assert
+	assert.Nil(t, tools)
+	require.Equal(t, expectedError, err)
+}
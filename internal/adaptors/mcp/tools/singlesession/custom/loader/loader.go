@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/loader/validator"
@@ -13,11 +14,28 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 )
 
+// validPrefix matches a valid prefix for the names of the tools in an extension file, for example signal.
+var validPrefix = regexp.MustCompile(`^[A-Za-z]\w*$`)
+
 type toolsFile struct {
+	// Prefix optionally namespaces the tools of the file: each tool is named <prefix>_<name>.
+	Prefix     string                          `json:"prefix,omitempty"`
 	Tools      []definition.Tool               `json:"tools"`
 	Signatures map[string]definition.Signature `json:"signatures"`
 }
 
+// prefixedTool is a validated tool, renamed with the prefix of its extension file.
+type prefixedTool struct {
+	definition.ValidatedTool
+	name string
+}
+
+func (t *prefixedTool) Definition() definition.Tool {
+	toolDefinition := t.ValidatedTool.Definition()
+	toolDefinition.Name = t.name
+	return toolDefinition
+}
+
 type OSLayer interface {
 	ReadFile(filePath string) ([]byte, error)
 }
@@ -62,6 +80,11 @@ func (l *Loader) Load(filePath string) ([]definition.ValidatedTool, messages.Err
 		return nil, messages.New_StartupErrors_FailedToParseExtensionFile_Error(filePath)
 	}
 
+	if parsed.Prefix != "" && !validPrefix.MatchString(parsed.Prefix) {
+		logger.WithError(fmt.Errorf("invalid prefix %q", parsed.Prefix)).Error("Invalid custom tools extension file")
+		return nil, messages.New_StartupErrors_InvalidExtensionFilePrefix_Error(parsed.Prefix, filePath)
+	}
+
 	validatedTools := make([]definition.ValidatedTool, 0, len(parsed.Tools))
 	for _, toolDefinition := range parsed.Tools {
 		validatedTool, err := l.toolValidator.Validate(toolDefinition, parsed.Signatures)
@@ -70,9 +93,16 @@ func (l *Loader) Load(filePath string) ([]definition.ValidatedTool, messages.Err
 			return nil, validationErrorToMessage(err, toolDefinition.Name, filePath)
 		}
 
+		if parsed.Prefix != "" {
+			validatedTool = &prefixedTool{
+				ValidatedTool: validatedTool,
+				name:          parsed.Prefix + "_" + toolDefinition.Name,
+			}
+		}
+
 		if isDuplicateToolName(validatedTool.Definition().Name, validatedTools) {
 			logger.WithError(fmt.Errorf("duplicate tool name %q", validatedTool.Definition().Name)).Error("Invalid custom tool definition")
-			return nil, messages.New_StartupErrors_DuplicateToolName_Error(validatedTool.Definition().Name, filePath)
+			return nil, messages.New_StartupErrors_DuplicateToolNameInExtensionFile_Error(validatedTool.Definition().Name, filePath)
 		}
 
 		validatedTools = append(validatedTools, validatedTool)
@@ -90,6 +120,8 @@ func validationErrorToMessage(err error, toolName string, filePath string) messa
 		return messages.New_StartupErrors_MissingToolSignature_Error(toolName, filePath)
 	case errors.Is(err, validator.ErrInvalidSignature):
 		return messages.New_StartupErrors_InvalidToolSignature_Error(toolName, filePath)
+	case errors.Is(err, validator.ErrInvalidOutput):
+		return messages.New_StartupErrors_InvalidToolOutput_Error(toolName, filePath)
 	case errors.Is(err, validator.ErrInvalidToolDefinition):
 		return messages.New_StartupErrors_InvalidToolDefinition_Error(filePath)
 	default:
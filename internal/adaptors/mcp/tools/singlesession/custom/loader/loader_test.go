@@ -37,6 +37,12 @@ var malformedJSON []byte
 //go:embed testdata/invalid_property.json
 var invalidPropertyJSON []byte
 
+//go:embed testdata/prefixed_tools.json
+var prefixedToolsJSON []byte
+
+//go:embed testdata/invalid_prefix.json
+var invalidPrefixJSON []byte
+
 func TestNewLoader_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &loadermocks.MockOSLayer{}
@@ -111,6 +117,62 @@ func TestLoader_Load_SingleTool_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedDefinition.Description, tools[0].Definition().Description)
 }
 
+func TestLoader_Load_Prefix_NamespacesToolNames(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loadermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &loadermocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockToolValidator := &loadermocks.MockToolValidator{}
+	defer mockToolValidator.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	toolsFilePath := filepath.Join("config", "tools.json")
+
+	var parsed struct {
+		Tools      []definition.Tool               `json:"tools"`
+		Signatures map[string]definition.Signature `json:"signatures"`
+	}
+	require.NoError(t, json.Unmarshal(prefixedToolsJSON, &parsed))
+	require.Len(t, parsed.Tools, 1)
+	expectedDefinition := parsed.Tools[0]
+	expectedSignatures := parsed.Signatures
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(toolsFilePath).
+		Return(prefixedToolsJSON, nil).
+		Once()
+	mockToolValidator.EXPECT().
+		Validate(expectedDefinition, expectedSignatures).
+		Return(mockValidatedTool, nil).
+		Once()
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition)
+
+	l := loader.NewLoader(mockOSLayer, mockLoggerFactory, mockToolValidator)
+
+	// Act
+	tools, err := l.Load(toolsFilePath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "signal_test_tool", tools[0].Definition().Name)
+	assert.Equal(t, expectedDefinition.Title, tools[0].Definition().Title)
+	assert.Equal(t, expectedDefinition.Description, tools[0].Definition().Description)
+}
+
 func TestLoader_Load_MultipleTools_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &loadermocks.MockOSLayer{}
@@ -317,6 +379,40 @@ func TestLoader_Load_MalformedJSON_ReturnsError(t *testing.T) {
 	require.Equal(t, expectedError, err)
 }
 
+func TestLoader_Load_InvalidPrefix_ReturnsError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loadermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &loadermocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockToolValidator := &loadermocks.MockToolValidator{}
+	defer mockToolValidator.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	toolsFilePath := filepath.Join("config", "tools.json")
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger, nil).
+		Once()
+	mockOSLayer.EXPECT().
+		ReadFile(toolsFilePath).
+		Return(invalidPrefixJSON, nil).
+		Once()
+
+	l := loader.NewLoader(mockOSLayer, mockLoggerFactory, mockToolValidator)
+
+	// Act
+	tools, err := l.Load(toolsFilePath)
+
+	// Assert
+	expectedError := messages.New_StartupErrors_InvalidExtensionFilePrefix_Error("signal-processing", toolsFilePath)
+
+	assert.Nil(t, tools)
+	require.Equal(t, expectedError, err)
+}
+
 func TestLoader_Load_InvalidPropertyDefinition_ReturnsError(t *testing.T) {
 	// Arrange
 	mockOSLayer := &loadermocks.MockOSLayer{}
@@ -380,6 +476,11 @@ func TestLoader_Load_ValidationError_ReturnsError(t *testing.T) {
 			fmt.Errorf("signature must have a 'function' field: %w", validator.ErrInvalidSignature),
 			messages.New_StartupErrors_InvalidToolSignature_Error(expectedToolName, toolsFilePath),
 		},
+		{
+			"invalid output",
+			fmt.Errorf("output.names must not be empty: %w", validator.ErrInvalidOutput),
+			messages.New_StartupErrors_InvalidToolOutput_Error(expectedToolName, toolsFilePath),
+		},
 		{
 			"unknown validation error",
 			fmt.Errorf("validation failed"),
@@ -467,7 +568,7 @@ func TestLoader_Load_DuplicateToolName_ReturnsError(t *testing.T) {
 	tools, err := l.Load(toolsFilePath)
 
 	// Assert
-	expectedError := messages.New_StartupErrors_DuplicateToolName_Error("same_name", toolsFilePath)
+	expectedError := messages.New_StartupErrors_DuplicateToolNameInExtensionFile_Error("same_name", toolsFilePath)
 
 	assert.Nil(t, tools)
 	require.Equal(t, expectedError, err)
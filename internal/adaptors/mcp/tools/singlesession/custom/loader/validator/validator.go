@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
@@ -16,6 +17,7 @@ var (
 	ErrInvalidInputSchema    = errors.New("invalid input schema")
 	ErrSignatureNotFound     = errors.New("signature not found")
 	ErrInvalidSignature      = errors.New("invalid signature")
+	ErrInvalidOutput         = errors.New("invalid output")
 )
 
 // validMATLABFunctionName matches a valid MATLAB function name:
@@ -25,6 +27,9 @@ var (
 //   - Example: pkg.myFunc
 var validMATLABFunctionName = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
 
+// validMATLABArgumentName matches a valid MATLAB name-value argument or output name, for example Tolerance.
+var validMATLABArgumentName = regexp.MustCompile(`^[A-Za-z]\w*$`)
+
 type validatedTool struct {
 	definition definition.Tool
 	signature  definition.Signature
@@ -57,6 +62,10 @@ func (v *Validator) Validate(toolDefinition definition.Tool, signatures map[stri
 		return nil, err
 	}
 
+	if err := validateOutput(sig.Output, toolDefinition.OutputSchema); err != nil {
+		return nil, err
+	}
+
 	return &validatedTool{
 		definition: toolDefinition,
 		signature:  sig,
@@ -85,30 +94,54 @@ func validateInputSchema(schema *jsonschema.Schema) error {
 		return fmt.Errorf("inputSchema type must be 'object', got '%s': %w", schema.Type, ErrInvalidInputSchema)
 	}
 
+	return validateProperties("", schema)
+}
+
+// validateProperties checks the properties of an object schema, descending into
+// array items and nested objects. prefix qualifies property names in error messages.
+func validateProperties(prefix string, schema *jsonschema.Schema) error {
 	for propName, prop := range schema.Properties {
-		if prop == nil {
-			return fmt.Errorf("property %q is nil: %w", propName, ErrInvalidInputSchema)
-		}
-		if prop.Type == "" {
-			return fmt.Errorf("property %q must have a 'type' field: %w", propName, ErrInvalidInputSchema)
-		}
-		if !isSupportedType(prop.Type) {
-			return fmt.Errorf("property %q has unsupported type %q (supported: string, number, integer, boolean): %w", propName, prop.Type, ErrInvalidInputSchema)
+		if err := validateProperty(prefix+propName, prop); err != nil {
+			return err
 		}
 	}
 
 	for _, reqName := range schema.Required {
 		if _, exists := schema.Properties[reqName]; !exists {
-			return fmt.Errorf("required property %q is not defined in properties: %w", reqName, ErrInvalidInputSchema)
+			return fmt.Errorf("required property %q is not defined in properties: %w", prefix+reqName, ErrInvalidInputSchema)
 		}
 	}
 
 	return nil
 }
 
+func validateProperty(propName string, prop *jsonschema.Schema) error {
+	if prop == nil {
+		return fmt.Errorf("property %q is nil: %w", propName, ErrInvalidInputSchema)
+	}
+	if prop.Type == "" {
+		return fmt.Errorf("property %q must have a 'type' field: %w", propName, ErrInvalidInputSchema)
+	}
+	if !isSupportedType(prop.Type) {
+		return fmt.Errorf("property %q has unsupported type %q (supported: string, number, integer, boolean, array, object): %w", propName, prop.Type, ErrInvalidInputSchema)
+	}
+
+	switch prop.Type {
+	case "array":
+		if prop.Items == nil {
+			return fmt.Errorf("array property %q must have an 'items' field: %w", propName, ErrInvalidInputSchema)
+		}
+		return validateProperty(propName+"[]", prop.Items)
+	case "object":
+		return validateProperties(propName+".", prop)
+	default:
+		return nil
+	}
+}
+
 func isSupportedType(t string) bool {
 	switch t {
-	case "string", "number", "integer", "boolean":
+	case "string", "number", "integer", "boolean", "array", "object":
 		return true
 	default:
 		return false
@@ -132,25 +165,105 @@ func validateSignature(sig definition.Signature, schema *jsonschema.Schema) erro
 		return fmt.Errorf("signature function %q is not a valid MATLAB function name: %w", sig.Function, ErrInvalidSignature)
 	}
 
-	if len(sig.Input.Order) > 0 && schema.Properties == nil {
+	if (len(sig.Input.Order) > 0 || len(sig.Input.NameValue) > 0) && schema.Properties == nil {
 		return fmt.Errorf("inputSchema properties not defined: %w", ErrInvalidSignature)
 	}
 
-	orderSet := make(map[string]struct{}, len(sig.Input.Order))
+	entrySet := make(map[string]struct{}, len(sig.Input.Order)+len(sig.Input.NameValue))
+	addEntry := func(entry string, field string) error {
+		if _, duplicate := entrySet[entry]; duplicate {
+			return fmt.Errorf("duplicate entry %q in %s: %w", entry, field, ErrInvalidSignature)
+		}
+		entrySet[entry] = struct{}{}
+
+		if _, exists := schema.Properties[entry]; !exists {
+			return fmt.Errorf("%s entry %q not found in inputSchema properties: %w", field, entry, ErrInvalidSignature)
+		}
+		return nil
+	}
+
 	for _, orderEntry := range sig.Input.Order {
-		if _, duplicate := orderSet[orderEntry]; duplicate {
-			return fmt.Errorf("duplicate entry %q in input.order: %w", orderEntry, ErrInvalidSignature)
+		if err := addEntry(orderEntry, "input.order"); err != nil {
+			return err
 		}
-		orderSet[orderEntry] = struct{}{}
+	}
 
-		if _, exists := schema.Properties[orderEntry]; !exists {
-			return fmt.Errorf("input.order entry %q not found in inputSchema properties: %w", orderEntry, ErrInvalidSignature)
+	for _, nameValueEntry := range sig.Input.NameValue {
+		if err := addEntry(nameValueEntry, "input.nameValue"); err != nil {
+			return err
+		}
+		if !validMATLABArgumentName.MatchString(nameValueEntry) {
+			return fmt.Errorf("input.nameValue entry %q is not a valid MATLAB argument name: %w", nameValueEntry, ErrInvalidSignature)
 		}
 	}
 
 	for propName := range schema.Properties {
-		if _, exists := orderSet[propName]; !exists {
-			return fmt.Errorf("inputSchema property %q is not included in input.order: %w", propName, ErrInvalidSignature)
+		if _, exists := entrySet[propName]; !exists {
+			return fmt.Errorf("inputSchema property %q is not included in input.order or input.nameValue: %w", propName, ErrInvalidSignature)
+		}
+	}
+
+	return validateOptionalPositionalArguments(sig.Input.Order, schema)
+}
+
+// validateOptionalPositionalArguments checks that positional arguments which can be omitted,
+// that is those that are neither required nor have a default, come after all other positional arguments.
+// Only trailing positional arguments can be left out of a MATLAB function call.
+func validateOptionalPositionalArguments(order []string, schema *jsonschema.Schema) error {
+	firstOptional := ""
+	for _, orderEntry := range order {
+		if slices.Contains(schema.Required, orderEntry) || schema.Properties[orderEntry].Default != nil {
+			if firstOptional != "" {
+				return fmt.Errorf("input.order entry %q must come before optional entry %q: %w", orderEntry, firstOptional, ErrInvalidSignature)
+			}
+			continue
+		}
+
+		if firstOptional == "" {
+			firstOptional = orderEntry
+		}
+	}
+	return nil
+}
+
+// validateOutput checks that the signature output names and the tool outputSchema are declared together,
+// and that each output name is a property of the outputSchema and the other way round.
+func validateOutput(output *definition.SignatureOutput, outputSchema *jsonschema.Schema) error {
+	if output == nil && outputSchema == nil {
+		return nil
+	}
+	if output == nil {
+		return fmt.Errorf("outputSchema requires an 'output' field in the signature: %w", ErrInvalidOutput)
+	}
+	if outputSchema == nil {
+		return fmt.Errorf("signature output requires an 'outputSchema' field in the tool definition: %w", ErrInvalidOutput)
+	}
+
+	if outputSchema.Type != "object" {
+		return fmt.Errorf("outputSchema type must be 'object', got '%s': %w", outputSchema.Type, ErrInvalidOutput)
+	}
+	if len(output.Names) == 0 {
+		return fmt.Errorf("output.names must not be empty: %w", ErrInvalidOutput)
+	}
+
+	nameSet := make(map[string]struct{}, len(output.Names))
+	for _, name := range output.Names {
+		if _, duplicate := nameSet[name]; duplicate {
+			return fmt.Errorf("duplicate entry %q in output.names: %w", name, ErrInvalidOutput)
+		}
+		nameSet[name] = struct{}{}
+
+		if !validMATLABArgumentName.MatchString(name) {
+			return fmt.Errorf("output.names entry %q is not a valid MATLAB name: %w", name, ErrInvalidOutput)
+		}
+		if _, exists := outputSchema.Properties[name]; !exists {
+			return fmt.Errorf("output.names entry %q not found in outputSchema properties: %w", name, ErrInvalidOutput)
+		}
+	}
+
+	for propName := range outputSchema.Properties {
+		if _, exists := nameSet[propName]; !exists {
+			return fmt.Errorf("outputSchema property %q is not included in output.names: %w", propName, ErrInvalidOutput)
 		}
 	}
 
@@ -48,6 +48,36 @@ func TestValidator_Validate_HappyPath(t *testing.T) {
 	assert.Equal(t, "testFunc", result.Signature().Function)
 }
 
+func TestValidator_Validate_ArrayAndObjectProperties_HappyPath(t *testing.T) {
+	// Arrange
+	v := validator.NewValidator()
+	td := validToolDefinition()
+	td.InputSchema = &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"matrix": {Type: "array", Items: &jsonschema.Schema{Type: "array", Items: &jsonschema.Schema{Type: "number"}}},
+			"names":  {Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+			"opts": {
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"tol": {Type: "number"},
+				},
+				Required: []string{"tol"},
+			},
+		},
+	}
+	signatures := map[string]definition.Signature{
+		"test_tool": {Function: "testFunc", Input: definition.SignatureInput{Order: []string{"matrix", "names", "opts"}}},
+	}
+
+	// Act
+	result, err := v.Validate(td, signatures)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, td.Name, result.Definition().Name)
+}
+
 func TestValidator_Validate_NoArgs_HappyPath(t *testing.T) {
 	// Arrange
 	v := validator.NewValidator()
@@ -105,9 +135,32 @@ func TestValidator_Validate_InvalidSchema_ReturnsError(t *testing.T) {
 		{"missing type", &jsonschema.Schema{Properties: map[string]*jsonschema.Schema{}}},
 		{"type not object", &jsonschema.Schema{Type: "array"}},
 		{"unsupported property type", &jsonschema.Schema{
+			Type:       "object",
+			Properties: map[string]*jsonschema.Schema{"x": {Type: "null"}},
+		}},
+		{"array missing items", &jsonschema.Schema{
 			Type:       "object",
 			Properties: map[string]*jsonschema.Schema{"arr": {Type: "array"}},
 		}},
+		{"array items missing type", &jsonschema.Schema{
+			Type:       "object",
+			Properties: map[string]*jsonschema.Schema{"arr": {Type: "array", Items: &jsonschema.Schema{}}},
+		}},
+		{"nested object unsupported property type", &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{"opts": {
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{"x": {Type: "null"}},
+			}},
+		}},
+		{"nested object required not in properties", &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{"opts": {
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{"x": {Type: "string"}},
+				Required:   []string{"missing_prop"},
+			}},
+		}},
 		{"nil property", &jsonschema.Schema{
 			Type:       "object",
 			Properties: map[string]*jsonschema.Schema{"x": nil},
@@ -282,3 +335,160 @@ func TestValidator_Validate_SchemaPropertyNotInOrder(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, validator.ErrInvalidSignature)
 }
+
+func optionalArgumentsToolDefinition() definition.Tool {
+	td := validToolDefinition()
+	td.InputSchema = &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"x":         {Type: "number"},
+			"y":         {Type: "number", Default: []byte("2")},
+			"z":         {Type: "number"},
+			"Tolerance": {Type: "number"},
+		},
+		Required: []string{"x"},
+	}
+	return td
+}
+
+func TestValidator_Validate_OptionalAndNameValueArguments_HappyPath(t *testing.T) {
+	// Arrange
+	v := validator.NewValidator()
+	td := optionalArgumentsToolDefinition()
+	signatures := map[string]definition.Signature{
+		"test_tool": {Function: "testFunc", Input: definition.SignatureInput{
+			Order:     []string{"x", "y", "z"},
+			NameValue: []string{"Tolerance"},
+		}},
+	}
+
+	// Act
+	result, err := v.Validate(td, signatures)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Tolerance"}, result.Signature().Input.NameValue)
+}
+
+func TestValidator_Validate_InvalidOptionalAndNameValueArguments_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input definition.SignatureInput
+	}{
+		{"required after optional", definition.SignatureInput{Order: []string{"z", "x", "y"}, NameValue: []string{"Tolerance"}}},
+		{"default after optional", definition.SignatureInput{Order: []string{"x", "z", "y"}, NameValue: []string{"Tolerance"}}},
+		{"name-value entry not in properties", definition.SignatureInput{Order: []string{"x", "y", "z"}, NameValue: []string{"Tolerance", "Missing"}}},
+		{"entry in both order and name-value", definition.SignatureInput{Order: []string{"x", "y", "z"}, NameValue: []string{"Tolerance", "z"}}},
+		{"property in neither order nor name-value", definition.SignatureInput{Order: []string{"x", "y", "z"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			v := validator.NewValidator()
+			td := optionalArgumentsToolDefinition()
+			signatures := map[string]definition.Signature{
+				"test_tool": {Function: "testFunc", Input: tt.input},
+			}
+
+			// Act
+			_, err := v.Validate(td, signatures)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, validator.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestValidator_Validate_InvalidNameValueArgumentName_ReturnsError(t *testing.T) {
+	// Arrange
+	v := validator.NewValidator()
+	td := validToolDefinition()
+	td.InputSchema.Properties["pkg.name"] = &jsonschema.Schema{Type: "string"}
+	signatures := map[string]definition.Signature{
+		"test_tool": {Function: "testFunc", Input: definition.SignatureInput{
+			Order:     []string{"n"},
+			NameValue: []string{"pkg.name"},
+		}},
+	}
+
+	// Act
+	_, err := v.Validate(td, signatures)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, validator.ErrInvalidSignature)
+	assert.Contains(t, err.Error(), "not a valid MATLAB argument name")
+}
+
+func outputToolDefinition() definition.Tool {
+	td := validToolDefinition()
+	td.OutputSchema = &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"mean": {Type: "number"},
+			"std":  {Type: "number"},
+		},
+		Required: []string{"mean", "std"},
+	}
+	return td
+}
+
+func TestValidator_Validate_Output_HappyPath(t *testing.T) {
+	// Arrange
+	v := validator.NewValidator()
+	td := outputToolDefinition()
+	signatures := validSignatures()
+	sig := signatures["test_tool"]
+	sig.Output = &definition.SignatureOutput{Names: []string{"mean", "std"}}
+	signatures["test_tool"] = sig
+
+	// Act
+	result, err := v.Validate(td, signatures)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mean", "std"}, result.Signature().Output.Names)
+	assert.Equal(t, td.OutputSchema, result.Definition().OutputSchema)
+}
+
+func TestValidator_Validate_InvalidOutput_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name         string
+		outputSchema *jsonschema.Schema
+		output       *definition.SignatureOutput
+	}{
+		{"outputSchema without output", outputToolDefinition().OutputSchema, nil},
+		{"output without outputSchema", nil, &definition.SignatureOutput{Names: []string{"mean"}}},
+		{"outputSchema type not object", &jsonschema.Schema{Type: "array"}, &definition.SignatureOutput{Names: []string{"mean"}}},
+		{"empty names", outputToolDefinition().OutputSchema, &definition.SignatureOutput{}},
+		{"duplicate name", outputToolDefinition().OutputSchema, &definition.SignatureOutput{Names: []string{"mean", "std", "mean"}}},
+		{"name not in outputSchema", outputToolDefinition().OutputSchema, &definition.SignatureOutput{Names: []string{"mean", "std", "max"}}},
+		{"outputSchema property not in names", outputToolDefinition().OutputSchema, &definition.SignatureOutput{Names: []string{"mean"}}},
+		{"invalid MATLAB name", &jsonschema.Schema{
+			Type:       "object",
+			Properties: map[string]*jsonschema.Schema{"1st": {Type: "number"}},
+		}, &definition.SignatureOutput{Names: []string{"1st"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			v := validator.NewValidator()
+			td := validToolDefinition()
+			td.OutputSchema = tt.outputSchema
+			signatures := validSignatures()
+			sig := signatures["test_tool"]
+			sig.Output = tt.output
+			signatures["test_tool"] = sig
+
+			// Act
+			_, err := v.Validate(td, signatures)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, validator.ErrInvalidOutput)
+		})
+	}
+}
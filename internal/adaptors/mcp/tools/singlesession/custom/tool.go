@@ -4,10 +4,17 @@ package custom
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
@@ -16,10 +23,18 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// sessionIDArgument selects the MATLAB session in which a custom tool runs in multi-session mode.
+const sessionIDArgument = "session_id"
+
 type ConfigFactory interface {
 	Config() (config.Config, messages.Error)
 }
 
+type MATLABManager interface {
+	ListMATLABSessions(ctx context.Context, sessionLogger entities.Logger) ([]entities.MATLABSessionInfo, error)
+	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+}
+
 type Usecase interface {
 	Execute(
 		ctx context.Context,
@@ -27,14 +42,26 @@ type Usecase interface {
 		client entities.MATLABSessionClient,
 		request evalcustomtool.Args,
 	) (entities.EvalResponse, error)
+	ExecuteWithStructuredOutput(
+		ctx context.Context,
+		sessionLogger entities.Logger,
+		client entities.MATLABSessionClient,
+		request evalcustomtool.Args,
+		outputNames []string,
+	) (evalcustomtool.StructuredResponse, error)
 }
 
 type Tool struct {
 	validatedTool definition.ValidatedTool
+	sessionAware  bool
 	handler       mcp.ToolHandlerFor[map[string]any, any]
 	toolAdder     basetool.ToolAdder[map[string]any, any]
 }
 
+// sessionClientGetter returns the client of the MATLAB session in which to run a tool call,
+// together with the arguments to pass on to the MATLAB function.
+type sessionClientGetter func(ctx context.Context, logger entities.Logger, args map[string]any) (entities.MATLABSessionClient, map[string]any, error)
+
 func NewTool(
 	validatedTool definition.ValidatedTool,
 	loggerFactory basetool.LoggerFactory,
@@ -49,23 +76,47 @@ func NewTool(
 	}
 }
 
+// NewMultiSessionTool creates a custom tool with an additional, optional session_id argument.
+func NewMultiSessionTool(
+	validatedTool definition.ValidatedTool,
+	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
+	usecase Usecase,
+	matlabManager MATLABManager,
+) *Tool {
+	return &Tool{
+		validatedTool: validatedTool,
+		sessionAware:  true,
+		handler:       MultiSessionHandler(validatedTool, loggerFactory, configFactory, usecase, matlabManager),
+		toolAdder:     mcpfacade.NewToolAdder[map[string]any, any](),
+	}
+}
+
 func (t *Tool) Name() string {
 	return t.validatedTool.Definition().Name
 }
 
 func (t *Tool) AddToServer(server *mcp.Server) error {
 	toolDef := t.validatedTool.Definition()
-	t.toolAdder.AddTool(
-		server,
-		&mcp.Tool{
-			Name:        toolDef.Name,
-			Title:       toolDef.Title,
-			Description: toolDef.Description,
-			Annotations: toolDef.Annotations,
-			InputSchema: toolDef.InputSchema,
-		},
-		t.handler,
-	)
+
+	inputSchema := toolDef.InputSchema
+	if t.sessionAware {
+		inputSchema = withSessionIDArgument(inputSchema)
+	}
+
+	tool := &mcp.Tool{
+		Name:        toolDef.Name,
+		Title:       toolDef.Title,
+		Description: toolDef.Description,
+		Annotations: toolDef.Annotations,
+		InputSchema: inputSchema,
+	}
+	// OutputSchema is an interface, so it is only set when declared, to avoid a non-nil interface holding a nil schema.
+	if toolDef.OutputSchema != nil {
+		tool.OutputSchema = toolDef.OutputSchema
+	}
+
+	t.toolAdder.AddTool(server, tool, t.handler)
 	return nil
 }
 
@@ -75,6 +126,48 @@ func Handler(
 	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
+) mcp.ToolHandlerFor[map[string]any, any] {
+	return newHandler(validatedTool, loggerFactory, configFactory, usecase, func(ctx context.Context, logger entities.Logger, args map[string]any) (entities.MATLABSessionClient, map[string]any, error) {
+		client, err := globalMATLAB.Client(ctx, logger)
+		return client, args, err
+	})
+}
+
+// MultiSessionHandler runs the tool in the MATLAB session given by the session_id argument.
+// Without session_id, the tool runs in the only MATLAB session, and fails when there is none or more than one.
+func MultiSessionHandler(
+	validatedTool definition.ValidatedTool,
+	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
+	usecase Usecase,
+	matlabManager MATLABManager,
+) mcp.ToolHandlerFor[map[string]any, any] {
+	return newHandler(validatedTool, loggerFactory, configFactory, usecase, func(ctx context.Context, logger entities.Logger, args map[string]any) (entities.MATLABSessionClient, map[string]any, error) {
+		var sessionID entities.SessionID
+		var err error
+		if rawSessionID, found := args[sessionIDArgument]; found {
+			sessionID, err = parseSessionID(rawSessionID)
+		} else {
+			sessionID, err = onlySessionID(ctx, logger, matlabManager)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		functionArgs := maps.Clone(args)
+		delete(functionArgs, sessionIDArgument)
+
+		client, err := matlabManager.GetMATLABSessionClient(ctx, logger.With("session_id", sessionID), sessionID)
+		return client, functionArgs, err
+	})
+}
+
+func newHandler(
+	validatedTool definition.ValidatedTool,
+	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
+	usecase Usecase,
+	getSessionClient sessionClientGetter,
 ) mcp.ToolHandlerFor[map[string]any, any] {
 	toolDef := validatedTool.Definition()
 	toolSig := validatedTool.Signature()
@@ -88,6 +181,8 @@ func Handler(
 		logger.Debug("Handling custom tool call request")
 		defer logger.Debug("Handled custom tool call request")
 
+		ctx = progressreporter.ContextForToolCall(ctx, req, logger)
+
 		var argumentTypes map[string]string
 		if toolDef.InputSchema != nil {
 			argumentTypes = make(map[string]string, len(toolDef.InputSchema.Properties))
@@ -101,24 +196,160 @@ func Handler(
 			return nil, nil, cfgErr
 		}
 
-		client, err := globalMATLAB.Client(ctx, logger)
+		client, functionArgs, err := getSessionClient(ctx, logger, args)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		functionArgs, err = withDefaults(functionArgs, toolDef.InputSchema)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		response, err := usecase.Execute(ctx, logger, client, evalcustomtool.Args{
+		request := evalcustomtool.Args{
 			Function:      toolSig.Function,
 			Order:         toolSig.Input.Order,
+			NameValue:     toolSig.Input.NameValue,
 			ArgumentTypes: argumentTypes,
-			Arguments:     args,
+			Arguments:     functionArgs,
 			CaptureOutput: !cfg.ShouldShowMATLABDesktop(),
-		})
+		}
+
+		if toolSig.Output == nil {
+			response, err := usecase.Execute(ctx, logger, client, request)
+			if result, interrupted := basetool.InterruptedEvaluationResult(err); interrupted {
+				return result, nil, nil
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return responseconverter.ConvertRichContentToCallToolResult(
+				responseconverter.ConvertEvalResponseToRichContent(response),
+			), nil, nil
+		}
+
+		structuredResponse, err := usecase.ExecuteWithStructuredOutput(ctx, logger, client, request, toolSig.Output.Names)
+		if result, interrupted := basetool.InterruptedEvaluationResult(err); interrupted {
+			return result, nil, nil
+		}
+		var structuredOutputErr *evalcustomtool.StructuredOutputError
+		if errors.As(err, &structuredOutputErr) {
+			logger.WithError(err).Warn("Failed to read the outputs of the custom tool")
+			return outputErrorResult(structuredOutputErr.Response, err), nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The outputs are validated here rather than by the SDK, so that the console output is still returned when they do not match.
+		if err := validateOutput(toolDef.OutputSchema, structuredResponse.Output); err != nil {
+			logger.WithError(err).Warn("The outputs of the custom tool do not match its output schema")
+			return outputErrorResult(structuredResponse.Response, err), nil, nil
+		}
+
+		// The console output and the serialized JSON are kept as text for clients that do not use structured content.
+		encodedOutput, err := json.Marshal(structuredResponse.Output)
 		if err != nil {
 			return nil, nil, err
 		}
+		richContent := responseconverter.ConvertEvalResponseToRichContent(structuredResponse.Response)
+		richContent.TextContent = append(richContent.TextContent, string(encodedOutput))
 
-		return responseconverter.ConvertRichContentToCallToolResult(
-			responseconverter.ConvertEvalResponseToRichContent(response),
-		), nil, nil
+		return responseconverter.ConvertRichContentToCallToolResult(richContent), structuredResponse.Output, nil
 	}
 }
+
+// outputErrorResult returns the console output of a custom tool call whose outputs cannot be returned, as an error result.
+func outputErrorResult(response entities.EvalResponse, err error) *mcp.CallToolResult {
+	richContent := responseconverter.ConvertEvalResponseToRichContent(response)
+	richContent.TextContent = append(richContent.TextContent, fmt.Sprintf("The outputs of the MATLAB function could not be returned: %v", err))
+
+	result := responseconverter.ConvertRichContentToCallToolResult(richContent)
+	result.IsError = true
+	return result
+}
+
+func validateOutput(outputSchema *jsonschema.Schema, output map[string]any) error {
+	if outputSchema == nil {
+		return nil
+	}
+
+	resolved, err := outputSchema.Resolve(nil)
+	if err != nil {
+		return fmt.Errorf("invalid output schema: %w", err)
+	}
+
+	return resolved.Validate(output)
+}
+
+func parseSessionID(value any) (entities.SessionID, error) {
+	number, ok := value.(float64)
+	if !ok || number != math.Trunc(number) {
+		return 0, fmt.Errorf("%s must be an integer, got %v", sessionIDArgument, value)
+	}
+	return entities.SessionID(number), nil
+}
+
+// onlySessionID returns the ID of the only MATLAB session, for tool calls that do not say in which session to run.
+func onlySessionID(ctx context.Context, logger entities.Logger, matlabManager MATLABManager) (entities.SessionID, error) {
+	sessions, err := matlabManager.ListMATLABSessions(ctx, logger)
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(sessions) {
+	case 0:
+		return 0, errors.New("no MATLAB session is running, start one with start_matlab_session")
+	case 1:
+		return sessions[0].SessionID, nil
+	default:
+		return 0, fmt.Errorf("%s is required when more than one MATLAB session is running", sessionIDArgument)
+	}
+}
+
+// withDefaults returns a copy of the arguments in which each omitted argument
+// with a default in the input schema is set to that default.
+func withDefaults(args map[string]any, inputSchema *jsonschema.Schema) (map[string]any, error) {
+	if inputSchema == nil {
+		return args, nil
+	}
+
+	result := make(map[string]any, len(inputSchema.Properties))
+	maps.Copy(result, args)
+
+	for name, prop := range inputSchema.Properties {
+		if prop.Default == nil {
+			continue
+		}
+		if _, provided := result[name]; provided {
+			continue
+		}
+
+		var value any
+		if err := json.Unmarshal(prop.Default, &value); err != nil {
+			return nil, fmt.Errorf("invalid default for argument %q: %w", name, err)
+		}
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+// withSessionIDArgument returns a copy of the input schema with the optional session_id argument added.
+func withSessionIDArgument(inputSchema *jsonschema.Schema) *jsonschema.Schema {
+	schema := &jsonschema.Schema{Type: "object"}
+	if inputSchema != nil {
+		schema = inputSchema.CloneSchemas()
+	}
+
+	if schema.Properties == nil {
+		schema.Properties = map[string]*jsonschema.Schema{}
+	}
+	schema.Properties[sessionIDArgument] = &jsonschema.Schema{
+		Type:        "integer",
+		Description: "The ID of the MATLAB session in which to run the tool. Can be omitted when only one MATLAB session is running.",
+	}
+
+	return schema
+}
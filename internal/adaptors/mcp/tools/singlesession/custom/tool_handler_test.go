@@ -3,6 +3,7 @@
 package custom_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
@@ -19,6 +20,7 @@ import (
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -94,6 +96,7 @@ func TestHandler_HappyPath(t *testing.T) {
 			args := map[string]any{"n": float64(5)}
 			req := &mcp.CallToolRequest{
 				Session: expectedSession,
+				Params:  progressRequestedParams(),
 			}
 
 			mockValidatedTool.EXPECT().
@@ -121,13 +124,13 @@ func TestHandler_HappyPath(t *testing.T) {
 				Once()
 
 			mockGlobalMATLAB.EXPECT().
-				Client(ctx, mockSessionLogger.AsMockArg()).
+				Client(toolCallContext(), mockSessionLogger.AsMockArg()).
 				Return(mockMATLABSessionClient, nil).
 				Once()
 
 			mockUsecase.EXPECT().
 				Execute(
-					ctx,
+					toolCallContext(),
 					mockSessionLogger.AsMockArg(),
 					mockMATLABSessionClient,
 					evalcustomtoolusecase.Args{
@@ -227,6 +230,7 @@ func TestHandler_NoArguments_HappyPath(t *testing.T) {
 			}
 			req := &mcp.CallToolRequest{
 				Session: expectedSession,
+				Params:  progressRequestedParams(),
 			}
 
 			mockValidatedTool.EXPECT().
@@ -254,13 +258,13 @@ func TestHandler_NoArguments_HappyPath(t *testing.T) {
 				Once()
 
 			mockGlobalMATLAB.EXPECT().
-				Client(ctx, mockSessionLogger.AsMockArg()).
+				Client(toolCallContext(), mockSessionLogger.AsMockArg()).
 				Return(mockMATLABSessionClient, nil).
 				Once()
 
 			mockUsecase.EXPECT().
 				Execute(
-					ctx,
+					toolCallContext(),
 					mockSessionLogger.AsMockArg(),
 					mockMATLABSessionClient,
 					evalcustomtoolusecase.Args{
@@ -291,6 +295,524 @@ func TestHandler_NoArguments_HappyPath(t *testing.T) {
 	}
 }
 
+func TestHandler_OptionalAndNameValueArguments_AppliesDefaults(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        "smooth_data",
+		Title:       "Smooth Data",
+		Description: "Smooths data",
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"data":       {Type: "array", Items: &jsonschema.Schema{Type: "number"}},
+				"window":     {Type: "integer", Default: []byte("5")},
+				"Method":     {Type: "string", Default: []byte(`"movmean"`)},
+				"SampleRate": {Type: "number"},
+			},
+			Required: []string{"data"},
+		},
+	}
+	expectedSignature := definition.Signature{
+		Function: "smoothdata",
+		Input: definition.SignatureInput{
+			Order:     []string{"data", "window"},
+			NameValue: []string{"Method", "SampleRate"},
+		},
+	}
+	args := map[string]any{"data": []any{float64(1), float64(2)}, "Method": "gaussian"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "ans = 1.5"}
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			toolCallContext(),
+			mockSessionLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalcustomtoolusecase.Args{
+				Function:  "smoothdata",
+				Order:     []string{"data", "window"},
+				NameValue: []string{"Method", "SampleRate"},
+				ArgumentTypes: map[string]string{
+					"data":       "array",
+					"window":     "integer",
+					"Method":     "string",
+					"SampleRate": "number",
+				},
+				Arguments: map[string]any{
+					"data":   []any{float64(1), float64(2)},
+					"window": float64(5),
+					"Method": "gaussian",
+				},
+				CaptureOutput: true,
+			},
+		).
+		Return(expectedResponse, nil).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, map[string]any{"data": []any{float64(1), float64(2)}, "Method": "gaussian"}, args, "caller arguments should not be modified")
+}
+
+func TestHandler_StructuredOutput_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        "compute_stats",
+		Title:       "Compute Stats",
+		Description: "Computes statistics",
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"x": {Type: "array", Items: &jsonschema.Schema{Type: "number"}},
+			},
+			Required: []string{"x"},
+		},
+		OutputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"mean": {Type: "number"},
+				"std":  {Type: "number"},
+			},
+		},
+	}
+	expectedSignature := definition.Signature{
+		Function: "compute_stats",
+		Input:    definition.SignatureInput{Order: []string{"x"}},
+		Output:   &definition.SignatureOutput{Names: []string{"mean", "std"}},
+	}
+	args := map[string]any{"x": []any{float64(1), float64(3)}}
+	expectedOutput := map[string]any{"mean": float64(2), "std": 1.5}
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ExecuteWithStructuredOutput(
+			toolCallContext(),
+			mockSessionLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalcustomtoolusecase.Args{
+				Function:      "compute_stats",
+				Order:         []string{"x"},
+				ArgumentTypes: map[string]string{"x": "array"},
+				Arguments:     args,
+				CaptureOutput: true,
+			},
+			[]string{"mean", "std"},
+		).
+		Return(evalcustomtoolusecase.StructuredResponse{
+			Response: entities.EvalResponse{ConsoleOutput: "Computing stats"},
+			Output:   expectedOutput,
+		}, nil).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, output, err := handler(ctx, req, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, output)
+	require.NotNil(t, result)
+	require.Len(t, result.Content, 2)
+
+	consoleContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "Computing stats", consoleContent.Text)
+
+	jsonContent, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"mean":2,"std":1.5}`, jsonContent.Text)
+}
+
+func TestHandler_StructuredOutput_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedError := assert.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{Name: "compute_stats", InputSchema: &jsonschema.Schema{Type: "object"}}).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{
+			Function: "compute_stats",
+			Output:   &definition.SignatureOutput{Names: []string{"mean"}},
+		}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ExecuteWithStructuredOutput(toolCallContext(), mockSessionLogger.AsMockArg(), mockMATLABSessionClient, mock.Anything, []string{"mean"}).
+		Return(evalcustomtoolusecase.StructuredResponse{}, expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, output, err := handler(t.Context(), req, map[string]any{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+	assert.Nil(t, output)
+}
+
+func TestHandler_StructuredOutput_UnreadableOutput_ReturnsConsoleOutputAsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedConsoleOutput := "Error using compute_stats\nNot enough input arguments."
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{Name: "compute_stats", InputSchema: &jsonschema.Schema{Type: "object"}}).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{
+			Function: "compute_stats",
+			Output:   &definition.SignatureOutput{Names: []string{"mean"}},
+		}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ExecuteWithStructuredOutput(toolCallContext(), mockSessionLogger.AsMockArg(), mockMATLABSessionClient, mock.Anything, []string{"mean"}).
+		Return(evalcustomtoolusecase.StructuredResponse{}, &evalcustomtoolusecase.StructuredOutputError{
+			Err:      evalcustomtoolusecase.ErrNoStructuredOutput,
+			Response: entities.EvalResponse{ConsoleOutput: expectedConsoleOutput},
+		}).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, output, err := handler(t.Context(), req, map[string]any{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, output)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	require.Len(t, result.Content, 2)
+
+	consoleContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, expectedConsoleOutput, consoleContent.Text)
+
+	errorContent, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Contains(t, errorContent.Text, evalcustomtoolusecase.ErrNoStructuredOutput.Error())
+}
+
+func TestHandler_StructuredOutput_OutputSchemaMismatch_ReturnsConsoleOutputAsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{
+			Name:        "compute_stats",
+			InputSchema: &jsonschema.Schema{Type: "object"},
+			OutputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"mean": {Type: "number"},
+				},
+			},
+		}).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{
+			Function: "compute_stats",
+			Output:   &definition.SignatureOutput{Names: []string{"mean"}},
+		}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ExecuteWithStructuredOutput(toolCallContext(), mockSessionLogger.AsMockArg(), mockMATLABSessionClient, mock.Anything, []string{"mean"}).
+		Return(evalcustomtoolusecase.StructuredResponse{
+			Response: entities.EvalResponse{ConsoleOutput: "Computing stats"},
+			Output:   map[string]any{"mean": "not a number"},
+		}, nil).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, output, err := handler(t.Context(), req, map[string]any{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, output)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	require.Len(t, result.Content, 2)
+
+	consoleContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "Computing stats", consoleContent.Text)
+
+	errorContent, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Contains(t, errorContent.Text, "mean")
+}
+
 func TestHandler_ConfigError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
@@ -324,9 +846,266 @@ func TestHandler_ConfigError(t *testing.T) {
 		},
 	}
 	args := map[string]any{"n": float64(5)}
-	expectedError := messages.AnError
+	expectedError := messages.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	_, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestHandler_ClientError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        "generate_magic_square",
+		Title:       "Generate Magic Square",
+		Description: "Generates a magic square",
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"n": {Type: "number", Description: "Size"},
+			},
+			Required: []string{"n"},
+		},
+	}
+	args := map[string]any{"n": float64(5)}
+	expectedError := assert.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	_, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        "generate_magic_square",
+		Title:       "Generate Magic Square",
+		Description: "Generates a magic square",
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"n": {Type: "number", Description: "Size"},
+			},
+			Required: []string{"n"},
+		},
+	}
+	expectedSignature := definition.Signature{
+		Function: "magic",
+		Input:    definition.SignatureInput{Order: []string{"n"}},
+	}
+	shouldShowMATLABDesktop := false
+	args := map[string]any{"n": float64(5)}
+	expectedError := assert.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(shouldShowMATLABDesktop).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			toolCallContext(),
+			mockSessionLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalcustomtoolusecase.Args{
+				Function:      "magic",
+				Order:         []string{"n"},
+				ArgumentTypes: map[string]string{"n": "number"},
+				Arguments:     args,
+				CaptureOutput: !shouldShowMATLABDesktop,
+			},
+		).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	_, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestHandler_EvaluationCancelled(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        "generate_magic_square",
+		Title:       "Generate Magic Square",
+		Description: "Generates a magic square",
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"n": {Type: "number", Description: "Size"},
+			},
+			Required: []string{"n"},
+		},
+	}
+	expectedSignature := definition.Signature{
+		Function: "magic",
+		Input:    definition.SignatureInput{Order: []string{"n"}},
+	}
+	shouldShowMATLABDesktop := false
+	args := map[string]any{"n": float64(5)}
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params:  progressRequestedParams(),
 	}
 
 	mockValidatedTool.EXPECT().
@@ -335,7 +1114,7 @@ func TestHandler_ConfigError(t *testing.T) {
 		Once()
 	mockValidatedTool.EXPECT().
 		Signature().
-		Return(definition.Signature{}).
+		Return(expectedSignature).
 		Once()
 
 	mockLoggerFactory.EXPECT().
@@ -345,6 +1124,85 @@ func TestHandler_ConfigError(t *testing.T) {
 
 	mockConfigFactory.EXPECT().
 		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(shouldShowMATLABDesktop).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			toolCallContext(),
+			mockSessionLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalcustomtoolusecase.Args{
+				Function:      "magic",
+				Order:         []string{"n"},
+				ArgumentTypes: map[string]string{"n": "number"},
+				Arguments:     args,
+				CaptureOutput: !shouldShowMATLABDesktop,
+			},
+		).
+		Return(entities.EvalResponse{}, entities.ErrEvaluationCancelled).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, output, err := handler(ctx, req, args)
+
+	// Assert
+	require.NoError(t, err, "A cancelled evaluation should be reported as a tool result")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "cancelled")
+	assert.Nil(t, output)
+}
+
+func TestHandler_LoggerFactoryError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	args := map[string]any{"n": float64(5)}
+	expectedError := messages.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+		Params:  progressRequestedParams(),
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{}).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
 		Return(nil, expectedError).
 		Once()
 
@@ -357,7 +1215,20 @@ func TestHandler_ConfigError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 }
 
-func TestHandler_ClientError(t *testing.T) {
+// toolCallContext matches the context handed on by the handler, which carries a progress reporter for the tool call.
+func progressRequestedParams() *mcp.CallToolParamsRaw {
+	return &mcp.CallToolParamsRaw{
+		Meta: mcp.Meta{"progressToken": "progress-token"},
+	}
+}
+
+func toolCallContext() any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return entities.EvalProgressReporterFromContext(ctx) != nil
+	})
+}
+
+func TestMultiSessionHandler_WithSessionID_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
@@ -371,8 +1242,11 @@ func TestHandler_ClientError(t *testing.T) {
 	mockUsecase := &custommocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockMATLABManager := &custommocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
 
 	mockSessionLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
@@ -380,10 +1254,9 @@ func TestHandler_ClientError(t *testing.T) {
 	mockValidatedTool := &definitionmocks.MockValidatedTool{}
 	defer mockValidatedTool.AssertExpectations(t)
 
+	const sessionID = 2
 	expectedDefinition := definition.Tool{
-		Name:        "generate_magic_square",
-		Title:       "Generate Magic Square",
-		Description: "Generates a magic square",
+		Name: "generate_magic_square",
 		InputSchema: &jsonschema.Schema{
 			Type: "object",
 			Properties: map[string]*jsonschema.Schema{
@@ -392,10 +1265,15 @@ func TestHandler_ClientError(t *testing.T) {
 			Required: []string{"n"},
 		},
 	}
-	args := map[string]any{"n": float64(5)}
-	expectedError := assert.AnError
+	expectedSignature := definition.Signature{
+		Function: "magic",
+		Input:    definition.SignatureInput{Order: []string{"n"}},
+	}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "    17    24     1     8    15"}
+	args := map[string]any{"n": float64(5), "session_id": float64(sessionID)}
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params:  progressRequestedParams(),
 	}
 
 	mockValidatedTool.EXPECT().
@@ -404,7 +1282,7 @@ func TestHandler_ClientError(t *testing.T) {
 		Once()
 	mockValidatedTool.EXPECT().
 		Signature().
-		Return(definition.Signature{}).
+		Return(expectedSignature).
 		Once()
 
 	mockLoggerFactory.EXPECT().
@@ -417,21 +1295,49 @@ func TestHandler_ClientError(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
-	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockSessionLogger.AsMockArg()).
-		Return(nil, expectedError).
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(toolCallContext(), mockSessionLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			toolCallContext(),
+			mockSessionLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalcustomtoolusecase.Args{
+				Function:      "magic",
+				Order:         []string{"n"},
+				ArgumentTypes: map[string]string{"n": "number"},
+				Arguments:     map[string]any{"n": float64(5)},
+				CaptureOutput: true,
+			},
+		).
+		Return(expectedResponse, nil).
+		Once()
+
+	handler := custom.MultiSessionHandler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
 
 	// Act
-	_, _, err := handler(ctx, req, args)
+	result, _, err := handler(ctx, req, args)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Content, 1)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, expectedResponse.ConsoleOutput, textContent.Text)
+	assert.Contains(t, args, "session_id", "Handler should not modify the arguments of the request")
 }
 
-func TestHandler_UsecaseError(t *testing.T) {
+func TestMultiSessionHandler_WithoutSessionID_UsesOnlySession(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
@@ -445,8 +1351,8 @@ func TestHandler_UsecaseError(t *testing.T) {
 	mockUsecase := &custommocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockMATLABManager := &custommocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -457,10 +1363,9 @@ func TestHandler_UsecaseError(t *testing.T) {
 	mockValidatedTool := &definitionmocks.MockValidatedTool{}
 	defer mockValidatedTool.AssertExpectations(t)
 
+	const sessionID = 2
 	expectedDefinition := definition.Tool{
-		Name:        "generate_magic_square",
-		Title:       "Generate Magic Square",
-		Description: "Generates a magic square",
+		Name: "generate_magic_square",
 		InputSchema: &jsonschema.Schema{
 			Type: "object",
 			Properties: map[string]*jsonschema.Schema{
@@ -473,11 +1378,11 @@ func TestHandler_UsecaseError(t *testing.T) {
 		Function: "magic",
 		Input:    definition.SignatureInput{Order: []string{"n"}},
 	}
-	shouldShowMATLABDesktop := false
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "    17    24     1     8    15"}
 	args := map[string]any{"n": float64(5)}
-	expectedError := assert.AnError
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params:  progressRequestedParams(),
 	}
 
 	mockValidatedTool.EXPECT().
@@ -501,40 +1406,50 @@ func TestHandler_UsecaseError(t *testing.T) {
 
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
-		Return(shouldShowMATLABDesktop).
+		Return(false).
 		Once()
 
-	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockSessionLogger.AsMockArg()).
+	mockMATLABManager.EXPECT().
+		ListMATLABSessions(toolCallContext(), mockSessionLogger.AsMockArg()).
+		Return([]entities.MATLABSessionInfo{{SessionID: sessionID, IsAlive: true}}, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(toolCallContext(), mockSessionLogger.AsMockArg(), entities.SessionID(sessionID)).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
 	mockUsecase.EXPECT().
 		Execute(
-			ctx,
+			toolCallContext(),
 			mockSessionLogger.AsMockArg(),
 			mockMATLABSessionClient,
 			evalcustomtoolusecase.Args{
 				Function:      "magic",
 				Order:         []string{"n"},
 				ArgumentTypes: map[string]string{"n": "number"},
-				Arguments:     args,
-				CaptureOutput: !shouldShowMATLABDesktop,
+				Arguments:     map[string]any{"n": float64(5)},
+				CaptureOutput: true,
 			},
 		).
-		Return(entities.EvalResponse{}, expectedError).
+		Return(expectedResponse, nil).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	handler := custom.MultiSessionHandler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
 
 	// Act
-	_, _, err := handler(ctx, req, args)
+	result, _, err := handler(ctx, req, args)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError)
-}
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Content, 1)
 
-func TestHandler_LoggerFactoryError(t *testing.T) {
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, expectedResponse.ConsoleOutput, textContent.Text)
+}
+func TestMultiSessionHandler_InvalidSessionID(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
@@ -542,26 +1457,30 @@ func TestHandler_LoggerFactoryError(t *testing.T) {
 	mockConfigFactory := &custommocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockUsecase := &custommocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockMATLABManager := &custommocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
 
+	mockSessionLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	expectedSession := &mcp.ServerSession{}
 	mockValidatedTool := &definitionmocks.MockValidatedTool{}
 	defer mockValidatedTool.AssertExpectations(t)
 
-	args := map[string]any{"n": float64(5)}
-	expectedError := messages.AnError
+	args := map[string]any{"session_id": 1.5}
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
+		Params:  progressRequestedParams(),
 	}
 
 	mockValidatedTool.EXPECT().
 		Definition().
-		Return(definition.Tool{}).
+		Return(definition.Tool{Name: "generate_magic_square"}).
 		Once()
 	mockValidatedTool.EXPECT().
 		Signature().
@@ -570,14 +1489,104 @@ func TestHandler_LoggerFactoryError(t *testing.T) {
 
 	mockLoggerFactory.EXPECT().
 		NewMCPSessionLogger(expectedSession).
-		Return(nil, expectedError).
+		Return(mockSessionLogger, nil).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	handler := custom.MultiSessionHandler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
 
 	// Act
-	_, _, err := handler(ctx, req, args)
+	result, _, err := handler(ctx, req, args)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError)
+	require.ErrorContains(t, err, "session_id must be an integer")
+	assert.Nil(t, result)
+}
+
+func TestMultiSessionHandler_WithoutSessionID_NotOneSession(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sessions      []entities.MATLABSessionInfo
+		expectedError string
+	}{
+		{
+			name:          "no sessions",
+			sessions:      nil,
+			expectedError: "no MATLAB session is running",
+		},
+		{
+			name:          "several sessions",
+			sessions:      []entities.MATLABSessionInfo{{SessionID: 1}, {SessionID: 2}},
+			expectedError: "session_id is required when more than one MATLAB session is running",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfigFactory := &custommocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockUsecase := &custommocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockMATLABManager := &custommocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockSessionLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			expectedSession := &mcp.ServerSession{}
+			mockValidatedTool := &definitionmocks.MockValidatedTool{}
+			defer mockValidatedTool.AssertExpectations(t)
+
+			args := map[string]any{"n": float64(5)}
+			req := &mcp.CallToolRequest{
+				Session: expectedSession,
+				Params:  progressRequestedParams(),
+			}
+
+			mockValidatedTool.EXPECT().
+				Definition().
+				Return(definition.Tool{Name: "generate_magic_square"}).
+				Once()
+			mockValidatedTool.EXPECT().
+				Signature().
+				Return(definition.Signature{}).
+				Once()
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(expectedSession).
+				Return(mockSessionLogger, nil).
+				Once()
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockMATLABManager.EXPECT().
+				ListMATLABSessions(toolCallContext(), mockSessionLogger.AsMockArg()).
+				Return(tc.sessions, nil).
+				Once()
+
+			handler := custom.MultiSessionHandler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
+
+			// Act
+			result, _, err := handler(ctx, req, args)
+
+			// Assert
+			require.ErrorContains(t, err, tc.expectedError)
+			assert.Nil(t, result)
+		})
+	}
 }
@@ -11,6 +11,7 @@ import (
 	basetoolmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	definitionmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/custom/definition"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -80,3 +81,119 @@ func TestTool_AddToServer_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 }
+
+func TestTool_AddToServer_WithOutputSchema(t *testing.T) {
+	// Arrange
+	mockAdder := &basetoolmocks.MockToolAdder[map[string]any, any]{}
+	defer mockAdder.AssertExpectations(t)
+
+	expectedInputSchema := &jsonschema.Schema{Type: "object"}
+	expectedOutputSchema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"mean": {Type: "number"},
+		},
+	}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:         testToolName,
+		Title:        testToolTitle,
+		Description:  testToolDescription,
+		InputSchema:  expectedInputSchema,
+		OutputSchema: expectedOutputSchema,
+	}
+	expectedServer := mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{})
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Twice()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	mockAdder.EXPECT().
+		AddTool(
+			expectedServer,
+			&mcp.Tool{
+				Name:         testToolName,
+				Title:        testToolTitle,
+				Description:  testToolDescription,
+				InputSchema:  expectedInputSchema,
+				OutputSchema: expectedOutputSchema,
+			},
+			mock.Anything,
+		).
+		Once()
+
+	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil)
+	tool.SetToolAdder(mockAdder)
+
+	// Act
+	err := tool.AddToServer(expectedServer)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMultiSessionTool_AddToServer_AddsSessionIDArgument(t *testing.T) {
+	// Arrange
+	mockAdder := &basetoolmocks.MockToolAdder[map[string]any, any]{}
+	defer mockAdder.AssertExpectations(t)
+
+	definitionInputSchema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"n": {Type: "number", Description: "Size"},
+		},
+		Required: []string{"n"},
+	}
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedDefinition := definition.Tool{
+		Name:        testToolName,
+		Title:       testToolTitle,
+		Description: testToolDescription,
+		InputSchema: definitionInputSchema,
+	}
+	expectedServer := mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{})
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Twice()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	var addedTool *mcp.Tool
+	mockAdder.EXPECT().
+		AddTool(expectedServer, mock.Anything, mock.Anything).
+		Run(func(_ *mcp.Server, tool *mcp.Tool, _ mcp.ToolHandlerFor[map[string]any, any]) {
+			addedTool = tool
+		}).
+		Once()
+
+	tool := custom.NewMultiSessionTool(mockValidatedTool, nil, nil, nil, nil)
+	tool.SetToolAdder(mockAdder)
+
+	// Act
+	err := tool.AddToServer(expectedServer)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, addedTool)
+	inputSchema, ok := addedTool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok)
+	require.Contains(t, inputSchema.Properties, "session_id")
+	require.Contains(t, inputSchema.Properties, "n")
+	assert.Equal(t, "integer", inputSchema.Properties["session_id"].Type)
+	assert.Equal(t, []string{"n"}, inputSchema.Required, "session_id should be optional")
+	assert.NotContains(t, definitionInputSchema.Properties, "session_id", "The tool definition should not be modified")
+	assert.Equal(t, []string{"n"}, definitionInputSchema.Required, "The tool definition should not be modified")
+}
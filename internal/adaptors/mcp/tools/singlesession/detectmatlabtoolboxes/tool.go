@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package detectmatlabtoolboxes
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 )
@@ -42,19 +43,24 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		sessionLogger.Info("Executing detect MATLAB toolboxes tool")
 		defer sessionLogger.Info("Done - Executing detect MATLAB toolboxes tool")
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
-		if err != nil {
-			return ReturnArgs{}, err
-		}
+		return Run(ctx, sessionLogger, usecase, globalMATLAB.Client)
+	}
+}
 
-		tbxInfo, err := usecase.Execute(ctx, sessionLogger, client)
+// Run detects the MATLAB toolboxes installed for the MATLAB session returned by getClient.
+func Run(ctx context.Context, sessionLogger entities.Logger, usecase Usecase, getClient sessionclient.Getter) (ReturnArgs, error) {
+	client, err := getClient(ctx, sessionLogger)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
 
-		if err != nil {
-			return ReturnArgs{}, err
-		}
+	tbxInfo, err := usecase.Execute(ctx, sessionLogger, client)
 
-		return ReturnArgs{
-			InstallationInfo: tbxInfo.Toolboxes,
-		}, nil
+	if err != nil {
+		return ReturnArgs{}, err
 	}
+
+	return ReturnArgs{
+		InstallationInfo: tbxInfo.Toolboxes,
+	}, nil
 }
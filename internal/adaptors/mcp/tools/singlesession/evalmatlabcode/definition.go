@@ -9,6 +9,7 @@ const (
 )
 
 type Args struct {
-	ProjectPath string `json:"project_path,omitempty" jsonschema:"(Optional) Absolute path to the project folder. When provided, MATLAB sets this as the current working folder. If omitted, code runs in MATLAB's current working folder. Example: C:\\Users\\username\\matlab-project or /home/user/research."`
-	Code        string `json:"code"                   jsonschema:"The MATLAB code to evaluate."`
+	ProjectPath    string `json:"project_path,omitempty"    jsonschema:"(Optional) Absolute path to the project folder. When provided, MATLAB sets this as the current working folder. If omitted, code runs in MATLAB's current working folder. Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code           string `json:"code"                      jsonschema:"The MATLAB code to evaluate."`
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"(Optional) Maximum number of seconds MATLAB may spend on this call. When the time runs out, MATLAB execution is interrupted and a timeout error is returned with any output captured so far. If omitted, the server default timeout applies."`
 }
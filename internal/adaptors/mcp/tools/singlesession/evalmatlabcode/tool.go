@@ -9,6 +9,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/evaltimeout"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
@@ -48,6 +49,11 @@ func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities
 			return tools.RichContent{}, messagesErr
 		}
 
+		timeout, err := evaltimeout.Resolve(inputs.TimeoutSeconds, config.DefaultEvalTimeout())
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
 		client, err := globalMATLAB.Client(ctx, sessionLogger)
 		if err != nil {
 			return tools.RichContent{}, err
@@ -57,6 +63,7 @@ func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities
 			Code:          inputs.Code,
 			ProjectPath:   inputs.ProjectPath,
 			CaptureOutput: !config.ShouldShowMATLABDesktop(),
+			Timeout:       timeout,
 		})
 		if err != nil {
 			return tools.RichContent{}, err
@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode
+
+const (
+	name        = "fix_matlab_code"
+	title       = "Fix MATLAB Code"
+	description = "Apply the automatic fixes suggested by MATLAB's Code Analyzer to a MATLAB script (`script_path`) in an existing MATLAB session, and return a unified diff of the file before and after the fixes. By default every issue that `check_matlab_code` reports as fixable is fixed; specify `check_ids` to fix only issues with those Code Analyzer check IDs. The file is modified in place. Requires MATLAB R2023a or later."
+)
+
+type Args struct {
+	ScriptPath string   `json:"script_path"         jsonschema:"The full absolute path to the MATLAB script file to fix. Must be a .m file that exists. Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+	CheckIDs   []string `json:"check_ids,omitempty" jsonschema:"(Optional) Only fix issues with these Code Analyzer check IDs, as reported in the check_id field of the Check MATLAB Code tool. Example: [\"NOPRT\", \"NASGU\"]. If omitted, every auto-fixable issue is fixed."`
+}
+
+type ReturnArgs struct {
+	FixedIssueCount int    `json:"fixed_issue_count" jsonschema:"Number of issues selected for fixing that Code Analyzer no longer reports after the fixes."`
+	Diff            string `json:"diff"              jsonschema:"Unified diff of the file before and after the fixes. Empty when the file did not change."`
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request fixmatlabcode.Args) (fixmatlabcode.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Fix MATLAB code tool")
+		defer sessionLogger.Info("Done - Executing Fix MATLAB code tool")
+
+		return Run(ctx, sessionLogger, usecase, globalMATLAB.Client, inputs)
+	}
+}
+
+// Run fixes MATLAB code in the MATLAB session returned by getClient.
+func Run(ctx context.Context, sessionLogger entities.Logger, usecase Usecase, getClient sessionclient.Getter, inputs Args) (ReturnArgs, error) {
+	client, err := getClient(ctx, sessionLogger)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := usecase.Execute(ctx, sessionLogger, client, fixmatlabcode.Args{
+		ScriptPath: inputs.ScriptPath,
+		CheckIDs:   inputs.CheckIDs,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return ReturnArgs{
+		FixedIssueCount: response.FixedIssueCount,
+		Diff:            response.Diff,
+	}, nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog
+
+const (
+	name        = "get_matlab_session_log"
+	title       = "Get MATLAB Session Log"
+	description = "Returns the last lines of the output (`stdout`) and errors (`stderr`) of the MATLAB session started by this server, " +
+		"along with the startup error (`startup_error`) and the crash dumps (`crash_dumps`) written by MATLAB, if any. " +
+		"Returns the log of the current MATLAB session, unless the ID (`session_id`) of a MATLAB session that stopped unexpectedly is given. " +
+		"Use it to find out why MATLAB failed or stopped responding."
+)
+
+type Args struct {
+	SessionID int `json:"session_id,omitempty" jsonschema:"(Optional) The ID of a MATLAB session that stopped unexpectedly, as reported when it stopped. Defaults to the current MATLAB session."`
+	MaxLines  int `json:"max_lines,omitempty"  jsonschema:"(Optional) The number of lines of output and errors to return. Defaults to 100."`
+}
+
+type ReturnArgs struct {
+	SessionID    int         `json:"session_id"              jsonschema:"The ID of the MATLAB session."`
+	Stdout       string      `json:"stdout"                  jsonschema:"The last lines of the output of MATLAB."`
+	Stderr       string      `json:"stderr"                  jsonschema:"The last lines of the errors written by MATLAB."`
+	StartupError string      `json:"startup_error,omitempty" jsonschema:"The error that prevented the MCP initialization of MATLAB, if any."`
+	CrashDumps   []CrashDump `json:"crash_dumps"             jsonschema:"The crash dumps written by MATLAB, if any."`
+}
+
+type CrashDump struct {
+	FileName string `json:"file_name" jsonschema:"The name of the crash dump file."`
+	Content  string `json:"content"   jsonschema:"The content of the crash dump file."`
+}
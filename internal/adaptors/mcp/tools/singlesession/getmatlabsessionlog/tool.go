@@ -0,0 +1,82 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+)
+
+var ErrNoMATLABSession = errors.New("no MATLAB session was started yet, so there is no MATLAB session log to return")
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, args getmatlabsessionlog.Args) (getmatlabsessionlog.ReturnArgs, error)
+}
+
+type GlobalMATLAB interface {
+	SessionID() (entities.SessionID, bool)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+// Handler returns the log of the MATLAB session given by the session_id argument, or of the global MATLAB session when it is not given.
+func Handler(usecase Usecase, globalMATLAB GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing get MATLAB session log tool")
+		defer sessionLogger.Info("Done - Executing get MATLAB session log tool")
+
+		sessionID := entities.SessionID(inputs.SessionID)
+		if inputs.SessionID == 0 {
+			var found bool
+			if sessionID, found = globalMATLAB.SessionID(); !found {
+				return ReturnArgs{}, ErrNoMATLABSession
+			}
+		}
+
+		return Run(ctx, sessionLogger, usecase, sessionID, inputs.MaxLines)
+	}
+}
+
+// Run returns the last maxLines lines of the log of the MATLAB session with the given ID.
+func Run(ctx context.Context, sessionLogger entities.Logger, usecase Usecase, sessionID entities.SessionID, maxLines int) (ReturnArgs, error) {
+	sessionLog, err := usecase.Execute(ctx, sessionLogger, getmatlabsessionlog.Args{
+		SessionID: sessionID,
+		MaxLines:  maxLines,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	crashDumps := make([]CrashDump, len(sessionLog.CrashDumps))
+	for i, crashDump := range sessionLog.CrashDumps {
+		crashDumps[i] = CrashDump{
+			FileName: crashDump.FileName,
+			Content:  crashDump.Content,
+		}
+	}
+
+	return ReturnArgs{
+		SessionID:    int(sessionID),
+		Stdout:       sessionLog.Stdout,
+		Stderr:       sessionLog.Stderr,
+		StartupError: sessionLog.StartupError,
+		CrashDumps:   crashDumps,
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getmatlabsessionlogusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := getmatlabsessionlog.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(entities.SessionID(1), true).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(1), MaxLines: 50}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{
+			Stdout: "MATLAB is starting",
+			Stderr: "Warning: low memory",
+			CrashDumps: []entities.MATLABCrashDump{
+				{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabsessionlog.Args{MaxLines: 50})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabsessionlog.ReturnArgs{
+		SessionID: 1,
+		Stdout:    "MATLAB is starting",
+		Stderr:    "Warning: low memory",
+		CrashDumps: []getmatlabsessionlog.CrashDump{
+			{FileName: "matlab_crash_dump.1234-1", Content: "Segmentation violation"},
+		},
+	}, result)
+}
+
+func TestTool_Handler_SessionIDGiven(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(2)}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{Stderr: "MATLAB crashed"}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: 2})
+
+	// Assert
+	require.NoError(t, err)
+	mockGlobalMATLAB.AssertNotCalled(t, "SessionID")
+	assert.Equal(t, 2, result.SessionID)
+	assert.Equal(t, "MATLAB crashed", result.Stderr)
+	assert.NotNil(t, result.CrashDumps, "CrashDumps should be an empty list rather than null")
+	assert.Empty(t, result.CrashDumps)
+}
+
+func TestTool_Handler_NoMATLABSession(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(entities.SessionID(0), false).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabsessionlog.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, getmatlabsessionlog.ErrNoMATLABSession)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(entities.SessionID(1), true).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabsessionlogusecase.Args{SessionID: entities.SessionID(1)}).
+		Return(getmatlabsessionlogusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := getmatlabsessionlog.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabsessionlog.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestGetMATLABSessionLog_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := getmatlabsessionlog.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, annotations.NewReadOnlyAnnotations(), tool.Annotations(), "Tool should have read-only annotations")
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listsharedmatlabsessions
+
+const (
+	name        = "list_shared_matlab_sessions"
+	title       = "List Shared MATLAB Sessions"
+	description = "List the running MATLAB sessions that were shared with `shareMATLABSession` and can be attached to, most recently shared first. Use `attach_to_shared_matlab_session` with a session's `pid` to switch to it."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	SharedSessions []SharedSession `json:"shared_sessions" jsonschema:"The shared MATLAB sessions, most recently shared first."`
+}
+
+type SharedSession struct {
+	PID           int    `json:"pid"            jsonschema:"The process ID of the MATLAB session."`
+	Name          string `json:"name,omitempty" jsonschema:"The name given to the session when it was shared."`
+	Release       string `json:"release"        jsonschema:"The MATLAB release, for example R2026a."`
+	WorkingFolder string `json:"working_folder" jsonschema:"The MATLAB current folder at the time the session was shared."`
+	SharedAt      string `json:"shared_at"      jsonschema:"When the session was shared, in RFC 3339 format."`
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listsharedmatlabsessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsharedmatlabsessions"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) listsharedmatlabsessions.ReturnArgs
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing list shared MATLAB sessions tool")
+		defer sessionLogger.Info("Done - Executing list shared MATLAB sessions tool")
+
+		sharedSessions := usecase.Execute(ctx, sessionLogger)
+
+		return ReturnArgs{
+			SharedSessions: convertToAnnotatedEquivalentType(sharedSessions),
+		}, nil
+	}
+}
+
+func convertToAnnotatedEquivalentType(sharedSessions listsharedmatlabsessions.ReturnArgs) []SharedSession {
+	convertedSharedSessions := make([]SharedSession, len(sharedSessions))
+	for i, session := range sharedSessions {
+		var sharedAt string
+		if !session.SharedAt.IsZero() {
+			sharedAt = session.SharedAt.UTC().Format(time.RFC3339)
+		}
+
+		convertedSharedSessions[i] = SharedSession{
+			PID:           session.ProcessID,
+			Name:          session.Name,
+			Release:       session.Release,
+			WorkingFolder: session.WorkingFolder,
+			SharedAt:      sharedAt,
+		}
+	}
+	return convertedSharedSessions
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listsharedmatlabsessions_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := listsharedmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	workingFolder := filepath.Join("home", "user", "work")
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return([]entities.SharedMATLABSession{
+			{ProcessID: 1234, Name: "analysis", Release: "R2026a", WorkingFolder: workingFolder, SharedAt: time.Unix(1767225600, 0)},
+			{ProcessID: 5678, Release: "R2025b"},
+		}).
+		Once()
+
+	// Act
+	result, err := listsharedmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listsharedmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []listsharedmatlabsessions.SharedSession{
+		{PID: 1234, Name: "analysis", Release: "R2026a", WorkingFolder: workingFolder, SharedAt: "2026-01-01T00:00:00Z"},
+		{PID: 5678, Release: "R2025b"},
+	}, result.SharedSessions)
+	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be created")
+}
+
+func TestTool_Handler_NoSharedSessions(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	// Act
+	result, err := listsharedmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listsharedmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.SharedSessions, "Shared sessions should be an empty list rather than null")
+	assert.Empty(t, result.SharedSessions)
+}
+
+func TestListSharedMATLABSessions_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := listsharedmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.Equal(t, annotations.NewReadOnlyAnnotations(), tool.Annotations(), "Tool should have read-only annotations")
+}
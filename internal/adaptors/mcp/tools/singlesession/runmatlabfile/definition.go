@@ -9,5 +9,6 @@ const (
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to execute. Must be a .m file that exists. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	ScriptPath     string `json:"script_path"               jsonschema:"The full absolute path to the MATLAB script file to execute. Must be a .m file that exists. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"(Optional) Maximum number of seconds MATLAB may spend on this call. When the time runs out, MATLAB execution is interrupted and a timeout error is returned with any output captured so far. If omitted, the server default timeout applies."`
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/evaltimeout"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
@@ -43,24 +45,35 @@ func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities
 		sessionLogger.Info("Executing Run MATLAB File tool")
 		defer sessionLogger.Info("Done - Executing Run MATLAB File tool")
 
-		config, messagesErr := configFactory.Config()
-		if messagesErr != nil {
-			return tools.RichContent{}, messagesErr
-		}
+		return Run(ctx, sessionLogger, configFactory, usecase, globalMATLAB.Client, inputs)
+	}
+}
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
-		if err != nil {
-			return tools.RichContent{}, err
-		}
+// Run runs a MATLAB script file in the MATLAB session returned by getClient.
+func Run(ctx context.Context, sessionLogger entities.Logger, configFactory ConfigFactory, usecase Usecase, getClient sessionclient.Getter, inputs Args) (tools.RichContent, error) {
+	config, messagesErr := configFactory.Config()
+	if messagesErr != nil {
+		return tools.RichContent{}, messagesErr
+	}
 
-		response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabfile.Args{
-			ScriptPath:    inputs.ScriptPath,
-			CaptureOutput: !config.ShouldShowMATLABDesktop(),
-		})
-		if err != nil {
-			return tools.RichContent{}, err
-		}
+	timeout, err := evaltimeout.Resolve(inputs.TimeoutSeconds, config.DefaultEvalTimeout())
+	if err != nil {
+		return tools.RichContent{}, err
+	}
 
-		return responseconverter.ConvertEvalResponseToRichContent(response), nil
+	client, err := getClient(ctx, sessionLogger)
+	if err != nil {
+		return tools.RichContent{}, err
 	}
+
+	response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabfile.Args{
+		ScriptPath:    inputs.ScriptPath,
+		CaptureOutput: !config.ShouldShowMATLABDesktop(),
+		Timeout:       timeout,
+	})
+	if err != nil {
+		return tools.RichContent{}, err
+	}
+
+	return responseconverter.ConvertEvalResponseToRichContent(response), nil
 }
@@ -4,6 +4,7 @@ package runmatlabfile_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
@@ -72,6 +73,11 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -134,6 +140,11 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(nil, expectedError).
@@ -176,6 +187,11 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -238,6 +254,11 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 		Return(mockConfig, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockConfig.EXPECT().
 		ShouldShowMATLABDesktop().
 		Return(shouldShowMATLABDesktop).
@@ -298,6 +319,178 @@ func TestTool_Handler_ConfigError(t *testing.T) {
 	assert.Empty(t, result, "Result should be empty in an error case")
 }
 
+func TestTool_Handler_TimeoutSecondsOverridesDefault(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/some/script/tofile/myfile.m"
+	const expectedTimeout = 30 * time.Second
+	args := runmatlabfile.Args{
+		ScriptPath:     scriptPath,
+		TimeoutSeconds: 30,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabfileusecase.Args{
+				ScriptPath:    scriptPath,
+				CaptureOutput: true,
+				Timeout:       expectedTimeout,
+			},
+		).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := runmatlabfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_UsesDefaultEvalTimeout(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/some/script/tofile/myfile.m"
+	const expectedTimeout = 2 * time.Minute
+	args := runmatlabfile.Args{
+		ScriptPath: scriptPath,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(expectedTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabfileusecase.Args{
+				ScriptPath:    scriptPath,
+				CaptureOutput: true,
+				Timeout:       expectedTimeout,
+			},
+		).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := runmatlabfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_NegativeTimeoutSeconds(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := runmatlabfile.Args{
+		ScriptPath:     "/some/script/tofile/myfile.m",
+		TimeoutSeconds: -1,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
+
+	// Act
+	result, err := runmatlabfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorContains(t, err, "timeout_seconds must not be negative")
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
+
 func TestRunMATLABFile_Annotations(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
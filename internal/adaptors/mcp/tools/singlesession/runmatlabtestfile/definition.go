@@ -5,9 +5,65 @@ package runmatlabtestfile
 const (
 	name        = "run_matlab_test_file"
 	title       = "Run MATLAB test file"
-	description = "Execute a MATLAB test script (`script_path`) using MATLAB's built-in runtests function and return comprehensive test results. Designed specifically for MATLAB unit test files that follow MATLAB's testing framework conventions."
+	description = "Run MATLAB unit tests using the MATLAB testing framework and return structured test results. Specify exactly one of a test file (`script_path`), a test folder (`test_folder`, including subfolders), or a test class or namespace on the MATLAB path (`test_suite`). Optionally narrow the tests by procedure name (`procedure_names`) and by tag (`include_tags`, `exclude_tags`), run them in parallel (`use_parallel`), fail tests that issue warnings (`strict`), measure code coverage of source folders (`coverage_folders`), and write JUnit and Cobertura XML reports to a folder (`artifacts_folder`). Returns a summary of passed, failed and incomplete tests, the status and duration of each test, the diagnostics of every failure, including the file and line where it occurred, per-file code coverage, and the console output of the test run."
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB test script file. Must be a .m file containing MATLAB unit tests. Example: C:\\Users\\username\\tests\\testMyFunction.m or /home/user/matlab/tests/test_analysis.m."`
+	ScriptPath      string   `json:"script_path,omitempty"      jsonschema:"(Optional) The full absolute path to the MATLAB test script file. Must be a .m file containing MATLAB unit tests. Example: C:\\Users\\username\\tests\\testMyFunction.m or /home/user/matlab/tests/test_analysis.m."`
+	TestFolder      string   `json:"test_folder,omitempty"      jsonschema:"(Optional) The full absolute path to a folder of MATLAB test files. Tests in subfolders are included. Example: C:\\Users\\username\\project\\tests or /home/user/project/tests."`
+	TestSuite       string   `json:"test_suite,omitempty"       jsonschema:"(Optional) Name of a test class or namespace on the MATLAB path. Tests in nested namespaces are included. Example: mypackage.tests or MyClassTest."`
+	ProcedureNames  []string `json:"procedure_names,omitempty"  jsonschema:"(Optional) Only run tests whose procedure name (the name of the test method or function) matches one of these patterns. Use * to match any sequence of characters and ? to match a single character. Example: [\"testAdd*\", \"testSubtract\"]."`
+	IncludeTags     []string `json:"include_tags,omitempty"     jsonschema:"(Optional) Only run tests that have at least one of these tags."`
+	ExcludeTags     []string `json:"exclude_tags,omitempty"     jsonschema:"(Optional) Do not run tests that have any of these tags."`
+	UseParallel     bool     `json:"use_parallel,omitempty"     jsonschema:"(Optional) Run the tests in parallel. Requires Parallel Computing Toolbox."`
+	Strict          bool     `json:"strict,omitempty"           jsonschema:"(Optional) Fail tests that issue warnings."`
+	CoverageFolders []string `json:"coverage_folders,omitempty" jsonschema:"(Optional) Full absolute paths to folders of source code for which to measure code coverage. Subfolders are included. Requires MATLAB R2023a or later."`
+	ArtifactsFolder string   `json:"artifacts_folder,omitempty" jsonschema:"(Optional) Full absolute path to a folder in which to write a JUnit XML test report (junit.xml) and, when coverage_folders is specified, a Cobertura XML coverage report (coverage.xml). The folder is created if it does not exist."`
+	TimeoutSeconds  int      `json:"timeout_seconds,omitempty"  jsonschema:"(Optional) Maximum number of seconds MATLAB may spend on this call. When the time runs out, MATLAB execution is interrupted and a timeout error is returned with any output captured so far. If omitted, the server default timeout applies."`
+}
+
+type ReturnArgs struct {
+	Summary       TestSummary    `json:"summary"        jsonschema:"Totals across all tests in the run."`
+	Tests         []TestResult   `json:"tests"          jsonschema:"The result of each test in the order it ran."`
+	Coverage      []FileCoverage `json:"coverage"       jsonschema:"Code coverage of each source file. Empty when coverage_folders was not specified."`
+	Artifacts     TestArtifacts  `json:"artifacts"      jsonschema:"Report files written to artifacts_folder."`
+	ConsoleOutput string         `json:"console_output" jsonschema:"Console output produced while the tests ran."`
+	TimedOut      bool           `json:"timed_out"      jsonschema:"True when the test run timed out and MATLAB was interrupted. The console output then holds the output produced before the timeout."`
+}
+
+type FileCoverage struct {
+	File                     string  `json:"file"                       jsonschema:"Full path of the source file."`
+	StatementCoveragePercent float64 `json:"statement_coverage_percent" jsonschema:"Percentage of statements in the file that ran."`
+	FunctionCoveragePercent  float64 `json:"function_coverage_percent"  jsonschema:"Percentage of functions in the file that ran."`
+	CoveredStatements        int     `json:"covered_statements"         jsonschema:"Number of statements that ran."`
+	TotalStatements          int     `json:"total_statements"           jsonschema:"Number of statements in the file."`
+	CoveredFunctions         int     `json:"covered_functions"          jsonschema:"Number of functions that ran."`
+	TotalFunctions           int     `json:"total_functions"            jsonschema:"Number of functions in the file."`
+}
+
+type TestArtifacts struct {
+	JUnitXML     string `json:"junit_xml,omitempty"     jsonschema:"Full path of the JUnit XML test report."`
+	CoberturaXML string `json:"cobertura_xml,omitempty" jsonschema:"Full path of the Cobertura XML coverage report."`
+}
+
+type TestSummary struct {
+	Total           int     `json:"total"            jsonschema:"Number of tests that ran."`
+	Passed          int     `json:"passed"           jsonschema:"Number of tests that passed."`
+	Failed          int     `json:"failed"           jsonschema:"Number of tests that failed."`
+	Incomplete      int     `json:"incomplete"       jsonschema:"Number of tests that did not run to completion, e.g. because an assumption failed."`
+	DurationSeconds float64 `json:"duration_seconds" jsonschema:"Total time spent running the tests, in seconds."`
+}
+
+type TestResult struct {
+	Name            string           `json:"name"             jsonschema:"Name of the test."`
+	Status          string           `json:"status"           jsonschema:"Outcome of the test: passed, failed or incomplete."`
+	DurationSeconds float64          `json:"duration_seconds" jsonschema:"Time spent running the test, in seconds."`
+	Diagnostics     []TestDiagnostic `json:"diagnostics"      jsonschema:"Diagnostics recorded for failed or incomplete tests. Empty when the test passed."`
+}
+
+type TestDiagnostic struct {
+	Event  string `json:"event"  jsonschema:"The qualification event that produced the diagnostic (e.g., VerificationFailed, AssertionFailed, ExceptionThrown)."`
+	Report string `json:"report" jsonschema:"Full diagnostic report, including the failing qualification and any expected and actual values."`
+	File   string `json:"file"   jsonschema:"File in which the failure occurred. Empty when MATLAB did not record a location."`
+	Line   int    `json:"line"   jsonschema:"Line number at which the failure occurred. Zero when MATLAB did not record a location."`
 }
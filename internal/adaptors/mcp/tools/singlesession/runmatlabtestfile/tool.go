@@ -1,53 +1,157 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package runmatlabtestfile
 
 import (
 	"context"
+	"errors"
 
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/evaltimeout"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
 )
 
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
 type Usecase interface {
-	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (entities.EvalResponse, error)
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (runmatlabtestfile.ReturnArgs, error)
 }
 
 type Tool struct {
-	basetool.ToolWithUnstructuredContentOutput[Args]
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
 }
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)),
 	}
 }
 
-func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
-	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
+func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
 		sessionLogger.Info("Executing Run MATLAB Test File tool")
 		defer sessionLogger.Info("Done - Executing Run MATLAB Test File tool")
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
-		if err != nil {
-			return tools.RichContent{}, err
+		return Run(ctx, sessionLogger, configFactory, usecase, globalMATLAB.Client, inputs)
+	}
+}
+
+// Run runs MATLAB tests in the MATLAB session returned by getClient.
+func Run(ctx context.Context, sessionLogger entities.Logger, configFactory ConfigFactory, usecase Usecase, getClient sessionclient.Getter, inputs Args) (ReturnArgs, error) {
+	// Not returning nil for empty slices, to comply with MCP spec.
+	mcpCompliantZeroValue := ReturnArgs{
+		Tests:    []TestResult{},
+		Coverage: []FileCoverage{},
+	}
+
+	config, messagesErr := configFactory.Config()
+	if messagesErr != nil {
+		return mcpCompliantZeroValue, messagesErr
+	}
+
+	timeout, err := evaltimeout.Resolve(inputs.TimeoutSeconds, config.DefaultEvalTimeout())
+	if err != nil {
+		return mcpCompliantZeroValue, err
+	}
+
+	client, err := getClient(ctx, sessionLogger)
+	if err != nil {
+		return mcpCompliantZeroValue, err
+	}
+
+	response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabtestfile.Args{
+		ScriptPath:      inputs.ScriptPath,
+		TestFolder:      inputs.TestFolder,
+		TestSuite:       inputs.TestSuite,
+		ProcedureNames:  inputs.ProcedureNames,
+		IncludeTags:     inputs.IncludeTags,
+		ExcludeTags:     inputs.ExcludeTags,
+		UseParallel:     inputs.UseParallel,
+		Strict:          inputs.Strict,
+		CoverageFolders: inputs.CoverageFolders,
+		ArtifactsFolder: inputs.ArtifactsFolder,
+		Timeout:         timeout,
+	})
+	var timeoutErr *entities.EvalTimeoutError
+	if errors.As(err, &timeoutErr) {
+		mcpCompliantZeroValue.TimedOut = true
+		mcpCompliantZeroValue.ConsoleOutput = timeoutErr.PartialResponse.ConsoleOutput
+	}
+	if err != nil {
+		return mcpCompliantZeroValue, err
+	}
+
+	return convertReturnArgs(response), nil
+}
+
+func convertReturnArgs(response runmatlabtestfile.ReturnArgs) ReturnArgs {
+	result := ReturnArgs{
+		Summary: TestSummary{
+			Total:           response.Summary.Total,
+			Passed:          response.Summary.Passed,
+			Failed:          response.Summary.Failed,
+			Incomplete:      response.Summary.Incomplete,
+			DurationSeconds: response.Summary.Duration.Seconds(),
+		},
+		Tests:    make([]TestResult, len(response.Tests)),
+		Coverage: make([]FileCoverage, len(response.Coverage)),
+		Artifacts: TestArtifacts{
+			JUnitXML:     response.Artifacts.JUnitReport,
+			CoberturaXML: response.Artifacts.CoberturaReport,
+		},
+		ConsoleOutput: response.ConsoleOutput,
+	}
+
+	for i, test := range response.Tests {
+		diagnostics := make([]TestDiagnostic, len(test.Diagnostics))
+		for j, diagnostic := range test.Diagnostics {
+			diagnostics[j] = TestDiagnostic{
+				Event:  diagnostic.Event,
+				Report: diagnostic.Report,
+				File:   diagnostic.File,
+				Line:   diagnostic.Line,
+			}
 		}
 
-		response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabtestfile.Args{
-			ScriptPath: inputs.ScriptPath,
-		})
-		if err != nil {
-			return tools.RichContent{}, err
+		result.Tests[i] = TestResult{
+			Name:            test.Name,
+			Status:          string(test.Status),
+			DurationSeconds: test.Duration.Seconds(),
+			Diagnostics:     diagnostics,
 		}
+	}
+
+	for i, fileCoverage := range response.Coverage {
+		result.Coverage[i] = FileCoverage{
+			File:                     fileCoverage.File,
+			StatementCoveragePercent: percent(fileCoverage.CoveredStatements, fileCoverage.TotalStatements),
+			FunctionCoveragePercent:  percent(fileCoverage.CoveredFunctions, fileCoverage.TotalFunctions),
+			CoveredStatements:        fileCoverage.CoveredStatements,
+			TotalStatements:          fileCoverage.TotalStatements,
+			CoveredFunctions:         fileCoverage.CoveredFunctions,
+			TotalFunctions:           fileCoverage.TotalFunctions,
+		}
+	}
+
+	return result
+}
 
-		return responseconverter.ConvertEvalResponseToRichContent(response), nil
+// percent reports a file without anything to cover as fully covered
+func percent(covered int, total int) float64 {
+	if total == 0 {
+		return 100
 	}
+	return float64(covered) / float64(total) * 100
 }
@@ -4,12 +4,15 @@ package runmatlabtestfile_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	runmatlabtestfileusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
 	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
@@ -22,6 +25,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
@@ -29,7 +35,7 @@ func TestNew_HappyPath(t *testing.T) {
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
 	// Act
-	tool := runmatlabtestfile.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -37,6 +43,12 @@ func TestNew_HappyPath(t *testing.T) {
 
 func TestTool_Handler_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
@@ -49,12 +61,94 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	const scriptPath = "/some/script/tofile/testFile.m"
-	expectedResponse := entities.EvalResponse{
-		ConsoleOutput: "Test Results",
-		Images:        [][]byte{[]byte("image1"), []byte("image2")},
+	usecaseResponse := runmatlabtestfileusecase.ReturnArgs{
+		Summary: runmatlabtestfileusecase.TestSummary{
+			Total:    2,
+			Passed:   1,
+			Failed:   1,
+			Duration: 1500 * time.Millisecond,
+		},
+		Tests: []runmatlabtestfileusecase.TestResult{
+			{
+				Name:        "testFile/testAddition",
+				Status:      runmatlabtestfileusecase.TestStatusPassed,
+				Duration:    500 * time.Millisecond,
+				Diagnostics: []runmatlabtestfileusecase.TestDiagnostic{},
+			},
+			{
+				Name:     "testFile/testSubtraction",
+				Status:   runmatlabtestfileusecase.TestStatusFailed,
+				Duration: time.Second,
+				Diagnostics: []runmatlabtestfileusecase.TestDiagnostic{
+					{
+						Event:  "VerificationFailed",
+						Report: "Verification failed.",
+						File:   "/some/script/tofile/testFile.m",
+						Line:   12,
+					},
+				},
+			},
+		},
+		Coverage: []runmatlabtestfileusecase.FileCoverage{
+			{File: "/some/source/add.m", CoveredStatements: 3, TotalStatements: 4, CoveredFunctions: 1, TotalFunctions: 1},
+			{File: "/some/source/empty.m"},
+		},
+		Artifacts: runmatlabtestfileusecase.TestArtifacts{
+			JUnitReport:     "/some/artifacts/junit.xml",
+			CoberturaReport: "/some/artifacts/coverage.xml",
+		},
+		ConsoleOutput: "Running testFile\n..\nDone testFile",
+	}
+	expectedResult := runmatlabtestfile.ReturnArgs{
+		Summary: runmatlabtestfile.TestSummary{
+			Total:           2,
+			Passed:          1,
+			Failed:          1,
+			DurationSeconds: 1.5,
+		},
+		Tests: []runmatlabtestfile.TestResult{
+			{
+				Name:            "testFile/testAddition",
+				Status:          "passed",
+				DurationSeconds: 0.5,
+				Diagnostics:     []runmatlabtestfile.TestDiagnostic{},
+			},
+			{
+				Name:            "testFile/testSubtraction",
+				Status:          "failed",
+				DurationSeconds: 1,
+				Diagnostics: []runmatlabtestfile.TestDiagnostic{
+					{
+						Event:  "VerificationFailed",
+						Report: "Verification failed.",
+						File:   "/some/script/tofile/testFile.m",
+						Line:   12,
+					},
+				},
+			},
+		},
+		Coverage: []runmatlabtestfile.FileCoverage{
+			{File: "/some/source/add.m", StatementCoveragePercent: 75, FunctionCoveragePercent: 100, CoveredStatements: 3, TotalStatements: 4, CoveredFunctions: 1, TotalFunctions: 1},
+			{File: "/some/source/empty.m", StatementCoveragePercent: 100, FunctionCoveragePercent: 100},
+		},
+		Artifacts: runmatlabtestfile.TestArtifacts{
+			JUnitXML:     "/some/artifacts/junit.xml",
+			CoberturaXML: "/some/artifacts/coverage.xml",
+		},
+		ConsoleOutput: "Running testFile\n..\nDone testFile",
 	}
 	args := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
@@ -67,25 +161,96 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 			mockMATLABSessionClient,
 			runmatlabtestfileusecase.Args{ScriptPath: scriptPath},
 		).
-		Return(expectedResponse, nil).
+		Return(usecaseResponse, nil).
 		Once()
 
 	// Act
-	result, err := runmatlabtestfile.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match expected value")
+}
+
+func TestTool_Handler_PassesTestRunOptions(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
 
-	require.Len(t, result.TextContent, 1, "Should have one text content item")
-	assert.Equal(t, expectedResponse.ConsoleOutput, result.TextContent[0], "Text content should match")
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
 
-	require.Len(t, result.ImageContent, 2, "Should have two image content items")
-	assert.Equal(t, "image1", string(result.ImageContent[0]), "First image should match")
-	assert.Equal(t, "image2", string(result.ImageContent[1]), "Second image should match")
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := runmatlabtestfile.Args{
+		TestFolder:      "/some/project/tests",
+		ProcedureNames:  []string{"testAdd*"},
+		IncludeTags:     []string{"Unit"},
+		ExcludeTags:     []string{"Slow"},
+		UseParallel:     true,
+		Strict:          true,
+		CoverageFolders: []string{"/some/project/source"},
+		ArtifactsFolder: "/some/project/artifacts",
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{
+				TestFolder:      "/some/project/tests",
+				ProcedureNames:  []string{"testAdd*"},
+				IncludeTags:     []string{"Unit"},
+				ExcludeTags:     []string{"Slow"},
+				UseParallel:     true,
+				Strict:          true,
+				CoverageFolders: []string{"/some/project/source"},
+				ArtifactsFolder: "/some/project/artifacts",
+			},
+		).
+		Return(runmatlabtestfileusecase.ReturnArgs{}, nil).
+		Once()
+
+	// Act
+	_, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
 }
 
 func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
@@ -101,21 +266,37 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	expectedError := assert.AnError
 	args := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(nil, expectedError).
 		Once()
 
 	// Act
-	result, err := runmatlabtestfile.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
-	assert.Empty(t, result, "Result should be empty in an error case")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{Tests: []runmatlabtestfile.TestResult{}, Coverage: []runmatlabtestfile.FileCoverage{}}, result, "Result should be empty in an error case")
 }
 
 func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
@@ -131,6 +312,75 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	expectedError := assert.AnError
 	args := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{ScriptPath: scriptPath},
+		).
+		Return(runmatlabtestfileusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{Tests: []runmatlabtestfile.TestResult{}, Coverage: []runmatlabtestfile.FileCoverage{}}, result, "Result should be empty in an error case")
+}
+
+func TestTool_Handler_UsecaseTimesOut(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/invalid/path.m"
+	expectedError := &entities.EvalTimeoutError{
+		Timeout:         time.Minute,
+		PartialResponse: entities.EvalResponse{ConsoleOutput: "Running testFoo"},
+	}
+	args := runmatlabtestfile.Args{ScriptPath: scriptPath}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
@@ -143,19 +393,30 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 			mockMATLABSessionClient,
 			runmatlabtestfileusecase.Args{ScriptPath: scriptPath},
 		).
-		Return(entities.EvalResponse{}, expectedError).
+		Return(runmatlabtestfileusecase.ReturnArgs{}, expectedError).
 		Once()
 
 	// Act
-	result, err := runmatlabtestfile.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
-	assert.Empty(t, result, "Result should be empty in an error case")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{
+		Tests:         []runmatlabtestfile.TestResult{},
+		Coverage:      []runmatlabtestfile.FileCoverage{},
+		ConsoleOutput: "Running testFoo",
+		TimedOut:      true,
+	}, result, "Result should report the timeout and the output produced before it")
 }
 
 func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
@@ -169,13 +430,20 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	ctx := t.Context()
 	const scriptPath = "/path/tomepty/testFile.m"
 
-	// Set up mock usecase to return an empty response
-	emptyResponse := entities.EvalResponse{
-		ConsoleOutput: "",
-		Images:        [][]byte{},
-	}
+	// Set up mock usecase to return a run without any tests
+	emptyResponse := runmatlabtestfileusecase.ReturnArgs{}
 	args := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(0).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
@@ -192,14 +460,202 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := runmatlabtestfile.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.NotNil(t, result.Tests, "Tests should be an empty slice, not nil")
+	assert.Empty(t, result.Tests, "Tests should be empty")
+	assert.Empty(t, result.ConsoleOutput, "Console output should be empty")
+}
+
+func TestTool_Handler_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := messages.New_StartupErrors_BadFlag_Error("flag", "value", "reason")
+	args := runmatlabtestfile.Args{ScriptPath: "/some/script/tofile/testFile.m"}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{Tests: []runmatlabtestfile.TestResult{}, Coverage: []runmatlabtestfile.FileCoverage{}}, result, "Result should be empty in an error case")
+}
+
+func TestTool_Handler_TimeoutSecondsOverridesDefault(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/some/script/tofile/testFile.m"
+	const expectedTimeout = 30 * time.Second
+	args := runmatlabtestfile.Args{
+		ScriptPath:     scriptPath,
+		TimeoutSeconds: 30,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{
+				ScriptPath: scriptPath,
+				Timeout:    expectedTimeout,
+			},
+		).
+		Return(runmatlabtestfileusecase.ReturnArgs{}, nil).
+		Once()
+
+	// Act
+	_, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_UsesDefaultEvalTimeout(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/some/script/tofile/testFile.m"
+	const expectedTimeout = 2 * time.Minute
+	args := runmatlabtestfile.Args{
+		ScriptPath: scriptPath,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(expectedTimeout).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{
+				ScriptPath: scriptPath,
+				Timeout:    expectedTimeout,
+			},
+		).
+		Return(runmatlabtestfileusecase.ReturnArgs{}, nil).
+		Once()
+
+	// Act
+	_, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
+}
+
+func TestTool_Handler_NegativeTimeoutSeconds(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := runmatlabtestfile.Args{
+		ScriptPath:     "/some/script/tofile/testFile.m",
+		TimeoutSeconds: -1,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DefaultEvalTimeout().
+		Return(time.Minute).
+		Once()
 
-	require.Len(t, result.TextContent, 1, "Should have one text content item")
-	assert.Empty(t, result.TextContent[0], "Text content should be empty")
-	assert.Empty(t, result.ImageContent, "Image content should be empty")
+	// Act
+	result, err := runmatlabtestfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorContains(t, err, "timeout_seconds must not be negative")
+	assert.Equal(t, runmatlabtestfile.ReturnArgs{Tests: []runmatlabtestfile.TestResult{}, Coverage: []runmatlabtestfile.FileCoverage{}}, result, "Result should be empty in an error case")
 }
 
 func TestRunMATLABTestFile_Annotations(t *testing.T) {
@@ -207,6 +663,9 @@ func TestRunMATLABTestFile_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -216,7 +675,7 @@ func TestRunMATLABTestFile_Annotations(t *testing.T) {
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
 	// Act
-	tool := runmatlabtestfile.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
@@ -14,6 +14,9 @@ type PNGImageData []byte
 type RichContent struct {
 	TextContent  []string
 	ImageContent []PNGImageData
+	// AdditionalContent holds content that is already in its MCP form, such as JPEG images, audio,
+	// embedded resources and resource links. It is appended after the text and image content.
+	AdditionalContent []mcp.Content
 }
 
 type Tool interface {
@@ -0,0 +1,22 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package evaltimeout
+
+import (
+	"fmt"
+	"time"
+)
+
+// Resolve returns the time budget for a tool call.
+// A positive timeoutSeconds from the tool input takes precedence over the server wide default.
+func Resolve(timeoutSeconds int, defaultTimeout time.Duration) (time.Duration, error) {
+	if timeoutSeconds < 0 {
+		return 0, fmt.Errorf("timeout_seconds must not be negative, got %d", timeoutSeconds)
+	}
+
+	if timeoutSeconds == 0 {
+		return defaultTimeout, nil
+	}
+
+	return time.Duration(timeoutSeconds) * time.Second, nil
+}
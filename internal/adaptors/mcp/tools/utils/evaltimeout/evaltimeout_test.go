@@ -0,0 +1,67 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package evaltimeout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/evaltimeout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve_HappyPath(t *testing.T) {
+	// Arrange
+	tests := []struct {
+		name           string
+		timeoutSeconds int
+		defaultTimeout time.Duration
+		expected       time.Duration
+	}{
+		{
+			name:           "NoTimeoutAnywhere",
+			timeoutSeconds: 0,
+			defaultTimeout: 0,
+			expected:       0,
+		},
+		{
+			name:           "FallsBackToDefault",
+			timeoutSeconds: 0,
+			defaultTimeout: 5 * time.Minute,
+			expected:       5 * time.Minute,
+		},
+		{
+			name:           "InputOverridesDefault",
+			timeoutSeconds: 30,
+			defaultTimeout: 5 * time.Minute,
+			expected:       30 * time.Second,
+		},
+		{
+			name:           "InputWithoutDefault",
+			timeoutSeconds: 90,
+			defaultTimeout: 0,
+			expected:       90 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			timeout, err := evaltimeout.Resolve(tt.timeoutSeconds, tt.defaultTimeout)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, timeout)
+		})
+	}
+}
+
+func TestResolve_NegativeTimeout(t *testing.T) {
+	// Act
+	timeout, err := evaltimeout.Resolve(-1, time.Minute)
+
+	// Assert
+	require.EqualError(t, err, "timeout_seconds must not be negative, got -1")
+	assert.Zero(t, timeout)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package progressreporter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const loggerName = "matlab"
+
+type Session interface {
+	NotifyProgress(ctx context.Context, params *mcp.ProgressNotificationParams) error
+	Log(ctx context.Context, params *mcp.LoggingMessageParams) error
+}
+
+// Reporter forwards evaluation updates to the MCP client that made the tool call.
+// Percent-complete updates become notifications/progress when the client sent a progress token,
+// and notifications/message log entries otherwise. Console output is only sent as log entries,
+// and only to clients that set a logging level.
+type Reporter struct {
+	session       Session
+	progressToken any
+	clientLogging bool
+	logger        entities.Logger
+
+	mu               sync.Mutex
+	hasSentProgress  bool
+	lastSentProgress float64
+}
+
+func New(session Session, progressToken any, clientLogging bool, logger entities.Logger) *Reporter {
+	return &Reporter{
+		session:       session,
+		progressToken: progressToken,
+		clientLogging: clientLogging,
+		logger:        logger,
+	}
+}
+
+type clientLoggingKey struct{}
+
+// ContextWithClientLogging returns a copy of ctx that records that the MCP client has set a logging level,
+// so that log entries sent to it are not dropped.
+func ContextWithClientLogging(ctx context.Context) context.Context {
+	return context.WithValue(ctx, clientLoggingKey{}, true)
+}
+
+func clientLoggingFromContext(ctx context.Context) bool {
+	clientLogging, _ := ctx.Value(clientLoggingKey{}).(bool)
+	return clientLogging
+}
+
+// ContextForToolCall returns a copy of ctx that carries a Reporter for req, so MATLAB evaluations made while
+// handling the tool call can stream their updates back to the client.
+// Streaming updates costs extra requests to MATLAB, so the Reporter is only attached when the client can receive them:
+// when it sent a progress token, or set a logging level.
+func ContextForToolCall(ctx context.Context, req *mcp.CallToolRequest, logger entities.Logger) context.Context {
+	if req == nil || req.Session == nil {
+		return ctx
+	}
+
+	var progressToken any
+	if req.Params != nil {
+		progressToken = req.Params.GetProgressToken()
+	}
+
+	clientLogging := clientLoggingFromContext(ctx)
+	if progressToken == nil && !clientLogging {
+		return ctx
+	}
+
+	return entities.ContextWithEvalProgressReporter(ctx, New(req.Session, progressToken, clientLogging, logger))
+}
+
+func (r *Reporter) WantsConsoleOutput() bool {
+	return r.clientLogging
+}
+
+func (r *Reporter) ReportConsoleOutput(ctx context.Context, output string) {
+	err := r.session.Log(ctx, &mcp.LoggingMessageParams{
+		Level:  "info",
+		Logger: loggerName,
+		Data:   output,
+	})
+	if err != nil {
+		r.logger.WithError(err).Debug("Failed to send console output notification")
+	}
+}
+
+func (r *Reporter) ReportProgress(ctx context.Context, fraction float64, message string) {
+	percent := min(max(fraction, 0), 1) * 100
+
+	if r.progressToken == nil {
+		err := r.session.Log(ctx, &mcp.LoggingMessageParams{
+			Level:  "info",
+			Logger: loggerName,
+			Data: map[string]any{
+				"progress": percent,
+				"message":  message,
+			},
+		})
+		if err != nil {
+			r.logger.WithError(err).Debug("Failed to send progress log notification")
+		}
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The MCP specification requires the progress value to increase with every notification.
+	if r.hasSentProgress && percent <= r.lastSentProgress {
+		r.logger.With("progress", percent).Debug("Dropping progress update that does not increase progress")
+		return
+	}
+
+	err := r.session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: r.progressToken,
+		Progress:      percent,
+		Total:         100,
+		Message:       message,
+	})
+	if err != nil {
+		r.logger.WithError(err).Debug("Failed to send progress notification")
+		return
+	}
+
+	r.hasSentProgress = true
+	r.lastSentProgress = percent
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package progressreporter_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/progressreporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/utils/progressreporter"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_ReportConsoleOutput_SendsLogNotification(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const output = "iteration 1\niteration 2"
+
+	mockSession.EXPECT().
+		Log(ctx, &mcp.LoggingMessageParams{
+			Level:  "info",
+			Logger: "matlab",
+			Data:   output,
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, "token", true, mockLogger)
+
+	// Act
+	reporter.ReportConsoleOutput(ctx, output)
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
+func TestReporter_ReportConsoleOutput_LogFails(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSession.EXPECT().
+		Log(ctx, &mcp.LoggingMessageParams{
+			Level:  "info",
+			Logger: "matlab",
+			Data:   "output",
+		}).
+		Return(assert.AnError).
+		Once()
+
+	reporter := progressreporter.New(mockSession, nil, true, mockLogger)
+
+	// Act
+	reporter.ReportConsoleOutput(ctx, "output")
+
+	// Assert
+	assert.Contains(t, mockLogger.DebugLogs(), "Failed to send console output notification")
+}
+
+func TestReporter_ReportProgress_WithProgressToken_SendsProgressNotification(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const progressToken = "token-123"
+
+	mockSession.EXPECT().
+		NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      25,
+			Total:         100,
+			Message:       "Solving step 1",
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, progressToken, false, mockLogger)
+
+	// Act
+	reporter.ReportProgress(ctx, 0.25, "Solving step 1")
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
+func TestReporter_ReportProgress_WithProgressToken_DropsNonIncreasingProgress(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const progressToken = 42
+
+	mockSession.EXPECT().
+		NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      50,
+			Total:         100,
+			Message:       "halfway",
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, progressToken, false, mockLogger)
+
+	// Act
+	reporter.ReportProgress(ctx, 0.5, "halfway")
+	reporter.ReportProgress(ctx, 0.5, "still halfway")
+	reporter.ReportProgress(ctx, 0.2, "going backwards")
+
+	// Assert
+	assert.Contains(t, mockLogger.DebugLogs(), "Dropping progress update that does not increase progress")
+}
+
+func TestReporter_ReportProgress_WithProgressToken_ClampsFraction(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const progressToken = "token"
+
+	mockSession.EXPECT().
+		NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      100,
+			Total:         100,
+			Message:       "done",
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, progressToken, false, mockLogger)
+
+	// Act
+	reporter.ReportProgress(ctx, 1.5, "done")
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
+func TestReporter_ReportProgress_WithProgressToken_NotifyFails(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const progressToken = "token"
+
+	mockSession.EXPECT().
+		NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      10,
+			Total:         100,
+		}).
+		Return(assert.AnError).
+		Once()
+
+	mockSession.EXPECT().
+		NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      10,
+			Total:         100,
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, progressToken, false, mockLogger)
+
+	// Act
+	reporter.ReportProgress(ctx, 0.1, "")
+	reporter.ReportProgress(ctx, 0.1, "")
+
+	// Assert
+	assert.Contains(t, mockLogger.DebugLogs(), "Failed to send progress notification")
+}
+
+func TestReporter_ReportProgress_WithoutProgressToken_SendsLogNotification(t *testing.T) {
+	// Arrange
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSession.EXPECT().
+		Log(ctx, &mcp.LoggingMessageParams{
+			Level:  "info",
+			Logger: "matlab",
+			Data: map[string]any{
+				"progress": 75.0,
+				"message":  "Post-processing",
+			},
+		}).
+		Return(nil).
+		Once()
+
+	reporter := progressreporter.New(mockSession, nil, true, mockLogger)
+
+	// Act
+	reporter.ReportProgress(ctx, 0.75, "Post-processing")
+
+	// Assert
+	assert.Empty(t, mockLogger.DebugLogs())
+}
+
+func TestContextForToolCall_AttachesReporter(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	req := &mcp.CallToolRequest{
+		Session: &mcp.ServerSession{},
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"progressToken": "progress-token"},
+		},
+	}
+
+	// Act
+	ctx := progressreporter.ContextForToolCall(t.Context(), req, mockLogger)
+
+	// Assert
+	reporter := entities.EvalProgressReporterFromContext(ctx)
+	require.NotNil(t, reporter)
+	assert.IsType(t, &progressreporter.Reporter{}, reporter)
+}
+
+func TestContextForToolCall_NoSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	req := &mcp.CallToolRequest{}
+
+	// Act
+	ctx := progressreporter.ContextForToolCall(t.Context(), req, mockLogger)
+
+	// Assert
+	assert.Nil(t, entities.EvalProgressReporterFromContext(ctx))
+}
+
+func TestContextForToolCall_NoProgressToken_ClientLogging_AttachesReporterForLogNotifications(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	req := &mcp.CallToolRequest{
+		Session: &mcp.ServerSession{},
+		Params:  &mcp.CallToolParamsRaw{},
+	}
+
+	// Act
+	ctx := progressreporter.ContextForToolCall(progressreporter.ContextWithClientLogging(t.Context()), req, mockLogger)
+
+	// Assert
+	reporter := entities.EvalProgressReporterFromContext(ctx)
+	require.NotNil(t, reporter)
+	assert.True(t, reporter.WantsConsoleOutput())
+}
+
+func TestContextForToolCall_NoProgressToken_NoClientLogging(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	req := &mcp.CallToolRequest{
+		Session: &mcp.ServerSession{},
+		Params:  &mcp.CallToolParamsRaw{},
+	}
+
+	// Act
+	ctx := progressreporter.ContextForToolCall(t.Context(), req, mockLogger)
+
+	// Assert
+	assert.Nil(t, entities.EvalProgressReporterFromContext(ctx))
+}
+
+func TestContextForToolCall_ProgressTokenWithoutClientLogging_DoesNotWantConsoleOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	req := &mcp.CallToolRequest{
+		Session: &mcp.ServerSession{},
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"progressToken": "progress-token"},
+		},
+	}
+
+	// Act
+	ctx := progressreporter.ContextForToolCall(t.Context(), req, mockLogger)
+
+	// Assert
+	reporter := entities.EvalProgressReporterFromContext(ctx)
+	require.NotNil(t, reporter)
+	assert.False(t, reporter.WantsConsoleOutput())
+}
@@ -32,5 +32,6 @@ func ConvertRichContentToCallToolResult(content tools.RichContent) *mcp.CallTool
 			Data:     imageData,
 		})
 	}
+	result.Content = append(result.Content, content.AdditionalContent...)
 	return result
 }
@@ -136,6 +136,23 @@ func TestConvertRichContentToCallToolResult_HappyPath(t *testing.T) {
 				&mcp.TextContent{Text: "line2"},
 			},
 		},
+		{
+			name: "AdditionalContentAfterTextAndImages",
+			content: tools.RichContent{
+				TextContent:  []string{"Processing complete"},
+				ImageContent: []tools.PNGImageData{tools.PNGImageData("chart")},
+				AdditionalContent: []mcp.Content{
+					&mcp.AudioContent{MIMEType: "audio/wav", Data: []byte("sound")},
+					&mcp.ResourceLink{URI: "file:///tmp/result.mat", Name: "result.mat"},
+				},
+			},
+			expectedContent: []mcp.Content{
+				&mcp.TextContent{Text: "Processing complete"},
+				&mcp.ImageContent{MIMEType: "image/png", Data: []byte("chart")},
+				&mcp.AudioContent{MIMEType: "audio/wav", Data: []byte("sound")},
+				&mcp.ResourceLink{URI: "file:///tmp/result.mat", Name: "result.mat"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,20 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionclient
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Getter returns the client of the MATLAB session in which a tool call runs.
+// Tools that exist for both the global MATLAB session and for a MATLAB session given by its ID only differ in their Getter.
+type Getter func(ctx context.Context, sessionLogger entities.Logger) (entities.MATLABSessionClient, error)
+
+// ForSession returns a Getter for the MATLAB session with the given ID.
+func ForSession(matlabManager entities.MATLABManager, sessionID entities.SessionID) Getter {
+	return func(ctx context.Context, sessionLogger entities.Logger) (entities.MATLABSessionClient, error) {
+		return matlabManager.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolnotices
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Collector gathers the notices raised while handling a tool call, so they can be appended to its result.
+type Collector struct {
+	mu      sync.Mutex
+	notices []string
+}
+
+func New() *Collector {
+	return &Collector{}
+}
+
+// ContextForToolCall returns a copy of ctx that carries a new Collector, together with that Collector.
+func ContextForToolCall(ctx context.Context) (context.Context, *Collector) {
+	collector := New()
+	return entities.ContextWithToolCallNoticeRecorder(ctx, collector), collector
+}
+
+func (c *Collector) AddNotice(notice string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.notices = append(c.notices, notice)
+}
+
+func (c *Collector) Notices() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	notices := make([]string, len(c.notices))
+	copy(notices, c.notices)
+	return notices
+}
+
+// Content returns one text content per collected notice, in the order the notices were added.
+func (c *Collector) Content() []mcp.Content {
+	notices := c.Notices()
+
+	content := make([]mcp.Content, 0, len(notices))
+	for _, notice := range notices {
+		content = append(content, &mcp.TextContent{Text: notice})
+	}
+	return content
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolnotices_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/toolnotices"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextForToolCall_CarriesCollector(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	// Act
+	ctx, collector := toolnotices.ContextForToolCall(ctx)
+
+	// Assert
+	recorder := entities.ToolCallNoticeRecorderFromContext(ctx)
+	require.NotNil(t, recorder)
+	assert.Same(t, collector, recorder)
+}
+
+func TestCollector_Content_HappyPath(t *testing.T) {
+	// Arrange
+	collector := toolnotices.New()
+	collector.AddNotice("first notice")
+	collector.AddNotice("second notice")
+
+	// Act
+	content := collector.Content()
+
+	// Assert
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: "first notice"},
+		&mcp.TextContent{Text: "second notice"},
+	}, content)
+}
+
+func TestCollector_Content_NoNotices(t *testing.T) {
+	// Arrange
+	collector := toolnotices.New()
+
+	// Act
+	content := collector.Content()
+
+	// Assert
+	assert.Empty(t, content)
+}
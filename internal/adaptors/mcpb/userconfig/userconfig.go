@@ -99,9 +99,19 @@ func (f *entryFactory) fromParameter(
 	title string,
 	typeOverride string,
 ) (userConfigEntry, error) {
+	defaultValue := parameter.GetDefaultValue()
+
+	// MCPB user config entries hold a single value, so repeatable parameters only expose one value.
+	if values, ok := defaultValue.([]string); ok {
+		defaultValue = ""
+		if len(values) > 0 {
+			defaultValue = values[0]
+		}
+	}
+
 	parameterType := typeOverride
 	if parameterType == "" {
-		switch parameterDefaultValue := parameter.GetDefaultValue().(type) {
+		switch parameterDefaultValue := defaultValue.(type) {
 		case string:
 			parameterType = "string"
 		case bool:
@@ -116,6 +126,6 @@ func (f *entryFactory) fromParameter(
 		Title:       title,
 		Description: f.messageCatalog.Get(parameter.GetDescriptionKey()),
 		Required:    false, // All of our parameters are optional
-		Default:     parameter.GetDefaultValue(),
+		Default:     defaultValue,
 	}, nil
 }
@@ -127,6 +127,7 @@ func TestGetUserConfig_DefaultValues(t *testing.T) {
 	assert.Equal(t, false, config["InitializeMATLABOnStartup"].Default)
 	assert.Equal(t, false, config["DisableTelemetry"].Default)
 	assert.Equal(t, "desktop", config["MATLABDisplayMode"].Default)
+	assert.Equal(t, "", config["ExtensionFile"].Default, "Repeatable parameters should expose a single value")
 }
 
 func TestGetUserConfig_AllEntriesNotRequired(t *testing.T) {
@@ -5,6 +5,7 @@ package dependenciesproviderresources
 import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/matlab"
 	publictypes "github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -28,21 +29,32 @@ type WatchdogFactory interface {
 	) publictypes.Watchdog
 }
 
+type MATLABFactory interface {
+	New(
+		logger entities.Logger,
+		internalGlobalMATLAB matlab.InternalGlobalMATLAB,
+		internalMessageCatalog matlab.InternalMessageCatalog,
+	) publictypes.MATLAB
+}
+
 type Factory struct {
 	loggerFactory   LoggerFactory
 	configFactory   ConfigFactory
 	watchdogFactory WatchdogFactory
+	matlabFactory   MATLABFactory
 }
 
 func NewFactory(
 	loggerFactory LoggerFactory,
 	configFactory ConfigFactory,
 	watchdogFactory WatchdogFactory,
+	matlabFactory MATLABFactory,
 ) *Factory {
 	return &Factory{
 		loggerFactory:   loggerFactory,
 		configFactory:   configFactory,
 		watchdogFactory: watchdogFactory,
+		matlabFactory:   matlabFactory,
 	}
 }
 
@@ -53,6 +65,7 @@ func (f *Factory) New(
 		logger:   f.loggerFactory.New(internal.Logger),
 		config:   f.configFactory.New(internal.Config, internal.MessageCatalog),
 		watchdog: f.watchdogFactory.New(internal.Logger, internal.Watchdog),
+		matlab:   f.matlabFactory.New(internal.Logger, internal.GlobalMATLAB, internal.MessageCatalog),
 	}
 }
 
@@ -60,6 +73,7 @@ type dependenciesProviderResourcesAdaptor struct {
 	logger   publictypes.Logger
 	config   publictypes.Config
 	watchdog publictypes.Watchdog
+	matlab   publictypes.MATLAB
 }
 
 func (r *dependenciesProviderResourcesAdaptor) Logger() publictypes.Logger {
@@ -73,3 +87,7 @@ func (r *dependenciesProviderResourcesAdaptor) Config() publictypes.Config {
 func (r *dependenciesProviderResourcesAdaptor) Watchdog() publictypes.Watchdog {
 	return r.watchdog
 }
+
+func (r *dependenciesProviderResourcesAdaptor) MATLAB() publictypes.MATLAB {
+	return r.matlab
+}
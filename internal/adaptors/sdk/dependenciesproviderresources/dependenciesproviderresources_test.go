@@ -26,11 +26,15 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
 	defer mockWatchdogFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	// Act
 	factory := dependenciesproviderresources.NewFactory(
 		mockLoggerFactory,
 		mockConfigFactory,
 		mockWatchdogFactory,
+		mockMATLABFactory,
 	)
 
 	// Assert
@@ -48,6 +52,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
 	defer mockWatchdogFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -60,6 +67,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockWatchdog := &definitionmocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockLoggerFactory.EXPECT().
 		New(mockInternalLogger).
 		Return(nil).
@@ -75,11 +85,17 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
 	internalResources := definition.NewDependenciesProviderResources(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
 		mockWatchdog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -87,6 +103,7 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		mockLoggerFactory,
 		mockConfigFactory,
 		mockWatchdogFactory,
+		mockMATLABFactory,
 	).New(internalResources)
 
 	// Assert
@@ -104,6 +121,9 @@ func TestFactory_New_Logger(t *testing.T) {
 	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
 	defer mockWatchdogFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -116,6 +136,9 @@ func TestFactory_New_Logger(t *testing.T) {
 	mockWatchdog := &definitionmocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedLogger := &publictypesmocks.MockLogger{}
 	defer expectedLogger.AssertExpectations(t)
 
@@ -134,11 +157,17 @@ func TestFactory_New_Logger(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
 	internalResources := definition.NewDependenciesProviderResources(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
 		mockWatchdog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -146,6 +175,7 @@ func TestFactory_New_Logger(t *testing.T) {
 		mockLoggerFactory,
 		mockConfigFactory,
 		mockWatchdogFactory,
+		mockMATLABFactory,
 	).New(internalResources)
 
 	// Assert
@@ -163,6 +193,9 @@ func TestFactory_New_Config(t *testing.T) {
 	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
 	defer mockWatchdogFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -175,6 +208,9 @@ func TestFactory_New_Config(t *testing.T) {
 	mockWatchdog := &definitionmocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedConfig := &publictypesmocks.MockConfig{}
 	defer expectedConfig.AssertExpectations(t)
 
@@ -193,11 +229,17 @@ func TestFactory_New_Config(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
 	internalResources := definition.NewDependenciesProviderResources(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
 		mockWatchdog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -205,6 +247,7 @@ func TestFactory_New_Config(t *testing.T) {
 		mockLoggerFactory,
 		mockConfigFactory,
 		mockWatchdogFactory,
+		mockMATLABFactory,
 	).New(internalResources)
 
 	// Assert
@@ -222,6 +265,9 @@ func TestFactory_New_Watchdog(t *testing.T) {
 	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
 	defer mockWatchdogFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -234,6 +280,9 @@ func TestFactory_New_Watchdog(t *testing.T) {
 	mockWatchdog := &definitionmocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedWatchdog := &publictypesmocks.MockWatchdog{}
 	defer expectedWatchdog.AssertExpectations(t)
 
@@ -252,11 +301,17 @@ func TestFactory_New_Watchdog(t *testing.T) {
 		Return(expectedWatchdog).
 		Once()
 
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
 	internalResources := definition.NewDependenciesProviderResources(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
 		mockWatchdog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -264,8 +319,81 @@ func TestFactory_New_Watchdog(t *testing.T) {
 		mockLoggerFactory,
 		mockConfigFactory,
 		mockWatchdogFactory,
+		mockMATLABFactory,
 	).New(internalResources)
 
 	// Assert
 	require.Equal(t, expectedWatchdog, resources.Watchdog())
 }
+
+func TestFactory_New_MATLAB(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &dependenciesproviderresourcesmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &dependenciesproviderresourcesmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockWatchdogFactory := &dependenciesproviderresourcesmocks.MockWatchdogFactory{}
+	defer mockWatchdogFactory.AssertExpectations(t)
+
+	mockMATLABFactory := &dependenciesproviderresourcesmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
+	mockInternalLogger := &entitiesmocks.MockLogger{}
+	defer mockInternalLogger.AssertExpectations(t)
+
+	mockInternalConfig := &internalconfigmocks.MockGenericConfig{}
+	defer mockInternalConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockWatchdog := &definitionmocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	expectedMATLAB := &publictypesmocks.MockMATLAB{}
+	defer expectedMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		New(mockInternalLogger).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		New(mockInternalConfig, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	mockWatchdogFactory.EXPECT().
+		New(mockInternalLogger, mockWatchdog).
+		Return(nil).
+		Once()
+
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(expectedMATLAB).
+		Once()
+
+	internalResources := definition.NewDependenciesProviderResources(
+		mockInternalLogger,
+		mockInternalConfig,
+		mockMessageCatalog,
+		mockWatchdog,
+		mockGlobalMATLAB,
+	)
+
+	// Act
+	resources := dependenciesproviderresources.NewFactory(
+		mockLoggerFactory,
+		mockConfigFactory,
+		mockWatchdogFactory,
+		mockMATLABFactory,
+	).New(internalResources)
+
+	// Assert
+	require.Equal(t, expectedMATLAB, resources.MATLAB())
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlab
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	internalmessages "github.com/matlab/matlab-mcp-core-server/internal/messages"
+)
+
+type InternalGlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+}
+
+type InternalMessageCatalog interface {
+	GetFromError(err internalmessages.Error) string
+}
+
+type MessagesFactory interface {
+	New(messageCatalog messages.MessageCatalog) messages.I18nErrorFactory
+}
+
+type Factory struct {
+	messagesFactory MessagesFactory
+}
+
+func NewFactory(
+	messagesFactory MessagesFactory,
+) *Factory {
+	return &Factory{
+		messagesFactory: messagesFactory,
+	}
+}
+
+// New adapts the server managed MATLAB for SDK tools.
+// internalGlobalMATLAB is nil when the application does not enable the MATLAB feature.
+func (f *Factory) New(
+	logger entities.Logger,
+	internalGlobalMATLAB InternalGlobalMATLAB,
+	internalMessageCatalog InternalMessageCatalog,
+) publictypes.MATLAB {
+	return &matlabAdaptor{
+		logger:               logger,
+		internalGlobalMATLAB: internalGlobalMATLAB,
+		errorFactory:         f.messagesFactory.New(internalMessageCatalog),
+	}
+}
+
+type matlabAdaptor struct {
+	logger               entities.Logger
+	internalGlobalMATLAB InternalGlobalMATLAB
+	errorFactory         messages.I18nErrorFactory
+}
+
+func (a *matlabAdaptor) Eval(ctx context.Context, request publictypes.EvalRequest) (publictypes.EvalResponse, publictypes.Error) {
+	client, publicErr := a.client(ctx)
+	if publicErr != nil {
+		return publictypes.EvalResponse{}, publicErr
+	}
+
+	response, err := client.Eval(ctx, a.logger, entities.EvalRequest{
+		Code: request.Code,
+	})
+	if err != nil {
+		return publictypes.EvalResponse{}, a.evaluationFailed(err)
+	}
+
+	return toPublicEvalResponse(response), nil
+}
+
+func (a *matlabAdaptor) EvalWithCapture(ctx context.Context, request publictypes.EvalRequest) (publictypes.EvalResponse, publictypes.Error) {
+	client, publicErr := a.client(ctx)
+	if publicErr != nil {
+		return publictypes.EvalResponse{}, publicErr
+	}
+
+	response, err := client.EvalWithCapture(ctx, a.logger, entities.EvalRequest{
+		Code: request.Code,
+	})
+	if err != nil {
+		return publictypes.EvalResponse{}, a.evaluationFailed(err)
+	}
+
+	return toPublicEvalResponse(response), nil
+}
+
+func (a *matlabAdaptor) FEval(ctx context.Context, request publictypes.FEvalRequest) (publictypes.FEvalResponse, publictypes.Error) {
+	client, publicErr := a.client(ctx)
+	if publicErr != nil {
+		return publictypes.FEvalResponse{}, publicErr
+	}
+
+	response, err := client.FEval(ctx, a.logger, entities.FEvalRequest{
+		Function:   request.Function,
+		Arguments:  request.Arguments,
+		NumOutputs: request.NumOutputs,
+	})
+	if err != nil {
+		return publictypes.FEvalResponse{}, a.evaluationFailed(err)
+	}
+
+	return publictypes.FEvalResponse{
+		Outputs: response.Outputs,
+	}, nil
+}
+
+func (a *matlabAdaptor) client(ctx context.Context) (entities.MATLABSessionClient, publictypes.Error) {
+	if a.internalGlobalMATLAB == nil {
+		return nil, a.errorFactory.FromInternalError(internalmessages.New_SDKErrors_MATLABFeatureDisabled_Error())
+	}
+
+	client, err := a.internalGlobalMATLAB.Client(ctx, a.logger)
+	if err != nil {
+		a.logger.WithError(err).Warn("Failed to get MATLAB client for SDK tool")
+		return nil, a.errorFactory.FromInternalError(internalmessages.New_SDKErrors_MATLABSessionUnavailable_Error(err.Error()))
+	}
+
+	return client, nil
+}
+
+func (a *matlabAdaptor) evaluationFailed(err error) publictypes.Error {
+	a.logger.WithError(err).Warn("MATLAB evaluation for SDK tool failed")
+	return a.errorFactory.FromInternalError(internalmessages.New_SDKErrors_MATLABEvaluationFailed_Error(err.Error()))
+}
+
+func toPublicEvalResponse(response entities.EvalResponse) publictypes.EvalResponse {
+	return publictypes.EvalResponse{
+		ConsoleOutput: response.ConsoleOutput,
+		Images:        response.Images,
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlab_test
+
+import (
+	"errors"
+	"testing"
+
+	matlabadaptor "github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/matlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/messages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	matlabmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/sdk/matlab"
+	messagesmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/sdk/messages"
+	publictypesmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/sdk/publictypes"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFactory_HappyPath(t *testing.T) {
+	// Arrange
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	// Act
+	factory := matlabadaptor.NewFactory(mockMessagesFactory)
+
+	// Assert
+	require.NotNil(t, factory)
+}
+
+func TestFactory_New_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	// Act
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Assert
+	require.NotNil(t, adaptor)
+}
+
+func TestMATLAB_Eval_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedCode := "disp('hello')"
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "hello",
+		Images:        [][]byte{[]byte("image")},
+	}
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger, entities.EvalRequest{Code: expectedCode}).
+		Return(expectedResponse, nil).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.Eval(ctx, publictypes.EvalRequest{Code: expectedCode})
+
+	// Assert
+	require.Nil(t, err)
+	require.Equal(t, publictypes.EvalResponse{
+		ConsoleOutput: expectedResponse.ConsoleOutput,
+		Images:        expectedResponse.Images,
+	}, response)
+}
+
+func TestMATLAB_EvalWithCapture_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedCode := "plot(1:10)"
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "",
+		Images:        [][]byte{[]byte("figure")},
+	}
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger, entities.EvalRequest{Code: expectedCode}).
+		Return(expectedResponse, nil).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.EvalWithCapture(ctx, publictypes.EvalRequest{Code: expectedCode})
+
+	// Assert
+	require.Nil(t, err)
+	require.Equal(t, publictypes.EvalResponse{
+		ConsoleOutput: expectedResponse.ConsoleOutput,
+		Images:        expectedResponse.Images,
+	}, response)
+}
+
+func TestMATLAB_FEval_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedRequest := publictypes.FEvalRequest{
+		Function:   "max",
+		Arguments:  []string{"[1 2 3]"},
+		NumOutputs: 2,
+	}
+	expectedOutputs := []any{3.0, 3.0}
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger, entities.FEvalRequest{
+			Function:   expectedRequest.Function,
+			Arguments:  expectedRequest.Arguments,
+			NumOutputs: expectedRequest.NumOutputs,
+		}).
+		Return(entities.FEvalResponse{Outputs: expectedOutputs}, nil).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.FEval(ctx, expectedRequest)
+
+	// Assert
+	require.Nil(t, err)
+	require.Equal(t, expectedOutputs, response.Outputs)
+}
+
+func TestMATLAB_Eval_FeatureDisabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	expectedError := &publictypesmocks.MockError{}
+	defer expectedError.AssertExpectations(t)
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockErrorFactory.EXPECT().
+		FromInternalError(messages.New_SDKErrors_MATLABFeatureDisabled_Error()).
+		Return(expectedError).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, nil, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.Eval(t.Context(), publictypes.EvalRequest{Code: "x = 1;"})
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	require.Empty(t, response)
+}
+
+func TestMATLAB_FEval_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	expectedError := &publictypesmocks.MockError{}
+	defer expectedError.AssertExpectations(t)
+
+	ctx := t.Context()
+	clientError := errors.New("MATLAB failed to start")
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger).
+		Return(nil, clientError).
+		Once()
+
+	mockErrorFactory.EXPECT().
+		FromInternalError(messages.New_SDKErrors_MATLABSessionUnavailable_Error(clientError.Error())).
+		Return(expectedError).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.FEval(ctx, publictypes.FEvalRequest{Function: "pwd", NumOutputs: 1})
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	require.Empty(t, response)
+
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	fields, found := warnLogs["Failed to get MATLAB client for SDK tool"]
+	require.True(t, found)
+	require.Equal(t, clientError, fields["error"])
+}
+
+func TestMATLAB_EvalWithCapture_EvaluationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB := &matlabmocks.MockInternalGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMessageCatalog := &matlabmocks.MockInternalMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockMessagesFactory := &matlabmocks.MockMessagesFactory{}
+	defer mockMessagesFactory.AssertExpectations(t)
+
+	mockErrorFactory := &messagesmocks.MockI18nErrorFactory{}
+	defer mockErrorFactory.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := &publictypesmocks.MockError{}
+	defer expectedError.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedCode := "undefinedFunction()"
+	evalError := errors.New("Undefined function 'undefinedFunction'")
+
+	mockMessagesFactory.EXPECT().
+		New(mockMessageCatalog).
+		Return(mockErrorFactory).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger, entities.EvalRequest{Code: expectedCode}).
+		Return(entities.EvalResponse{}, evalError).
+		Once()
+
+	mockErrorFactory.EXPECT().
+		FromInternalError(messages.New_SDKErrors_MATLABEvaluationFailed_Error(evalError.Error())).
+		Return(expectedError).
+		Once()
+
+	adaptor := matlabadaptor.NewFactory(mockMessagesFactory).New(mockLogger, mockGlobalMATLAB, mockMessageCatalog)
+
+	// Act
+	response, err := adaptor.EvalWithCapture(ctx, publictypes.EvalRequest{Code: expectedCode})
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	require.Empty(t, response)
+
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	fields, found := warnLogs["MATLAB evaluation for SDK tool failed"]
+	require.True(t, found)
+	require.Equal(t, evalError, fields["error"])
+}
@@ -6,6 +6,7 @@ type DependenciesProviderResources interface {
 	Logger() Logger
 	Config() Config
 	Watchdog() Watchdog
+	MATLAB() MATLAB
 }
 
 type DependenciesProvider[Dependencies any] func(DependenciesProviderResources) (Dependencies, Error)
@@ -0,0 +1,30 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package publictypes
+
+import "context"
+
+type MATLAB interface {
+	Eval(ctx context.Context, request EvalRequest) (EvalResponse, Error)
+	EvalWithCapture(ctx context.Context, request EvalRequest) (EvalResponse, Error)
+	FEval(ctx context.Context, request FEvalRequest) (FEvalResponse, Error)
+}
+
+type EvalRequest struct {
+	Code string
+}
+
+type EvalResponse struct {
+	ConsoleOutput string
+	Images        [][]byte
+}
+
+type FEvalRequest struct {
+	Function   string
+	Arguments  []string
+	NumOutputs int
+}
+
+type FEvalResponse struct {
+	Outputs []any
+}
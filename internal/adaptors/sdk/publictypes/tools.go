@@ -27,10 +27,53 @@ type ToolsProvider[Dependencies any] func(ToolsProviderResources[Dependencies])
 type ToolCallRequest interface {
 	Logger() Logger
 	Config() Config
+	MATLAB() MATLAB
 }
 
 type RichContent struct {
-	TextContent []string
+	TextContent       []string
+	ImageContent      []ImageContent
+	AudioContent      []AudioContent
+	EmbeddedResources []EmbeddedResource
+	ResourceLinks     []ResourceLink
+}
+
+type ImageMIMEType string
+
+const (
+	ImageMIMETypePNG  ImageMIMEType = "image/png"
+	ImageMIMETypeJPEG ImageMIMEType = "image/jpeg"
+)
+
+type ImageContent struct {
+	MIMEType ImageMIMEType
+	Data     []byte
+}
+
+// AudioContent is returned inline with the tool result.
+// MIMEType must be an audio type, for example audio/wav.
+type AudioContent struct {
+	MIMEType string
+	Data     []byte
+}
+
+// EmbeddedResource is returned inline with the tool result.
+// Set either Text for textual resources, or Blob for binary ones.
+type EmbeddedResource struct {
+	URI      string
+	MIMEType string
+	Text     string
+	Blob     []byte
+}
+
+// ResourceLink points the client at a resource it can fetch separately.
+// URI and Name are required.
+type ResourceLink struct {
+	URI         string
+	Name        string
+	Title       string
+	Description string
+	MIMEType    string
 }
 
 // Nifty little trick to create cross-package seals
@@ -10,6 +10,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/dependenciesproviderresources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/features"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/logger"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/matlab"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/messages"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/parameters"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/server"
@@ -27,10 +28,12 @@ func NewServer[Dependencies any](
 	loggerFactory := logger.NewFactory()
 	configFactory := config.NewFactory(messagesFactory)
 	watchdogFactory := watchdog.NewFactory()
+	matlabFactory := matlab.NewFactory(messagesFactory)
 	dependenciesProviderResourcesFactory := dependenciesproviderresources.NewFactory(
 		loggerFactory,
 		configFactory,
 		watchdogFactory,
+		matlabFactory,
 	)
 	dependenciesProviderFactory := dependenciesprovider.NewFactory[Dependencies](
 		dependenciesProviderResourcesFactory,
@@ -41,6 +44,7 @@ func NewServer[Dependencies any](
 	toolCallRequestFactory := toolcallrequest.NewFactory(
 		loggerFactory,
 		configFactory,
+		matlabFactory,
 	)
 	toolsProviderFactory := toolsprovider.NewFactory(
 		toolsProviderResourcesFactory,
@@ -6,6 +6,7 @@ import (
 	internalconfig "github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/matlab"
 	publictypes "github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
@@ -21,18 +22,29 @@ type ConfigFactory interface {
 	) publictypes.Config
 }
 
+type MATLABFactory interface {
+	New(
+		logger entities.Logger,
+		internalGlobalMATLAB matlab.InternalGlobalMATLAB,
+		internalMessageCatalog matlab.InternalMessageCatalog,
+	) publictypes.MATLAB
+}
+
 type Factory struct {
 	loggerFactory LoggerFactory
 	configFactory ConfigFactory
+	matlabFactory MATLABFactory
 }
 
 func NewFactory(
 	loggerFactory LoggerFactory,
 	configFactory ConfigFactory,
+	matlabFactory MATLABFactory,
 ) *Factory {
 	return &Factory{
 		loggerFactory: loggerFactory,
 		configFactory: configFactory,
+		matlabFactory: matlabFactory,
 	}
 }
 
@@ -40,16 +52,19 @@ func (f *Factory) New(
 	internalLogger entities.Logger,
 	internalConfig internalconfig.GenericConfig,
 	internalMessageCatalog definition.MessageCatalog,
+	internalGlobalMATLAB definition.GlobalMATLAB,
 ) publictypes.ToolCallRequest {
 	return &toolCallRequestAdaptor{
 		logger: f.loggerFactory.New(internalLogger),
 		config: f.configFactory.New(internalConfig, internalMessageCatalog),
+		matlab: f.matlabFactory.New(internalLogger, internalGlobalMATLAB, internalMessageCatalog),
 	}
 }
 
 type toolCallRequestAdaptor struct {
 	logger publictypes.Logger
 	config publictypes.Config
+	matlab publictypes.MATLAB
 }
 
 func (a *toolCallRequestAdaptor) Logger() publictypes.Logger {
@@ -59,3 +74,7 @@ func (a *toolCallRequestAdaptor) Logger() publictypes.Logger {
 func (a *toolCallRequestAdaptor) Config() publictypes.Config {
 	return a.config
 }
+
+func (a *toolCallRequestAdaptor) MATLAB() publictypes.MATLAB {
+	return a.matlab
+}
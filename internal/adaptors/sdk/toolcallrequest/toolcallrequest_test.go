@@ -22,8 +22,11 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockConfigFactory := &toolcallrequestmocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &toolcallrequestmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	// Act
-	factory := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory)
+	factory := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory, mockMATLABFactory)
 
 	// Assert
 	require.NotNil(t, factory)
@@ -37,6 +40,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockConfigFactory := &toolcallrequestmocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &toolcallrequestmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -46,6 +52,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockLoggerFactory.EXPECT().
 		New(mockInternalLogger).
 		Return(nil).
@@ -56,11 +65,17 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
 	// Act
-	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory).New(
+	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory, mockMATLABFactory).New(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 	)
 
 	// Assert
@@ -75,6 +90,9 @@ func TestFactory_New_Logger(t *testing.T) {
 	mockConfigFactory := &toolcallrequestmocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &toolcallrequestmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -84,6 +102,9 @@ func TestFactory_New_Logger(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedLogger := &publictypesmocks.MockLogger{}
 	defer expectedLogger.AssertExpectations(t)
 
@@ -97,10 +118,16 @@ func TestFactory_New_Logger(t *testing.T) {
 		Return(nil).
 		Once()
 
-	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory).New(
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory, mockMATLABFactory).New(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -118,6 +145,9 @@ func TestFactory_New_Config(t *testing.T) {
 	mockConfigFactory := &toolcallrequestmocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockMATLABFactory := &toolcallrequestmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
 	mockInternalLogger := &entitiesmocks.MockLogger{}
 	defer mockInternalLogger.AssertExpectations(t)
 
@@ -127,6 +157,9 @@ func TestFactory_New_Config(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedConfig := &publictypesmocks.MockConfig{}
 	defer expectedConfig.AssertExpectations(t)
 
@@ -140,10 +173,16 @@ func TestFactory_New_Config(t *testing.T) {
 		Return(expectedConfig).
 		Once()
 
-	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory).New(
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory, mockMATLABFactory).New(
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 	)
 
 	// Act
@@ -152,3 +191,58 @@ func TestFactory_New_Config(t *testing.T) {
 	// Assert
 	require.Equal(t, expectedConfig, result)
 }
+
+func TestFactory_New_MATLAB(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &toolcallrequestmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &toolcallrequestmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABFactory := &toolcallrequestmocks.MockMATLABFactory{}
+	defer mockMATLABFactory.AssertExpectations(t)
+
+	mockInternalLogger := &entitiesmocks.MockLogger{}
+	defer mockInternalLogger.AssertExpectations(t)
+
+	mockInternalConfig := &configmocks.MockGenericConfig{}
+	defer mockInternalConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	expectedMATLAB := &publictypesmocks.MockMATLAB{}
+	defer expectedMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		New(mockInternalLogger).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		New(mockInternalConfig, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	mockMATLABFactory.EXPECT().
+		New(mockInternalLogger, mockGlobalMATLAB, mockMessageCatalog).
+		Return(expectedMATLAB).
+		Once()
+
+	request := toolcallrequest.NewFactory(mockLoggerFactory, mockConfigFactory, mockMATLABFactory).New(
+		mockInternalLogger,
+		mockInternalConfig,
+		mockMessageCatalog,
+		mockGlobalMATLAB,
+	)
+
+	// Act
+	result := request.MATLAB()
+
+	// Assert
+	require.Equal(t, expectedMATLAB, result)
+}
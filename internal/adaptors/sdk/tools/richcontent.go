@@ -0,0 +1,86 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	internaltools "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	publictypes "github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func toInternalRichContent(richContent publictypes.RichContent) (internaltools.RichContent, error) {
+	additionalContent := []mcp.Content{}
+
+	for _, image := range richContent.ImageContent {
+		if image.MIMEType != publictypes.ImageMIMETypePNG && image.MIMEType != publictypes.ImageMIMETypeJPEG {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM+"unsupported image MIME type %q", image.MIMEType)
+		}
+
+		additionalContent = append(additionalContent, &mcp.ImageContent{
+			MIMEType: string(image.MIMEType),
+			Data:     image.Data,
+		})
+	}
+
+	for _, audio := range richContent.AudioContent {
+		if !strings.HasPrefix(audio.MIMEType, "audio/") {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM+"unsupported audio MIME type %q", audio.MIMEType)
+		}
+
+		additionalContent = append(additionalContent, &mcp.AudioContent{
+			MIMEType: audio.MIMEType,
+			Data:     audio.Data,
+		})
+	}
+
+	for _, resource := range richContent.EmbeddedResources {
+		if resource.URI == "" {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM + "embedded resource has no URI")
+		}
+
+		if resource.Text != "" && resource.Blob != nil {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM+"embedded resource %q sets both text and blob", resource.URI)
+		}
+
+		if resource.Text == "" && resource.Blob == nil {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM+"embedded resource %q sets neither text nor blob", resource.URI)
+		}
+
+		additionalContent = append(additionalContent, &mcp.EmbeddedResource{
+			Resource: &mcp.ResourceContents{
+				URI:      resource.URI,
+				MIMEType: resource.MIMEType,
+				Text:     resource.Text,
+				Blob:     resource.Blob,
+			},
+		})
+	}
+
+	for _, link := range richContent.ResourceLinks {
+		if link.URI == "" {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM + "resource link has no URI")
+		}
+
+		if link.Name == "" {
+			return internaltools.RichContent{}, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM+"resource link %q has no name", link.URI)
+		}
+
+		additionalContent = append(additionalContent, &mcp.ResourceLink{
+			URI:         link.URI,
+			Name:        link.Name,
+			Title:       link.Title,
+			Description: link.Description,
+			MIMEType:    link.MIMEType,
+		})
+	}
+
+	return internaltools.RichContent{
+		TextContent:       richContent.TextContent,
+		ImageContent:      nil,
+		AdditionalContent: additionalContent,
+	}, nil
+}
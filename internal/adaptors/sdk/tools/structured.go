@@ -35,6 +35,7 @@ func (t *ToolWithStructuredContentOutput[ToolInput, ToolOutput]) ToInternal(
 	loggerFactoryInstance basetool.LoggerFactory,
 	config internalconfig.GenericConfig,
 	messageCatalog definition.MessageCatalog,
+	globalMATLAB definition.GlobalMATLAB,
 ) internaltools.Tool {
 	annotations, ok := t.definition.Annotations.(ConvertibleAnnotation)
 	if !ok {
@@ -47,7 +48,7 @@ func (t *ToolWithStructuredContentOutput[ToolInput, ToolOutput]) ToInternal(
 		t.definition.Description,
 		annotations,
 		loggerFactoryInstance,
-		adaptStructuredHandler(toolCallRequestFactory, config, messageCatalog, t.handler),
+		adaptStructuredHandler(toolCallRequestFactory, config, messageCatalog, globalMATLAB, t.handler),
 	)
 }
 
@@ -55,6 +56,7 @@ func adaptStructuredHandler[ToolInput, ToolOutput any](
 	toolCallRequestFactory ToolCallRequestFactory,
 	config internalconfig.GenericConfig,
 	messageCatalog definition.MessageCatalog,
+	globalMATLAB definition.GlobalMATLAB,
 	handler StructuredHandler[ToolInput, ToolOutput],
 ) basetool.HandlerWithStructuredContentOutput[ToolInput, ToolOutput] {
 	return func(ctx context.Context, logger entities.Logger, inputs ToolInput) (ToolOutput, error) {
@@ -62,6 +64,7 @@ func adaptStructuredHandler[ToolInput, ToolOutput any](
 			logger,
 			config,
 			messageCatalog,
+			globalMATLAB,
 		)
 
 		return handler(ctx, callRequest, inputs)
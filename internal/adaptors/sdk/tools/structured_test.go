@@ -31,6 +31,9 @@ func TestNewStructured_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -49,7 +52,7 @@ func TestNewStructured_HappyPath(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -62,7 +65,7 @@ func TestNewStructured_HappyPath(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -87,6 +90,9 @@ func TestNewStructured_HandlerError(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -104,7 +110,7 @@ func TestNewStructured_HandlerError(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -115,7 +121,7 @@ func TestNewStructured_HandlerError(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -140,6 +146,9 @@ func TestNewStructured_HandlerReceivesLogger(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -160,7 +169,7 @@ func TestNewStructured_HandlerReceivesLogger(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -182,7 +191,7 @@ func TestNewStructured_HandlerReceivesLogger(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -207,6 +216,9 @@ func TestNewStructured_HandlerReceivesConfig(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -228,7 +240,7 @@ func TestNewStructured_HandlerReceivesConfig(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -252,7 +264,7 @@ func TestNewStructured_HandlerReceivesConfig(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -277,6 +289,9 @@ func TestNewStructured_DefinitionFieldsForwarded(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -298,7 +313,7 @@ func TestNewStructured_DefinitionFieldsForwarded(t *testing.T) {
 	)
 
 	// Act
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithStructuredContentOutput[structuredToolInput, structuredToolOutput])
 
 	// Assert
 	require.Equal(t, expectedName, internalTool.Name())
@@ -16,6 +16,7 @@ type ToolCallRequestFactory interface {
 		internalLogger entities.Logger,
 		internalConfig internalconfig.GenericConfig,
 		internalMessageCatalog definition.MessageCatalog,
+		internalGlobalMATLAB definition.GlobalMATLAB,
 	) publictypes.ToolCallRequest
 }
 
@@ -26,5 +27,6 @@ type ConvertibleTool interface {
 		loggerFactory basetool.LoggerFactory,
 		config internalconfig.GenericConfig,
 		messageCatalog definition.MessageCatalog,
+		globalMATLAB definition.GlobalMATLAB,
 	) internaltools.Tool
 }
@@ -35,6 +35,7 @@ func (t *ToolWithUnstructuredContentOutput[ToolInput]) ToInternal(
 	loggerFactoryInstance basetool.LoggerFactory,
 	config internalconfig.GenericConfig,
 	messageCatalog definition.MessageCatalog,
+	globalMATLAB definition.GlobalMATLAB,
 ) internaltools.Tool {
 	annotations, ok := t.definition.Annotations.(ConvertibleAnnotation)
 	if !ok {
@@ -47,7 +48,7 @@ func (t *ToolWithUnstructuredContentOutput[ToolInput]) ToInternal(
 		t.definition.Description,
 		annotations,
 		loggerFactoryInstance,
-		adaptUnstructuredHandler(toolCallRequestFactory, config, messageCatalog, t.handler),
+		adaptUnstructuredHandler(toolCallRequestFactory, config, messageCatalog, globalMATLAB, t.handler),
 	)
 }
 
@@ -55,6 +56,7 @@ func adaptUnstructuredHandler[ToolInput any](
 	toolCallRequestFactory ToolCallRequestFactory,
 	config internalconfig.GenericConfig,
 	messageCatalog definition.MessageCatalog,
+	globalMATLAB definition.GlobalMATLAB,
 	handler UnstructuredHandler[ToolInput],
 ) basetool.HandlerWithUnstructuredContentOutput[ToolInput] {
 	return func(ctx context.Context, logger entities.Logger, inputs ToolInput) (internaltools.RichContent, error) {
@@ -62,6 +64,7 @@ func adaptUnstructuredHandler[ToolInput any](
 			logger,
 			config,
 			messageCatalog,
+			globalMATLAB,
 		)
 
 		richContent, err := handler(ctx, callRequest, inputs)
@@ -69,9 +72,6 @@ func adaptUnstructuredHandler[ToolInput any](
 			return internaltools.RichContent{}, err
 		}
 
-		return internaltools.RichContent{
-			TextContent:  richContent.TextContent,
-			ImageContent: nil,
-		}, nil
+		return toInternalRichContent(richContent)
 	}
 }
@@ -31,6 +31,9 @@ func TestNewUnstructured_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -49,7 +52,7 @@ func TestNewUnstructured_HappyPath(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -64,7 +67,7 @@ func TestNewUnstructured_HappyPath(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -87,6 +90,208 @@ func TestNewUnstructured_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedTextContent[1], textContent2.Text)
 }
 
+func TestNewUnstructured_RichContent(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockGenericConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSession := &mcp.ServerSession{}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockLogger, nil).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
+		Return(mockCallRequest).
+		Once()
+
+	tool := tools.NewUnstructured(
+		publictypes.ToolDefinition{Name: "test-tool"},
+		func(ctx context.Context, request publictypes.ToolCallRequest, input toolInput) (publictypes.RichContent, publictypes.Error) {
+			return publictypes.RichContent{
+				TextContent: []string{"Figure created"},
+				ImageContent: []publictypes.ImageContent{
+					{MIMEType: publictypes.ImageMIMETypePNG, Data: []byte("png")},
+					{MIMEType: publictypes.ImageMIMETypeJPEG, Data: []byte("jpeg")},
+				},
+				AudioContent: []publictypes.AudioContent{
+					{MIMEType: "audio/wav", Data: []byte("wav")},
+				},
+				EmbeddedResources: []publictypes.EmbeddedResource{
+					{URI: "file:///tmp/data.csv", MIMEType: "text/csv", Text: "a,b"},
+					{URI: "file:///tmp/data.mat", MIMEType: "application/octet-stream", Blob: []byte("mat")},
+				},
+				ResourceLinks: []publictypes.ResourceLink{
+					{URI: "file:///tmp/figure.fig", Name: "figure.fig", Title: "Figure", Description: "Saved figure", MIMEType: "application/octet-stream"},
+				},
+			}, nil
+		},
+	)
+
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+
+	mcpCallToolRequest := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, _, err := internalTool.Handler()(t.Context(), mcpCallToolRequest, toolInput{})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []mcp.Content{
+		&mcp.TextContent{Text: "Figure created"},
+		&mcp.ImageContent{MIMEType: "image/png", Data: []byte("png")},
+		&mcp.ImageContent{MIMEType: "image/jpeg", Data: []byte("jpeg")},
+		&mcp.AudioContent{MIMEType: "audio/wav", Data: []byte("wav")},
+		&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "file:///tmp/data.csv", MIMEType: "text/csv", Text: "a,b"}},
+		&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "file:///tmp/data.mat", MIMEType: "application/octet-stream", Blob: []byte("mat")}},
+		&mcp.ResourceLink{URI: "file:///tmp/figure.fig", Name: "figure.fig", Title: "Figure", Description: "Saved figure", MIMEType: "application/octet-stream"},
+	}, result.Content)
+}
+
+func TestNewUnstructured_InvalidRichContent(t *testing.T) {
+	testCases := []struct {
+		name          string
+		richContent   publictypes.RichContent
+		expectedError string
+	}{
+		{
+			name: "unsupported image MIME type",
+			richContent: publictypes.RichContent{
+				ImageContent: []publictypes.ImageContent{{MIMEType: "image/gif", Data: []byte("gif")}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `unsupported image MIME type "image/gif"`,
+		},
+		{
+			name: "unsupported audio MIME type",
+			richContent: publictypes.RichContent{
+				AudioContent: []publictypes.AudioContent{{MIMEType: "video/mp4", Data: []byte("mp4")}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `unsupported audio MIME type "video/mp4"`,
+		},
+		{
+			name: "audio without MIME type",
+			richContent: publictypes.RichContent{
+				AudioContent: []publictypes.AudioContent{{Data: []byte("wav")}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `unsupported audio MIME type ""`,
+		},
+		{
+			name: "embedded resource without URI",
+			richContent: publictypes.RichContent{
+				EmbeddedResources: []publictypes.EmbeddedResource{{Text: "content"}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + "embedded resource has no URI",
+		},
+		{
+			name: "embedded resource with text and blob",
+			richContent: publictypes.RichContent{
+				EmbeddedResources: []publictypes.EmbeddedResource{{URI: "file:///tmp/x", Text: "content", Blob: []byte("content")}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `embedded resource "file:///tmp/x" sets both text and blob`,
+		},
+		{
+			name: "embedded resource without text or blob",
+			richContent: publictypes.RichContent{
+				EmbeddedResources: []publictypes.EmbeddedResource{{URI: "file:///tmp/x", MIMEType: "text/plain"}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `embedded resource "file:///tmp/x" sets neither text nor blob`,
+		},
+		{
+			name: "resource link without URI",
+			richContent: publictypes.RichContent{
+				ResourceLinks: []publictypes.ResourceLink{{Name: "link"}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + "resource link has no URI",
+		},
+		{
+			name: "resource link without name",
+			richContent: publictypes.RichContent{
+				ResourceLinks: []publictypes.ResourceLink{{URI: "file:///tmp/figure.fig"}},
+			},
+			expectedError: basetool.UnexpectedErrorPrefixForLLM + `resource link "file:///tmp/figure.fig" has no name`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockGenericConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+			defer mockMessageCatalog.AssertExpectations(t)
+
+			mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
+			defer mockToolCallRequestFactory.AssertExpectations(t)
+
+			mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+			defer mockCallRequest.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			expectedSession := &mcp.ServerSession{}
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(expectedSession).
+				Return(mockLogger, nil).
+				Once()
+
+			mockToolCallRequestFactory.EXPECT().
+				New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
+				Return(mockCallRequest).
+				Once()
+
+			tool := tools.NewUnstructured(
+				publictypes.ToolDefinition{Name: "test-tool"},
+				func(ctx context.Context, request publictypes.ToolCallRequest, input toolInput) (publictypes.RichContent, publictypes.Error) {
+					return tc.richContent, nil
+				},
+			)
+
+			internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+
+			mcpCallToolRequest := &mcp.CallToolRequest{
+				Session: expectedSession,
+			}
+
+			// Act
+			result, _, err := internalTool.Handler()(t.Context(), mcpCallToolRequest, toolInput{})
+
+			// Assert
+			require.EqualError(t, err, tc.expectedError)
+			require.Nil(t, result)
+		})
+	}
+}
+
 func TestNewUnstructured_HandlerError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
@@ -98,6 +303,9 @@ func TestNewUnstructured_HandlerError(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -115,7 +323,7 @@ func TestNewUnstructured_HandlerError(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -126,7 +334,7 @@ func TestNewUnstructured_HandlerError(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -151,6 +359,9 @@ func TestNewUnstructured_HandlerReceivesLogger(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -171,7 +382,7 @@ func TestNewUnstructured_HandlerReceivesLogger(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -193,7 +404,7 @@ func TestNewUnstructured_HandlerReceivesLogger(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -217,6 +428,9 @@ func TestNewUnstructured_HandlerReceivesConfig(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -238,7 +452,7 @@ func TestNewUnstructured_HandlerReceivesConfig(t *testing.T) {
 		Once()
 
 	mockToolCallRequestFactory.EXPECT().
-		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog).
+		New(mockLogger.AsMockArg(), mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockCallRequest).
 		Once()
 
@@ -262,7 +476,7 @@ func TestNewUnstructured_HandlerReceivesConfig(t *testing.T) {
 		},
 	)
 
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
 
 	mcpCallToolRequest := &mcp.CallToolRequest{
 		Session: expectedSession,
@@ -286,6 +500,9 @@ func TestNewUnstructured_DefinitionFieldsForwarded(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockToolCallRequestFactory := &toolsmocks.MockToolCallRequestFactory{}
 	defer mockToolCallRequestFactory.AssertExpectations(t)
 
@@ -307,7 +524,7 @@ func TestNewUnstructured_DefinitionFieldsForwarded(t *testing.T) {
 	)
 
 	// Act
-	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).(basetool.ToolWithUnstructuredContentOutput[toolInput])
+	internalTool := tool.ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).(basetool.ToolWithUnstructuredContentOutput[toolInput])
 
 	// Assert
 	require.Equal(t, expectedName, internalTool.Name())
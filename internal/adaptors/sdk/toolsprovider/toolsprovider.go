@@ -54,6 +54,7 @@ func (f *Factory[Dependencies]) New(provider ToolsProvider[Dependencies]) defini
 				internalResources.LoggerFactory,
 				internalResources.Config,
 				internalResources.MessageCatalog,
+				internalResources.GlobalMATLAB,
 			))
 		}
 
@@ -57,6 +57,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockInternalTool := &internaltoolsmocks.MockTool{}
 	defer mockInternalTool.AssertExpectations(t)
 
@@ -67,6 +70,7 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		Logger:         testutils.NewInspectableLogger(),
 		Config:         mockConfig,
 		MessageCatalog: mockMessageCatalog,
+		GlobalMATLAB:   mockGlobalMATLAB,
 		LoggerFactory:  mockLoggerFactory,
 	}
 
@@ -76,7 +80,7 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		Once()
 
 	mockTool.EXPECT().
-		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).
+		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockInternalTool).
 		Once()
 
@@ -116,10 +120,14 @@ func TestFactory_New_EmptyTools(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	expectedInternalResources := definition.ToolsProviderResources{
 		Logger:         testutils.NewInspectableLogger(),
 		Config:         mockConfig,
 		MessageCatalog: mockMessageCatalog,
+		GlobalMATLAB:   mockGlobalMATLAB,
 		LoggerFactory:  mockLoggerFactory,
 	}
 
@@ -162,6 +170,9 @@ func TestFactory_New_MultipleTools(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockInternalTool1 := &internaltoolsmocks.MockTool{}
 	defer mockInternalTool1.AssertExpectations(t)
 
@@ -178,6 +189,7 @@ func TestFactory_New_MultipleTools(t *testing.T) {
 		Logger:         testutils.NewInspectableLogger(),
 		Config:         mockConfig,
 		MessageCatalog: mockMessageCatalog,
+		GlobalMATLAB:   mockGlobalMATLAB,
 		LoggerFactory:  mockLoggerFactory,
 	}
 
@@ -187,12 +199,12 @@ func TestFactory_New_MultipleTools(t *testing.T) {
 		Once()
 
 	mockTool1.EXPECT().
-		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).
+		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockInternalTool1).
 		Once()
 
 	mockTool2.EXPECT().
-		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).
+		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockInternalTool2).
 		Once()
 
@@ -248,6 +260,9 @@ func TestFactory_New_NonConvertibleToolSkipped(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockInternalTool := &internaltoolsmocks.MockTool{}
 	defer mockInternalTool.AssertExpectations(t)
 
@@ -260,6 +275,7 @@ func TestFactory_New_NonConvertibleToolSkipped(t *testing.T) {
 		Logger:         testutils.NewInspectableLogger(),
 		Config:         mockConfig,
 		MessageCatalog: mockMessageCatalog,
+		GlobalMATLAB:   mockGlobalMATLAB,
 		LoggerFactory:  mockLoggerFactory,
 	}
 
@@ -269,7 +285,7 @@ func TestFactory_New_NonConvertibleToolSkipped(t *testing.T) {
 		Once()
 
 	mockTool.EXPECT().
-		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog).
+		ToInternal(mockToolCallRequestFactory, mockLoggerFactory, mockConfig, mockMessageCatalog, mockGlobalMATLAB).
 		Return(mockInternalTool).
 		Once()
 
@@ -46,6 +46,9 @@ func TestFactory_New_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockBaseToolLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockBaseToolLoggerFactory.AssertExpectations(t)
 
@@ -58,6 +61,7 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		&TestDependencies{Value: "test"},
 		mockBaseToolLoggerFactory,
 	)
@@ -83,6 +87,9 @@ func TestFactory_New_Logger(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockBaseToolLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockBaseToolLoggerFactory.AssertExpectations(t)
 
@@ -98,6 +105,7 @@ func TestFactory_New_Logger(t *testing.T) {
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		&TestDependencies{Value: "test"},
 		mockBaseToolLoggerFactory,
 	)
@@ -123,6 +131,9 @@ func TestFactory_New_Dependencies_HappyPath(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockBaseToolLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockBaseToolLoggerFactory.AssertExpectations(t)
 
@@ -137,6 +148,7 @@ func TestFactory_New_Dependencies_HappyPath(t *testing.T) {
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		expectedDependencies,
 		mockBaseToolLoggerFactory,
 	)
@@ -162,6 +174,9 @@ func TestFactory_New_Dependencies_Nil(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockBaseToolLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockBaseToolLoggerFactory.AssertExpectations(t)
 
@@ -174,6 +189,7 @@ func TestFactory_New_Dependencies_Nil(t *testing.T) {
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		nil,
 		mockBaseToolLoggerFactory,
 	)
@@ -200,6 +216,9 @@ func TestFactory_New_Dependencies_CastFailure(t *testing.T) {
 	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
 	defer mockMessageCatalog.AssertExpectations(t)
 
+	mockGlobalMATLAB := &definitionmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
 	mockBaseToolLoggerFactory := &basetoolmocks.MockLoggerFactory{}
 	defer mockBaseToolLoggerFactory.AssertExpectations(t)
 
@@ -217,6 +236,7 @@ func TestFactory_New_Dependencies_CastFailure(t *testing.T) {
 		mockInternalLogger,
 		mockInternalConfig,
 		mockMessageCatalog,
+		mockGlobalMATLAB,
 		"wrong type",
 		mockBaseToolLoggerFactory,
 	)
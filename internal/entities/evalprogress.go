@@ -0,0 +1,28 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+// EvalProgressReporter receives incremental updates while MATLAB is still evaluating code.
+// The final evaluation result is returned as usual; these updates are only informational.
+type EvalProgressReporter interface {
+	ReportConsoleOutput(ctx context.Context, output string)
+	ReportProgress(ctx context.Context, fraction float64, message string)
+	// WantsConsoleOutput reports whether console output should be mirrored while MATLAB evaluates code.
+	WantsConsoleOutput() bool
+}
+
+type evalProgressReporterKey struct{}
+
+// ContextWithEvalProgressReporter returns a copy of ctx that carries reporter.
+// The reporter travels with the context because it belongs to the originating tool call, not to the MATLAB session.
+func ContextWithEvalProgressReporter(ctx context.Context, reporter EvalProgressReporter) context.Context {
+	return context.WithValue(ctx, evalProgressReporterKey{}, reporter)
+}
+
+// EvalProgressReporterFromContext returns the reporter carried by ctx, or nil when there is none.
+func EvalProgressReporterFromContext(ctx context.Context) EvalProgressReporter {
+	reporter, _ := ctx.Value(evalProgressReporterKey{}).(EvalProgressReporter)
+	return reporter
+}
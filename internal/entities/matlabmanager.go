@@ -2,7 +2,12 @@
 
 package entities
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
 
 type MATLABSessionClient interface {
 	Eval(ctx context.Context, sessionLogger Logger, request EvalRequest) (EvalResponse, error)
@@ -25,6 +30,27 @@ type EnvironmentInfo struct {
 
 type SessionID int
 
+// MATLABSessionInfo describes a MATLAB session managed by the server.
+type MATLABSessionInfo struct {
+	SessionID  SessionID
+	MATLABRoot string
+	Version    string
+	ProcessID  int
+	StartedAt  time.Time
+	LastUsedAt time.Time
+	IsBusy     bool
+	IsAlive    bool
+}
+
+// SharedMATLABSession describes a MATLAB session shared with shareMATLABSession.
+type SharedMATLABSession struct {
+	ProcessID     int
+	Name          string
+	Release       string
+	WorkingFolder string
+	SharedAt      time.Time
+}
+
 // SessionDetails is an interface to disambiguate which type of MATLAB session to start.
 type SessionDetails interface {
 	interfacelock()
@@ -35,16 +61,28 @@ type LocalSessionDetails struct {
 	IsStartingDirectorySet bool
 	StartingDirectory      string
 	ShowMATLABDesktop      bool
+	// StartupScript is a MATLAB script to run once the session is initialized. Empty means none.
+	StartupScript string
+	// EnvironmentVariables are set in the MATLAB process, in addition to the environment of the server.
+	EnvironmentVariables map[string]string
+	// AdditionalFlags are passed on the MATLAB command line.
+	AdditionalFlags []string
 }
 
 func (l LocalSessionDetails) interfacelock() {}
 
-type AttachToExistingSession struct{}
+// AttachToExistingSession attaches to a MATLAB session shared with shareMATLABSession.
+// When ProcessID is zero, the session is chosen using the configured session selector.
+type AttachToExistingSession struct {
+	ProcessID int
+}
 
 func (a AttachToExistingSession) interfacelock() {}
 
 type EvalRequest struct {
 	Code string
+	// Timeout bounds how long the code may run before MATLAB is interrupted. Zero means no limit.
+	Timeout time.Duration
 }
 
 type EvalResponse struct {
@@ -62,6 +100,66 @@ type FEvalResponse struct {
 	Outputs []any
 }
 
+// ErrEvaluationCancelled is returned when an evaluation is abandoned because its context was cancelled.
+// MATLAB is asked to interrupt the code only when the request reached MATLAB and MATLAB had not answered it yet,
+// use errors.As with *EvalCancelledError to tell whether it was.
+var ErrEvaluationCancelled = errors.New("evaluation cancelled")
+
+// EvalCancelledError is returned when an evaluation is abandoned because its context was cancelled.
+// Interrupted reports whether MATLAB was interrupted and responded again afterwards. It matches ErrEvaluationCancelled with errors.Is.
+type EvalCancelledError struct {
+	Interrupted bool
+}
+
+func (e *EvalCancelledError) Error() string {
+	if e.Interrupted {
+		return ErrEvaluationCancelled.Error() + " and MATLAB execution was interrupted"
+	}
+
+	return ErrEvaluationCancelled.Error() + " without interrupting MATLAB"
+}
+
+func (e *EvalCancelledError) Is(target error) bool {
+	return target == ErrEvaluationCancelled
+}
+
+// EvalTimeoutError is returned when an evaluation runs past its timeout and MATLAB was interrupted.
+// PartialResponse holds whatever output MATLAB produced before the interrupt.
+// NotStarted reports that the timeout expired before the code reached MATLAB, so it did not run and nothing was interrupted.
+type EvalTimeoutError struct {
+	Timeout         time.Duration
+	PartialResponse EvalResponse
+	NotStarted      bool
+}
+
+func (e *EvalTimeoutError) Error() string {
+	if e.NotStarted {
+		return fmt.Sprintf("evaluation timed out after %s before the request reached MATLAB, so the code did not run", e.Timeout)
+	}
+
+	message := fmt.Sprintf("evaluation timed out after %s and MATLAB execution was interrupted", e.Timeout)
+	if e.PartialResponse.ConsoleOutput == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s. Output captured before the timeout:\n%s", message, e.PartialResponse.ConsoleOutput)
+}
+
 type PingResponse struct {
 	IsAlive bool
 }
+
+// MATLABSessionLog holds the output and diagnostics files written by a MATLAB session started by the server.
+type MATLABSessionLog struct {
+	// Stdout and Stderr hold the last lines written by MATLAB.
+	Stdout       string
+	Stderr       string
+	StartupError string
+	CrashDumps   []MATLABCrashDump
+}
+
+// MATLABCrashDump is a crash dump file written by MATLAB.
+type MATLABCrashDump struct {
+	FileName string
+	Content  string
+}
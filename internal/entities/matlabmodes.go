@@ -15,3 +15,6 @@ const (
 	MATLABSessionModeNew      MATLABSessionMode = "new"
 	MATLABSessionModeExisting MATLABSessionMode = "existing"
 )
+
+// MATLABSessionSelectorLatest selects the most recently shared MATLAB session when attaching to an existing session.
+const MATLABSessionSelectorLatest = "latest"
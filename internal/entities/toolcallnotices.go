@@ -0,0 +1,24 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+// ToolCallNoticeRecorder collects notices that should be shown to the client alongside the result of a tool call,
+// such as a MATLAB session having been restarted while handling it.
+type ToolCallNoticeRecorder interface {
+	AddNotice(notice string)
+}
+
+type toolCallNoticeRecorderKey struct{}
+
+// ContextWithToolCallNoticeRecorder returns a copy of ctx that carries recorder.
+func ContextWithToolCallNoticeRecorder(ctx context.Context, recorder ToolCallNoticeRecorder) context.Context {
+	return context.WithValue(ctx, toolCallNoticeRecorderKey{}, recorder)
+}
+
+// ToolCallNoticeRecorderFromContext returns the recorder carried by ctx, or nil when there is none.
+func ToolCallNoticeRecorderFromContext(ctx context.Context) ToolCallNoticeRecorder {
+	recorder, _ := ctx.Value(toolCallNoticeRecorderKey{}).(ToolCallNoticeRecorder)
+	return recorder
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+type Transport string
+
+const (
+	TransportStdio Transport = "stdio"
+	TransportHTTP  Transport = "http"
+)
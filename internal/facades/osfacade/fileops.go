@@ -1,9 +1,10 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package osfacade
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -94,6 +95,16 @@ func (osw *OsFacade) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
 
+// Remove wraps the os.Remove function to delete a single file.
+func (osw *OsFacade) Remove(name string) error {
+	return os.Remove(name)
+}
+
+// Glob wraps the filepath.Glob function to list the files matching a pattern.
+func (osw *OsFacade) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
 // ReadFile wraps the os.ReadFile function to read a file content.
 func (osw *OsFacade) ReadFile(filePath string) ([]byte, error) {
 	return os.ReadFile(filePath) //nolint:gosec // Intentional os.ReadFile usage in facade
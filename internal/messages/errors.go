@@ -28,6 +28,64 @@ func New_AddonManagerErrors_InstallFailed_Error(
 	}
 }
 
+// SDKErrors_MATLABEvaluationFailed_Error defines an error corresponding to the "SDKErrors_MATLABEvaluationFailed" message catalog message
+type SDKErrors_MATLABEvaluationFailed_Error struct {
+	Attr0 string
+}
+
+// Error makes SDKErrors_MATLABEvaluationFailed_Error satisfy the error interface.
+func (e *SDKErrors_MATLABEvaluationFailed_Error) Error() string {
+	return "SDKErrors_MATLABEvaluationFailed_Error"
+}
+
+func (*SDKErrors_MATLABEvaluationFailed_Error) marker() {}
+
+// New_SDKErrors_MATLABEvaluationFailed_Error makes a new SDKErrors_MATLABEvaluationFailed_Error error.
+func New_SDKErrors_MATLABEvaluationFailed_Error(
+	attr0 string,
+) *SDKErrors_MATLABEvaluationFailed_Error {
+	return &SDKErrors_MATLABEvaluationFailed_Error{
+		Attr0: attr0,
+	}
+}
+
+// SDKErrors_MATLABFeatureDisabled_Error defines an error corresponding to the "SDKErrors_MATLABFeatureDisabled" message catalog message
+type SDKErrors_MATLABFeatureDisabled_Error struct {
+}
+
+// Error makes SDKErrors_MATLABFeatureDisabled_Error satisfy the error interface.
+func (e *SDKErrors_MATLABFeatureDisabled_Error) Error() string {
+	return "SDKErrors_MATLABFeatureDisabled_Error"
+}
+
+func (*SDKErrors_MATLABFeatureDisabled_Error) marker() {}
+
+// New_SDKErrors_MATLABFeatureDisabled_Error makes a new SDKErrors_MATLABFeatureDisabled_Error error.
+func New_SDKErrors_MATLABFeatureDisabled_Error() *SDKErrors_MATLABFeatureDisabled_Error {
+	return &SDKErrors_MATLABFeatureDisabled_Error{}
+}
+
+// SDKErrors_MATLABSessionUnavailable_Error defines an error corresponding to the "SDKErrors_MATLABSessionUnavailable" message catalog message
+type SDKErrors_MATLABSessionUnavailable_Error struct {
+	Attr0 string
+}
+
+// Error makes SDKErrors_MATLABSessionUnavailable_Error satisfy the error interface.
+func (e *SDKErrors_MATLABSessionUnavailable_Error) Error() string {
+	return "SDKErrors_MATLABSessionUnavailable_Error"
+}
+
+func (*SDKErrors_MATLABSessionUnavailable_Error) marker() {}
+
+// New_SDKErrors_MATLABSessionUnavailable_Error makes a new SDKErrors_MATLABSessionUnavailable_Error error.
+func New_SDKErrors_MATLABSessionUnavailable_Error(
+	attr0 string,
+) *SDKErrors_MATLABSessionUnavailable_Error {
+	return &SDKErrors_MATLABSessionUnavailable_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_ArgumentNotAllowedInSessionMode_Error defines an error corresponding to the "StartupErrors_ArgumentNotAllowedInSessionMode" message catalog message
 type StartupErrors_ArgumentNotAllowedInSessionMode_Error struct {
 	Attr0 string
@@ -52,6 +110,30 @@ func New_StartupErrors_ArgumentNotAllowedInSessionMode_Error(
 	}
 }
 
+// StartupErrors_ArgumentNotAllowedWithTransport_Error defines an error corresponding to the "StartupErrors_ArgumentNotAllowedWithTransport" message catalog message
+type StartupErrors_ArgumentNotAllowedWithTransport_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_ArgumentNotAllowedWithTransport_Error satisfy the error interface.
+func (e *StartupErrors_ArgumentNotAllowedWithTransport_Error) Error() string {
+	return "StartupErrors_ArgumentNotAllowedWithTransport_Error"
+}
+
+func (*StartupErrors_ArgumentNotAllowedWithTransport_Error) marker() {}
+
+// New_StartupErrors_ArgumentNotAllowedWithTransport_Error makes a new StartupErrors_ArgumentNotAllowedWithTransport_Error error.
+func New_StartupErrors_ArgumentNotAllowedWithTransport_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_ArgumentNotAllowedWithTransport_Error {
+	return &StartupErrors_ArgumentNotAllowedWithTransport_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_BadFlag_Error defines an error corresponding to the "StartupErrors_BadFlag" message catalog message
 type StartupErrors_BadFlag_Error struct {
 	Attr0 string
@@ -209,6 +291,7 @@ func New_StartupErrors_DuplicateParameter_Error(
 type StartupErrors_DuplicateToolName_Error struct {
 	Attr0 string
 	Attr1 string
+	Attr2 string
 }
 
 // Error makes StartupErrors_DuplicateToolName_Error satisfy the error interface.
@@ -222,10 +305,36 @@ func (*StartupErrors_DuplicateToolName_Error) marker() {}
 func New_StartupErrors_DuplicateToolName_Error(
 	attr0 string,
 	attr1 string,
+	attr2 string,
 ) *StartupErrors_DuplicateToolName_Error {
 	return &StartupErrors_DuplicateToolName_Error{
 		Attr0: attr0,
 		Attr1: attr1,
+		Attr2: attr2,
+	}
+}
+
+// StartupErrors_DuplicateToolNameInExtensionFile_Error defines an error corresponding to the "StartupErrors_DuplicateToolNameInExtensionFile" message catalog message
+type StartupErrors_DuplicateToolNameInExtensionFile_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_DuplicateToolNameInExtensionFile_Error satisfy the error interface.
+func (e *StartupErrors_DuplicateToolNameInExtensionFile_Error) Error() string {
+	return "StartupErrors_DuplicateToolNameInExtensionFile_Error"
+}
+
+func (*StartupErrors_DuplicateToolNameInExtensionFile_Error) marker() {}
+
+// New_StartupErrors_DuplicateToolNameInExtensionFile_Error makes a new StartupErrors_DuplicateToolNameInExtensionFile_Error error.
+func New_StartupErrors_DuplicateToolNameInExtensionFile_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_DuplicateToolNameInExtensionFile_Error {
+	return &StartupErrors_DuplicateToolNameInExtensionFile_Error{
+		Attr0: attr0,
+		Attr1: attr1,
 	}
 }
 
@@ -350,6 +459,27 @@ func New_StartupErrors_FailedToParseExtensionFile_Error(
 	}
 }
 
+// StartupErrors_FailedToReadExtensionDir_Error defines an error corresponding to the "StartupErrors_FailedToReadExtensionDir" message catalog message
+type StartupErrors_FailedToReadExtensionDir_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_FailedToReadExtensionDir_Error satisfy the error interface.
+func (e *StartupErrors_FailedToReadExtensionDir_Error) Error() string {
+	return "StartupErrors_FailedToReadExtensionDir_Error"
+}
+
+func (*StartupErrors_FailedToReadExtensionDir_Error) marker() {}
+
+// New_StartupErrors_FailedToReadExtensionDir_Error makes a new StartupErrors_FailedToReadExtensionDir_Error error.
+func New_StartupErrors_FailedToReadExtensionDir_Error(
+	attr0 string,
+) *StartupErrors_FailedToReadExtensionDir_Error {
+	return &StartupErrors_FailedToReadExtensionDir_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_FailedToReadExtensionFile_Error defines an error corresponding to the "StartupErrors_FailedToReadExtensionFile" message catalog message
 type StartupErrors_FailedToReadExtensionFile_Error struct {
 	Attr0 string
@@ -403,6 +533,30 @@ func New_StartupErrors_GenericInitializeFailure_Error() *StartupErrors_GenericIn
 	return &StartupErrors_GenericInitializeFailure_Error{}
 }
 
+// StartupErrors_IncompleteTLSConfiguration_Error defines an error corresponding to the "StartupErrors_IncompleteTLSConfiguration" message catalog message
+type StartupErrors_IncompleteTLSConfiguration_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_IncompleteTLSConfiguration_Error satisfy the error interface.
+func (e *StartupErrors_IncompleteTLSConfiguration_Error) Error() string {
+	return "StartupErrors_IncompleteTLSConfiguration_Error"
+}
+
+func (*StartupErrors_IncompleteTLSConfiguration_Error) marker() {}
+
+// New_StartupErrors_IncompleteTLSConfiguration_Error makes a new StartupErrors_IncompleteTLSConfiguration_Error error.
+func New_StartupErrors_IncompleteTLSConfiguration_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_IncompleteTLSConfiguration_Error {
+	return &StartupErrors_IncompleteTLSConfiguration_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_InvalidDisplayMode_Error defines an error corresponding to the "StartupErrors_InvalidDisplayMode" message catalog message
 type StartupErrors_InvalidDisplayMode_Error struct {
 	Attr0 string
@@ -424,6 +578,30 @@ func New_StartupErrors_InvalidDisplayMode_Error(
 	}
 }
 
+// StartupErrors_InvalidExtensionFilePrefix_Error defines an error corresponding to the "StartupErrors_InvalidExtensionFilePrefix" message catalog message
+type StartupErrors_InvalidExtensionFilePrefix_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_InvalidExtensionFilePrefix_Error satisfy the error interface.
+func (e *StartupErrors_InvalidExtensionFilePrefix_Error) Error() string {
+	return "StartupErrors_InvalidExtensionFilePrefix_Error"
+}
+
+func (*StartupErrors_InvalidExtensionFilePrefix_Error) marker() {}
+
+// New_StartupErrors_InvalidExtensionFilePrefix_Error makes a new StartupErrors_InvalidExtensionFilePrefix_Error error.
+func New_StartupErrors_InvalidExtensionFilePrefix_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_InvalidExtensionFilePrefix_Error {
+	return &StartupErrors_InvalidExtensionFilePrefix_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_InvalidLogLevel_Error defines an error corresponding to the "StartupErrors_InvalidLogLevel" message catalog message
 type StartupErrors_InvalidLogLevel_Error struct {
 	Attr0 string
@@ -556,6 +734,30 @@ func New_StartupErrors_InvalidToolInputSchema_Error(
 	}
 }
 
+// StartupErrors_InvalidToolOutput_Error defines an error corresponding to the "StartupErrors_InvalidToolOutput" message catalog message
+type StartupErrors_InvalidToolOutput_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_InvalidToolOutput_Error satisfy the error interface.
+func (e *StartupErrors_InvalidToolOutput_Error) Error() string {
+	return "StartupErrors_InvalidToolOutput_Error"
+}
+
+func (*StartupErrors_InvalidToolOutput_Error) marker() {}
+
+// New_StartupErrors_InvalidToolOutput_Error makes a new StartupErrors_InvalidToolOutput_Error error.
+func New_StartupErrors_InvalidToolOutput_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_InvalidToolOutput_Error {
+	return &StartupErrors_InvalidToolOutput_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_InvalidToolSignature_Error defines an error corresponding to the "StartupErrors_InvalidToolSignature" message catalog message
 type StartupErrors_InvalidToolSignature_Error struct {
 	Attr0 string
@@ -580,6 +782,27 @@ func New_StartupErrors_InvalidToolSignature_Error(
 	}
 }
 
+// StartupErrors_InvalidTransport_Error defines an error corresponding to the "StartupErrors_InvalidTransport" message catalog message
+type StartupErrors_InvalidTransport_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidTransport_Error satisfy the error interface.
+func (e *StartupErrors_InvalidTransport_Error) Error() string {
+	return "StartupErrors_InvalidTransport_Error"
+}
+
+func (*StartupErrors_InvalidTransport_Error) marker() {}
+
+// New_StartupErrors_InvalidTransport_Error makes a new StartupErrors_InvalidTransport_Error error.
+func New_StartupErrors_InvalidTransport_Error(
+	attr0 string,
+) *StartupErrors_InvalidTransport_Error {
+	return &StartupErrors_InvalidTransport_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_MissingToolSignature_Error defines an error corresponding to the "StartupErrors_MissingToolSignature" message catalog message
 type StartupErrors_MissingToolSignature_Error struct {
 	Attr0 string
@@ -649,6 +872,33 @@ func New_StartupErrors_ParseFailed_Error(
 	}
 }
 
+// StartupErrors_ReservedCustomToolArgument_Error defines an error corresponding to the "StartupErrors_ReservedCustomToolArgument" message catalog message
+type StartupErrors_ReservedCustomToolArgument_Error struct {
+	Attr0 string
+	Attr1 string
+	Attr2 string
+}
+
+// Error makes StartupErrors_ReservedCustomToolArgument_Error satisfy the error interface.
+func (e *StartupErrors_ReservedCustomToolArgument_Error) Error() string {
+	return "StartupErrors_ReservedCustomToolArgument_Error"
+}
+
+func (*StartupErrors_ReservedCustomToolArgument_Error) marker() {}
+
+// New_StartupErrors_ReservedCustomToolArgument_Error makes a new StartupErrors_ReservedCustomToolArgument_Error error.
+func New_StartupErrors_ReservedCustomToolArgument_Error(
+	attr0 string,
+	attr1 string,
+	attr2 string,
+) *StartupErrors_ReservedCustomToolArgument_Error {
+	return &StartupErrors_ReservedCustomToolArgument_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+		Attr2: attr2,
+	}
+}
+
 // StartupErrors_TelemetryInitializationFailed_Error defines an error corresponding to the "StartupErrors_TelemetryInitializationFailed" message catalog message
 type StartupErrors_TelemetryInitializationFailed_Error struct {
 }
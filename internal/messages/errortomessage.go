@@ -18,6 +18,21 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			msg,
 			e.Attr0,
 		)
+	case *SDKErrors_MATLABEvaluationFailed_Error:
+		msg := catalog.Get(SDKErrors_MATLABEvaluationFailed)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *SDKErrors_MATLABFeatureDisabled_Error:
+		msg := catalog.Get(SDKErrors_MATLABFeatureDisabled)
+		return msg
+	case *SDKErrors_MATLABSessionUnavailable_Error:
+		msg := catalog.Get(SDKErrors_MATLABSessionUnavailable)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_ArgumentNotAllowedInSessionMode_Error:
 		msg := catalog.Get(StartupErrors_ArgumentNotAllowedInSessionMode)
 		return fmt.Sprintf(
@@ -25,6 +40,13 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_ArgumentNotAllowedWithTransport_Error:
+		msg := catalog.Get(StartupErrors_ArgumentNotAllowedWithTransport)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_BadFlag_Error:
 		msg := catalog.Get(StartupErrors_BadFlag)
 		return fmt.Sprintf(
@@ -72,6 +94,14 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 		)
 	case *StartupErrors_DuplicateToolName_Error:
 		msg := catalog.Get(StartupErrors_DuplicateToolName)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+			e.Attr2,
+		)
+	case *StartupErrors_DuplicateToolNameInExtensionFile_Error:
+		msg := catalog.Get(StartupErrors_DuplicateToolNameInExtensionFile)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
@@ -110,6 +140,12 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_FailedToReadExtensionDir_Error:
+		msg := catalog.Get(StartupErrors_FailedToReadExtensionDir)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_FailedToReadExtensionFile_Error:
 		msg := catalog.Get(StartupErrors_FailedToReadExtensionFile)
 		return fmt.Sprintf(
@@ -122,12 +158,26 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 	case *StartupErrors_GenericInitializeFailure_Error:
 		msg := catalog.Get(StartupErrors_GenericInitializeFailure)
 		return msg
+	case *StartupErrors_IncompleteTLSConfiguration_Error:
+		msg := catalog.Get(StartupErrors_IncompleteTLSConfiguration)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_InvalidDisplayMode_Error:
 		msg := catalog.Get(StartupErrors_InvalidDisplayMode)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_InvalidExtensionFilePrefix_Error:
+		msg := catalog.Get(StartupErrors_InvalidExtensionFilePrefix)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_InvalidLogLevel_Error:
 		msg := catalog.Get(StartupErrors_InvalidLogLevel)
 		return fmt.Sprintf(
@@ -166,6 +216,13 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_InvalidToolOutput_Error:
+		msg := catalog.Get(StartupErrors_InvalidToolOutput)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_InvalidToolSignature_Error:
 		msg := catalog.Get(StartupErrors_InvalidToolSignature)
 		return fmt.Sprintf(
@@ -173,6 +230,12 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_InvalidTransport_Error:
+		msg := catalog.Get(StartupErrors_InvalidTransport)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_MissingToolSignature_Error:
 		msg := catalog.Get(StartupErrors_MissingToolSignature)
 		return fmt.Sprintf(
@@ -193,6 +256,14 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_ReservedCustomToolArgument_Error:
+		msg := catalog.Get(StartupErrors_ReservedCustomToolArgument)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+			e.Attr2,
+		)
 	case *StartupErrors_TelemetryInitializationFailed_Error:
 		msg := catalog.Get(StartupErrors_TelemetryInitializationFailed)
 		return msg
@@ -7,21 +7,39 @@ type messageKey string
 const (
 	AddonManagerErrors_InstallFailed                        messageKey = "AddonManagerErrors_InstallFailed"
 	CLIMessages_BaseDirDescription                          messageKey = "CLIMessages_BaseDirDescription"
+	CLIMessages_DefaultEvalTimeoutDescription               messageKey = "CLIMessages_DefaultEvalTimeoutDescription"
 	CLIMessages_DisableTelemetryDescription                 messageKey = "CLIMessages_DisableTelemetryDescription"
 	CLIMessages_DisplayModeDescription                      messageKey = "CLIMessages_DisplayModeDescription"
+	CLIMessages_EvictIdleMATLABSessionsDescription          messageKey = "CLIMessages_EvictIdleMATLABSessionsDescription"
+	CLIMessages_ExtensionDirDescription                     messageKey = "CLIMessages_ExtensionDirDescription"
 	CLIMessages_ExtensionFileDescription                    messageKey = "CLIMessages_ExtensionFileDescription"
+	CLIMessages_HTTPAuthTokenDescription                    messageKey = "CLIMessages_HTTPAuthTokenDescription"
+	CLIMessages_HTTPListenAddressDescription                messageKey = "CLIMessages_HTTPListenAddressDescription"
+	CLIMessages_HTTPTLSCertFileDescription                  messageKey = "CLIMessages_HTTPTLSCertFileDescription"
+	CLIMessages_HTTPTLSKeyFileDescription                   messageKey = "CLIMessages_HTTPTLSKeyFileDescription"
 	CLIMessages_HelpDescription                             messageKey = "CLIMessages_HelpDescription"
 	CLIMessages_InitializeMATLABOnStartupDescription        messageKey = "CLIMessages_InitializeMATLABOnStartupDescription"
 	CLIMessages_InternalUseDescription                      messageKey = "CLIMessages_InternalUseDescription"
 	CLIMessages_LogLevelDescription                         messageKey = "CLIMessages_LogLevelDescription"
+	CLIMessages_MATLABPoolSizeDescription                   messageKey = "CLIMessages_MATLABPoolSizeDescription"
+	CLIMessages_MATLABSessionIdleTimeoutDescription         messageKey = "CLIMessages_MATLABSessionIdleTimeoutDescription"
 	CLIMessages_MATLABSessionModeDescription                messageKey = "CLIMessages_MATLABSessionModeDescription"
+	CLIMessages_MATLABSessionSelectorDescription            messageKey = "CLIMessages_MATLABSessionSelectorDescription"
+	CLIMessages_MaxMATLABSessionsDescription                messageKey = "CLIMessages_MaxMATLABSessionsDescription"
 	CLIMessages_PreferredLocalMATLABRootDescription         messageKey = "CLIMessages_PreferredLocalMATLABRootDescription"
 	CLIMessages_PreferredMATLABStartingDirectoryDescription messageKey = "CLIMessages_PreferredMATLABStartingDirectoryDescription"
+	CLIMessages_RecoverSessionStateDescription              messageKey = "CLIMessages_RecoverSessionStateDescription"
+	CLIMessages_RecoverSessionWorkspaceDescription          messageKey = "CLIMessages_RecoverSessionWorkspaceDescription"
 	CLIMessages_SetupMATLABDescription                      messageKey = "CLIMessages_SetupMATLABDescription"
 	CLIMessages_SuccessfullySetupMATLAB                     messageKey = "CLIMessages_SuccessfullySetupMATLAB"
+	CLIMessages_TransportDescription                        messageKey = "CLIMessages_TransportDescription"
 	CLIMessages_UseSingleMATLABSessionDescription           messageKey = "CLIMessages_UseSingleMATLABSessionDescription"
 	CLIMessages_VersionDescription                          messageKey = "CLIMessages_VersionDescription"
+	SDKErrors_MATLABEvaluationFailed                        messageKey = "SDKErrors_MATLABEvaluationFailed"
+	SDKErrors_MATLABFeatureDisabled                         messageKey = "SDKErrors_MATLABFeatureDisabled"
+	SDKErrors_MATLABSessionUnavailable                      messageKey = "SDKErrors_MATLABSessionUnavailable"
 	StartupErrors_ArgumentNotAllowedInSessionMode           messageKey = "StartupErrors_ArgumentNotAllowedInSessionMode"
+	StartupErrors_ArgumentNotAllowedWithTransport           messageKey = "StartupErrors_ArgumentNotAllowedWithTransport"
 	StartupErrors_BadFlag                                   messageKey = "StartupErrors_BadFlag"
 	StartupErrors_BadSyntax                                 messageKey = "StartupErrors_BadSyntax"
 	StartupErrors_BadValue                                  messageKey = "StartupErrors_BadValue"
@@ -29,26 +47,33 @@ const (
 	StartupErrors_CustomToolNameConflict                    messageKey = "StartupErrors_CustomToolNameConflict"
 	StartupErrors_DuplicateParameter                        messageKey = "StartupErrors_DuplicateParameter"
 	StartupErrors_DuplicateToolName                         messageKey = "StartupErrors_DuplicateToolName"
+	StartupErrors_DuplicateToolNameInExtensionFile          messageKey = "StartupErrors_DuplicateToolNameInExtensionFile"
 	StartupErrors_FailedToCreateDirectory                   messageKey = "StartupErrors_FailedToCreateDirectory"
 	StartupErrors_FailedToCreateFile                        messageKey = "StartupErrors_FailedToCreateFile"
 	StartupErrors_FailedToCreateLogFile                     messageKey = "StartupErrors_FailedToCreateLogFile"
 	StartupErrors_FailedToCreateSubdirectory                messageKey = "StartupErrors_FailedToCreateSubdirectory"
 	StartupErrors_FailedToGetExecutablePath                 messageKey = "StartupErrors_FailedToGetExecutablePath"
 	StartupErrors_FailedToParseExtensionFile                messageKey = "StartupErrors_FailedToParseExtensionFile"
+	StartupErrors_FailedToReadExtensionDir                  messageKey = "StartupErrors_FailedToReadExtensionDir"
 	StartupErrors_FailedToReadExtensionFile                 messageKey = "StartupErrors_FailedToReadExtensionFile"
 	StartupErrors_FailedToStartWatchdogProcess              messageKey = "StartupErrors_FailedToStartWatchdogProcess"
 	StartupErrors_GenericInitializeFailure                  messageKey = "StartupErrors_GenericInitializeFailure"
+	StartupErrors_IncompleteTLSConfiguration                messageKey = "StartupErrors_IncompleteTLSConfiguration"
 	StartupErrors_InvalidDisplayMode                        messageKey = "StartupErrors_InvalidDisplayMode"
+	StartupErrors_InvalidExtensionFilePrefix                messageKey = "StartupErrors_InvalidExtensionFilePrefix"
 	StartupErrors_InvalidLogLevel                           messageKey = "StartupErrors_InvalidLogLevel"
 	StartupErrors_InvalidMATLABSessionMode                  messageKey = "StartupErrors_InvalidMATLABSessionMode"
 	StartupErrors_InvalidParameterKey                       messageKey = "StartupErrors_InvalidParameterKey"
 	StartupErrors_InvalidParameterType                      messageKey = "StartupErrors_InvalidParameterType"
 	StartupErrors_InvalidToolDefinition                     messageKey = "StartupErrors_InvalidToolDefinition"
 	StartupErrors_InvalidToolInputSchema                    messageKey = "StartupErrors_InvalidToolInputSchema"
+	StartupErrors_InvalidToolOutput                         messageKey = "StartupErrors_InvalidToolOutput"
 	StartupErrors_InvalidToolSignature                      messageKey = "StartupErrors_InvalidToolSignature"
+	StartupErrors_InvalidTransport                          messageKey = "StartupErrors_InvalidTransport"
 	StartupErrors_MissingToolSignature                      messageKey = "StartupErrors_MissingToolSignature"
 	StartupErrors_MissingValue                              messageKey = "StartupErrors_MissingValue"
 	StartupErrors_ParseFailed                               messageKey = "StartupErrors_ParseFailed"
+	StartupErrors_ReservedCustomToolArgument                messageKey = "StartupErrors_ReservedCustomToolArgument"
 	StartupErrors_TelemetryInitializationFailed             messageKey = "StartupErrors_TelemetryInitializationFailed"
 	StartupErrors_WriteError                                messageKey = "StartupErrors_WriteError"
 )
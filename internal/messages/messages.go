@@ -9,48 +9,73 @@ type localeMap map[localeKey]messageMap
 var messages_en_US = messageMap{
 	AddonManagerErrors_InstallFailed:                        `Failed to install MATLAB Add-On. For details, see the server log in "%[1]s".`,
 	CLIMessages_BaseDirDescription:                          `The folder where this MCP server stores log files. If not specified, the server uses the default temp folder of your operating system.`,
+	CLIMessages_DefaultEvalTimeoutDescription:               `Default time budget for MATLAB code run by the evaluate, run file and run test file tools, for example 30s or 5m. When the budget runs out, MATLAB execution is interrupted. Tools can override it with their timeout_seconds input. The default of 0 means no time budget.`,
 	CLIMessages_DisableTelemetryDescription:                 `This MCP server can collect fully anonymized information about your usage of the server and send it to MathWorks. This data collection helps MathWorks improve products and is on by default. To opt out of data collection, set the argument --disable-telemetry to true.`,
 	CLIMessages_DisplayModeDescription:                      `Specify whether to show the MATLAB desktop. Use 'desktop' mode (default) to show the MATLAB desktop or 'nodesktop' mode to use MATLAB only from your AI application, without the MATLAB desktop. `,
-	CLIMessages_ExtensionFileDescription:                    `Path to a JSON extension file that defines custom MCP tools. Each tool maps to a MATLAB function. If not specified, no custom tools are loaded.`,
+	CLIMessages_EvictIdleMATLABSessionsDescription:          `When --max-matlab-sessions is reached, starting a session stops the least recently used idle session instead of failing. It still fails if every session is busy.`,
+	CLIMessages_ExtensionDirDescription:                     `Folder of JSON extension files that define custom MCP tools. Every .json file in the folder is loaded. Repeat the argument to load several folders.`,
+	CLIMessages_ExtensionFileDescription:                    `Path to a JSON extension file that defines custom MCP tools. Each tool maps to a MATLAB function. Repeat the argument to load several extension files. If not specified, no custom tools are loaded.`,
+	CLIMessages_HTTPAuthTokenDescription:                    `Bearer token that MCP clients must present in the Authorization header when the transport is set to 'http'. If not specified, requests are not authenticated.`,
+	CLIMessages_HTTPListenAddressDescription:                `The address, in host:port form, on which the server listens when the transport is set to 'http'.`,
+	CLIMessages_HTTPTLSCertFileDescription:                  `Path to a PEM-encoded TLS certificate. If specified together with --http-tls-key-file, the server serves HTTPS when the transport is set to 'http'.`,
+	CLIMessages_HTTPTLSKeyFileDescription:                   `Path to the PEM-encoded private key matching --http-tls-cert-file.`,
 	CLIMessages_HelpDescription:                             `Show this help text`,
 	CLIMessages_InitializeMATLABOnStartupDescription:        `To initialize MATLAB as soon as you start the server, set this argument to true. By default, MATLAB only starts when the first tool is called. `,
 	CLIMessages_InternalUseDescription:                      `INTERNAL USE ONLY`,
 	CLIMessages_LogLevelDescription:                         `The log levels of this MCP server. Valid values, in order of decreasing verbosity, are 'debug', 'info', 'warn', and 'error'.`,
+	CLIMessages_MATLABPoolSizeDescription:                   `When --use-single-matlab-session is false, the number of MATLAB sessions to keep started in the background for each MATLAB root, so that start_matlab_session can hand one out immediately. Only requests without a starting folder, desktop, startup script, environment variables or MATLAB flags use the pool. The default of 0 disables the pool.`,
+	CLIMessages_MATLABSessionIdleTimeoutDescription:         `When --use-single-matlab-session is false, stops MATLAB sessions that have not run any code for this long, for example 30m or 2h. Tools called later with the ID of a stopped session return a session expired error. The default of 0 means sessions are never stopped for being idle.`,
 	CLIMessages_MATLABSessionModeDescription:                `Specify how MATLAB sessions are managed. Use 'new' (default) to launch new MATLAB sessions from a local installation, or 'existing' to connect to an already running MATLAB instance.`,
+	CLIMessages_MATLABSessionSelectorDescription:            `When --matlab-session-mode is existing and several MATLAB sessions are shared, chooses the session to attach to: the process ID of the MATLAB session, the name given to shareMATLABSession, or latest for the most recently shared session. The default is latest.`,
+	CLIMessages_MaxMATLABSessionsDescription:                `When --use-single-matlab-session is false, the maximum number of MATLAB sessions that can run at the same time. When the limit is reached, starting a session fails, unless --evict-idle-matlab-sessions is true. Pooled sessions are always stopped to make room. The default of 0 means no limit.`,
 	CLIMessages_PreferredLocalMATLABRootDescription:         `Full path specifying which MATLAB to start. Do not include /bin in the path. By default, the server tries to find the first MATLAB on the system PATH.`,
 	CLIMessages_PreferredMATLABStartingDirectoryDescription: `Specify the folder where MATLAB starts. If you do not provide the argument, MATLAB starts in these locations: Linux: /home/username, Windows: C:\Users\username\Documents, Mac: /Users/username/Documents.`,
+	CLIMessages_RecoverSessionStateDescription:              `When --use-single-matlab-session is true, periodically saves the current folder and the folders added to the MATLAB path, and restores them when the server restarts MATLAB after it stops responding. The next tool result reports the restart. The state is saved in the server's application directory rather than in the MATLAB session directory, which is deleted when MATLAB stops.`,
+	CLIMessages_RecoverSessionWorkspaceDescription:          `When --recover-session-state is true, also saves the variables in the MATLAB workspace, and loads them again after MATLAB restarts. Saving large workspaces can take a long time.`,
 	CLIMessages_SetupMATLABDescription:                      `Set up a MATLAB installation for use with the MATLAB MCP Core Server.`,
 	CLIMessages_SuccessfullySetupMATLAB:                     `Successfully setup MATLAB.`,
+	CLIMessages_TransportDescription:                        `Specify how MCP clients connect to this server. Use 'stdio' (default) to communicate over standard input and output, or 'http' to serve the Streamable HTTP transport.`,
 	CLIMessages_UseSingleMATLABSessionDescription:           `By default, this MCP server starts a single MATLAB session, and stops the session when the server shuts down. To allow the server to manage multiple MATLAB sessions, set this argument to false. `,
 	CLIMessages_VersionDescription:                          `Display the version of this MCP server.`,
+	SDKErrors_MATLABEvaluationFailed:                        `Failed to evaluate code in MATLAB. Error: %[1]s`,
+	SDKErrors_MATLABFeatureDisabled:                         `MATLAB is not available to this server. Enable the MATLAB feature in the server definition to use MATLAB from tools.`,
+	SDKErrors_MATLABSessionUnavailable:                      `Failed to connect to MATLAB. Error: %[1]s`,
 	StartupErrors_ArgumentNotAllowedInSessionMode:           `Error with supplied arguments: option "%[1]s" is not compatible with MATLAB session mode set to "%[2]s".`,
+	StartupErrors_ArgumentNotAllowedWithTransport:           `Error with supplied arguments: option "%[1]s" is not compatible with transport set to "%[2]s".`,
 	StartupErrors_BadFlag:                                   `Error with supplied arguments: non-existent option %[1]s.%[2]s%[3]s`,
 	StartupErrors_BadSyntax:                                 `Error with supplied arguments: invalid syntax %[1]s.%[2]s%[3]s`,
 	StartupErrors_BadValue:                                  `Error with supplied arguments: invalid value %[1]s for option %[2]s.`,
 	StartupErrors_BadValueForEnvVar:                         `Error with supplied environment variable: invalid value %[1]s for environment variable %[2]s.`,
 	StartupErrors_CustomToolNameConflict:                    `Custom tool name "%[1]s" in extension file "%[2]s" conflicts with a built-in tool. Choose a different name.`,
 	StartupErrors_DuplicateParameter:                        `Found duplicate parameter "%[1]s": %[2]s with value "%[3]s" is already defined.`,
-	StartupErrors_DuplicateToolName:                         `Duplicate tool name "%[1]s" in "%[2]s". Choose a different name.`,
+	StartupErrors_DuplicateToolName:                         `Duplicate tool name "%[1]s" in "%[2]s", already defined in "%[3]s". Choose a different name, or a different prefix for one of the extension files.`,
+	StartupErrors_DuplicateToolNameInExtensionFile:          `Duplicate tool name "%[1]s" in "%[2]s". Each tool in an extension file must have a different name.`,
 	StartupErrors_FailedToCreateDirectory:                   `Failed to create directory "%[1]s".`,
 	StartupErrors_FailedToCreateFile:                        `Failed to create file "%[1]s".`,
 	StartupErrors_FailedToCreateLogFile:                     `Failed to create the log file "%[1]s".`,
 	StartupErrors_FailedToCreateSubdirectory:                `Failed to create subdirectory in "%[1]s".`,
 	StartupErrors_FailedToGetExecutablePath:                 `Failed to get executable path.`,
 	StartupErrors_FailedToParseExtensionFile:                `Failed to parse extension file "%[1]s". File must contain valid JSON.`,
+	StartupErrors_FailedToReadExtensionDir:                  `Failed to read extension folder "%[1]s". Check that the folder exists.`,
 	StartupErrors_FailedToReadExtensionFile:                 `Failed to read extension file "%[1]s". Check that file is valid.`,
 	StartupErrors_FailedToStartWatchdogProcess:              `Failed to start watchdog process.`,
 	StartupErrors_GenericInitializeFailure:                  `Failed to initialize MCP Core Server. For details, see the MCP server log in your AI application.`,
+	StartupErrors_IncompleteTLSConfiguration:                `Error with supplied arguments: options "%[1]s" and "%[2]s" must be specified together.`,
 	StartupErrors_InvalidDisplayMode:                        `Error with supplied arguments: invalid display mode %[1]s.`,
+	StartupErrors_InvalidExtensionFilePrefix:                `Invalid prefix "%[1]s" in "%[2]s". The prefix must start with a letter, and contain only letters, digits, and underscores.`,
 	StartupErrors_InvalidLogLevel:                           `Error with supplied arguments: invalid log level %[1]s.`,
 	StartupErrors_InvalidMATLABSessionMode:                  `Error with supplied arguments: invalid MATLAB session mode %[1]s.`,
 	StartupErrors_InvalidParameterKey:                       `Invalid key "%[1]s" in configuration.`,
 	StartupErrors_InvalidParameterType:                      `Invalid type for key "%[1]s" in configuration, expected "%[2]s".`,
 	StartupErrors_InvalidToolDefinition:                     `Invalid custom tool definition in "%[1]s". Tool must match the tool schema specified by MCP.`,
 	StartupErrors_InvalidToolInputSchema:                    `Invalid input schema for tool "%[1]s" in "%[2]s".`,
+	StartupErrors_InvalidToolOutput:                         `Invalid output for tool "%[1]s" in "%[2]s".`,
 	StartupErrors_InvalidToolSignature:                      `Invalid signature for tool "%[1]s" in "%[2]s".`,
+	StartupErrors_InvalidTransport:                          `Error with supplied arguments: invalid transport %[1]s.`,
 	StartupErrors_MissingToolSignature:                      `Missing signature for tool "%[1]s" in "%[2]s".`,
 	StartupErrors_MissingValue:                              `Error with supplied arguments: value required for option %[1]s.`,
 	StartupErrors_ParseFailed:                               `Error with supplied arguments: parse failed.%[1]s%[2]s`,
+	StartupErrors_ReservedCustomToolArgument:                `Custom tool "%[1]s" in extension file "%[2]s" declares the argument "%[3]s", which is reserved for selecting the MATLAB session. Choose a different name.`,
 	StartupErrors_TelemetryInitializationFailed:             `Failed to initialize telemetry.`,
 	StartupErrors_WriteError:                                `Failed to display %[1]s information. Error: %[2]s`,
 }
@@ -0,0 +1,71 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package attachsharedmatlabsession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+var ErrSharedMATLABSessionNotFound = errors.New("no shared MATLAB session found with process ID")
+
+type SharedSessionLister interface {
+	ListSharedMATLABSessions(ctx context.Context, sessionLogger entities.Logger) []entities.SharedMATLABSession
+}
+
+type GlobalMATLAB interface {
+	AttachToSharedSession(ctx context.Context, logger entities.Logger, processID int) error
+}
+
+type Args struct {
+	ProcessID int
+}
+
+type ReturnArgs entities.SharedMATLABSession
+
+type Usecase struct {
+	sharedSessionLister SharedSessionLister
+	globalMATLAB        GlobalMATLAB
+}
+
+func New(
+	sharedSessionLister SharedSessionLister,
+	globalMATLAB GlobalMATLAB,
+) *Usecase {
+	return &Usecase{
+		sharedSessionLister: sharedSessionLister,
+		globalMATLAB:        globalMATLAB,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger = sessionLogger.With("pid", request.ProcessID)
+	sessionLogger.Debug("Entering AttachSharedMATLABSession Usecase")
+	defer sessionLogger.Debug("Exiting AttachSharedMATLABSession Usecase")
+
+	var zeroValue ReturnArgs
+
+	session, found := u.findSharedSession(ctx, sessionLogger, request.ProcessID)
+	if !found {
+		return zeroValue, fmt.Errorf("%w %d", ErrSharedMATLABSessionNotFound, request.ProcessID)
+	}
+
+	if err := u.globalMATLAB.AttachToSharedSession(ctx, sessionLogger, request.ProcessID); err != nil {
+		return zeroValue, err
+	}
+
+	return ReturnArgs(session), nil
+}
+
+func (u *Usecase) findSharedSession(ctx context.Context, sessionLogger entities.Logger, processID int) (entities.SharedMATLABSession, bool) {
+	for _, session := range u.sharedSessionLister.ListSharedMATLABSessions(ctx, sessionLogger) {
+		if session.ProcessID == processID {
+			return session, true
+		}
+	}
+
+	return entities.SharedMATLABSession{}, false
+}
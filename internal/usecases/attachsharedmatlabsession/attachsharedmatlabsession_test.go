@@ -0,0 +1,128 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package attachsharedmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachsharedmatlabsession"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/attachsharedmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	usecase := attachsharedmatlabsession.New(mockSharedSessionLister, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 5678
+	expectedSession := entities.SharedMATLABSession{ProcessID: processID, Name: "simulation", Release: "R2026a"}
+
+	mockSharedSessionLister.EXPECT().
+		ListSharedMATLABSessions(ctx, mock.Anything).
+		Return([]entities.SharedMATLABSession{
+			{ProcessID: 1234, Name: "analysis"},
+			expectedSession,
+		}).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		AttachToSharedSession(ctx, mock.Anything, processID).
+		Return(nil).
+		Once()
+
+	usecase := attachsharedmatlabsession.New(mockSharedSessionLister, mockGlobalMATLAB)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, attachsharedmatlabsession.Args{ProcessID: processID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, attachsharedmatlabsession.ReturnArgs(expectedSession), result)
+}
+
+func TestUsecase_Execute_SessionNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSharedSessionLister.EXPECT().
+		ListSharedMATLABSessions(ctx, mock.Anything).
+		Return([]entities.SharedMATLABSession{{ProcessID: 1234}}).
+		Once()
+
+	usecase := attachsharedmatlabsession.New(mockSharedSessionLister, mockGlobalMATLAB)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, attachsharedmatlabsession.Args{ProcessID: 5678})
+
+	// Assert
+	require.ErrorIs(t, err, attachsharedmatlabsession.ErrSharedMATLABSessionNotFound)
+	assert.ErrorContains(t, err, "5678")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_AttachError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+	const processID = 1234
+
+	mockSharedSessionLister.EXPECT().
+		ListSharedMATLABSessions(ctx, mock.Anything).
+		Return([]entities.SharedMATLABSession{{ProcessID: processID}}).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		AttachToSharedSession(ctx, mock.Anything, processID).
+		Return(assert.AnError).
+		Once()
+
+	usecase := attachsharedmatlabsession.New(mockSharedSessionLister, mockGlobalMATLAB)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, attachsharedmatlabsession.Args{ProcessID: processID})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
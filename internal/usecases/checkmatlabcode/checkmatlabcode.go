@@ -11,6 +11,8 @@ import (
 
 // CodeIssue represents a single code issue found by the code analysis
 type CodeIssue struct {
+	File        string
+	CheckID     string
 	Description string
 	Line        int
 	StartColumn int
@@ -19,20 +21,48 @@ type CodeIssue struct {
 	Fixable     bool
 }
 
+// FileCodeIssues groups the code issues found in a single file
+type FileCodeIssues struct {
+	File           string
+	CodeIssues     []CodeIssue
+	SeverityCounts map[string]int
+}
+
 type Args struct {
 	ScriptPath string
+	// Paths lists additional MATLAB files and folders to analyze. Folders are analyzed recursively.
+	Paths []string
+	// ConfigurationFile is an optional Code Analyzer configuration file. When empty, MATLAB uses the active configuration.
+	ConfigurationFile string
 }
 
 type ReturnArgs struct {
-	CodeIssues []CodeIssue
+	Files             []FileCodeIssues
+	SeverityCounts    map[string]int
+	AnalyzedFileCount int
+	Configuration     string
+}
+
+// CodeAnalysisRequest describes a single Code Analyzer run over a set of files and folders
+type CodeAnalysisRequest struct {
+	Paths             []string
+	ConfigurationFile string
+}
+
+// CodeAnalysis is the outcome of a single Code Analyzer run
+type CodeAnalysis struct {
+	CodeIssues        []CodeIssue
+	AnalyzedFileCount int
+	Configuration     string
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABCodePath(filePath string) (string, error)
+	ValidateFilePath(filePath string) (string, error)
 }
 
 type CodeAnalyzer interface {
-	AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string) ([]CodeIssue, error)
+	AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request CodeAnalysisRequest) (CodeAnalysis, error)
 }
 
 type Usecase struct {
@@ -54,15 +84,78 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering CheckMATLABCode Usecase")
 	defer sessionLogger.Debug("Exiting CheckMATLABCode Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	analysisRequest, err := u.buildCodeAnalysisRequest(request)
 	if err != nil {
-		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+		return ReturnArgs{}, err
 	}
 
-	issues, err := u.codeAnalyzer.AnalyzeCode(ctx, sessionLogger, client, validatedPath)
+	analysis, err := u.codeAnalyzer.AnalyzeCode(ctx, sessionLogger, client, analysisRequest)
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
-	return ReturnArgs{CodeIssues: issues}, nil
+	return groupByFile(analysis), nil
+}
+
+func (u *Usecase) buildCodeAnalysisRequest(request Args) (CodeAnalysisRequest, error) {
+	paths := request.Paths
+	if request.ScriptPath != "" {
+		paths = append([]string{request.ScriptPath}, paths...)
+	}
+
+	if len(paths) == 0 {
+		return CodeAnalysisRequest{}, fmt.Errorf("at least one MATLAB file or folder must be specified")
+	}
+
+	analysisRequest := CodeAnalysisRequest{
+		Paths: make([]string, 0, len(paths)),
+	}
+
+	for _, path := range paths {
+		validatedPath, err := u.pathValidator.ValidateMATLABCodePath(path)
+		if err != nil {
+			return CodeAnalysisRequest{}, fmt.Errorf("path validation failed: %w", err)
+		}
+		analysisRequest.Paths = append(analysisRequest.Paths, validatedPath)
+	}
+
+	if request.ConfigurationFile != "" {
+		validatedConfigurationFile, err := u.pathValidator.ValidateFilePath(request.ConfigurationFile)
+		if err != nil {
+			return CodeAnalysisRequest{}, fmt.Errorf("invalid configuration file: %w", err)
+		}
+		analysisRequest.ConfigurationFile = validatedConfigurationFile
+	}
+
+	return analysisRequest, nil
+}
+
+// groupByFile groups the issues by the file they were found in, keeping the order in which files first appear.
+func groupByFile(analysis CodeAnalysis) ReturnArgs {
+	result := ReturnArgs{
+		Files:             []FileCodeIssues{},
+		SeverityCounts:    map[string]int{},
+		AnalyzedFileCount: analysis.AnalyzedFileCount,
+		Configuration:     analysis.Configuration,
+	}
+
+	fileIndex := map[string]int{}
+	for _, issue := range analysis.CodeIssues {
+		index, found := fileIndex[issue.File]
+		if !found {
+			index = len(result.Files)
+			fileIndex[issue.File] = index
+			result.Files = append(result.Files, FileCodeIssues{
+				File:           issue.File,
+				CodeIssues:     []CodeIssue{},
+				SeverityCounts: map[string]int{},
+			})
+		}
+
+		result.Files[index].CodeIssues = append(result.Files[index].CodeIssues, issue)
+		result.Files[index].SeverityCounts[issue.Severity]++
+		result.SeverityCounts[issue.Severity]++
+	}
+
+	return result
 }
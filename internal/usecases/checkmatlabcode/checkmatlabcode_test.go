@@ -50,6 +50,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	}
 	codeIssues := []checkmatlabcode.CodeIssue{
 		{
+			File:        expectedValidatedPath,
 			Description: "Variable 'x' might be unused.",
 			Line:        5,
 			StartColumn: 1,
@@ -58,16 +59,27 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 			Fixable:     true,
 		},
 	}
-	expectedCodeIssues := codeIssues
+	expectedResponse := checkmatlabcode.ReturnArgs{
+		Files: []checkmatlabcode.FileCodeIssues{
+			{
+				File:           expectedValidatedPath,
+				CodeIssues:     codeIssues,
+				SeverityCounts: map[string]int{"warning": 1},
+			},
+		},
+		SeverityCounts:    map[string]int{"warning": 1},
+		AnalyzedFileCount: 1,
+		Configuration:     "active",
+	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(expectedScriptPath).
+		ValidateMATLABCodePath(expectedScriptPath).
 		Return(expectedValidatedPath, nil).
 		Once()
 
 	mockCodeAnalyzer.EXPECT().
-		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, expectedValidatedPath).
-		Return(codeIssues, nil).
+		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{expectedValidatedPath}}).
+		Return(checkmatlabcode.CodeAnalysis{CodeIssues: codeIssues, AnalyzedFileCount: 1, Configuration: "active"}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
@@ -77,7 +89,113 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, expectedCodeIssues, response.CodeIssues, "CodeIssues should match expected value")
+	assert.Equal(t, expectedResponse, response, "Response should match expected value")
+}
+
+func TestUsecase_Execute_MultiplePathsGroupedByFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeAnalyzer := &checkmatlabcodemocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("path", "to", "main.m")
+	folderPath := filepath.Join("path", "to", "src")
+	configurationFile := filepath.Join("path", "to", "resources", "codeAnalyzerConfiguration.json")
+	validatedScriptPath := filepath.Join("validated", "path", "to", "main.m")
+	validatedFolderPath := filepath.Join("validated", "path", "to", "src")
+	validatedConfigurationFile := filepath.Join("validated", "path", "to", "resources", "codeAnalyzerConfiguration.json")
+	helperPath := filepath.Join(validatedFolderPath, "helper.m")
+
+	mainIssue := checkmatlabcode.CodeIssue{File: validatedScriptPath, Description: "Terminate statement with semicolon.", Line: 1, Severity: "warning"}
+	helperError := checkmatlabcode.CodeIssue{File: helperPath, Description: "Parse error.", Line: 3, Severity: "error"}
+	helperWarning := checkmatlabcode.CodeIssue{File: helperPath, Description: "Variable 'y' might be unused.", Line: 7, Severity: "warning"}
+
+	expectedResponse := checkmatlabcode.ReturnArgs{
+		Files: []checkmatlabcode.FileCodeIssues{
+			{
+				File:           helperPath,
+				CodeIssues:     []checkmatlabcode.CodeIssue{helperError, helperWarning},
+				SeverityCounts: map[string]int{"error": 1, "warning": 1},
+			},
+			{
+				File:           validatedScriptPath,
+				CodeIssues:     []checkmatlabcode.CodeIssue{mainIssue},
+				SeverityCounts: map[string]int{"warning": 1},
+			},
+		},
+		SeverityCounts:    map[string]int{"error": 1, "warning": 2},
+		AnalyzedFileCount: 4,
+		Configuration:     validatedConfigurationFile,
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodePath(scriptPath).
+		Return(validatedScriptPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodePath(folderPath).
+		Return(validatedFolderPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(configurationFile).
+		Return(validatedConfigurationFile, nil).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, checkmatlabcode.CodeAnalysisRequest{
+			Paths:             []string{validatedScriptPath, validatedFolderPath},
+			ConfigurationFile: validatedConfigurationFile,
+		}).
+		Return(checkmatlabcode.CodeAnalysis{
+			CodeIssues:        []checkmatlabcode.CodeIssue{helperError, mainIssue, helperWarning},
+			AnalyzedFileCount: 4,
+			Configuration:     validatedConfigurationFile,
+		}, nil).
+		Once()
+
+	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, checkmatlabcode.Args{
+		ScriptPath:        scriptPath,
+		Paths:             []string{folderPath},
+		ConfigurationFile: configurationFile,
+	})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, expectedResponse, response, "Issues should be grouped by file in order of first appearance")
+}
+
+func TestUsecase_Execute_NoPaths(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeAnalyzer := &checkmatlabcodemocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, checkmatlabcode.Args{})
+
+	// Assert
+	require.Error(t, err, "Execute should return an error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
 func TestUsecase_Execute_PathValidationError(t *testing.T) {
@@ -100,7 +218,7 @@ func TestUsecase_Execute_PathValidationError(t *testing.T) {
 	pathValidationErr := fmt.Errorf("invalid script path")
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(expectedScriptPath).
+		ValidateMATLABCodePath(expectedScriptPath).
 		Return("", pathValidationErr).
 		Once()
 
@@ -110,7 +228,46 @@ func TestUsecase_Execute_PathValidationError(t *testing.T) {
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, checkcodeRequest)
 
 	// Assert
-	require.Error(t, err, "Execute should return an error")
+	require.ErrorIs(t, err, pathValidationErr, "Execute should return the path validation error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
+}
+
+func TestUsecase_Execute_ConfigurationFileValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeAnalyzer := &checkmatlabcodemocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	folderPath := filepath.Join("path", "to", "src")
+	configurationFile := filepath.Join("path", "to", "missing.json")
+	validationErr := fmt.Errorf("resource not found")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodePath(folderPath).
+		Return(folderPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(configurationFile).
+		Return("", validationErr).
+		Once()
+
+	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, checkmatlabcode.Args{
+		Paths:             []string{folderPath},
+		ConfigurationFile: configurationFile,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, validationErr, "Execute should return the validation error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
@@ -135,13 +292,13 @@ func TestUsecase_Execute_AnalyzeCodeError(t *testing.T) {
 	analyzeCodeErr := fmt.Errorf("code analysis failed")
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(expectedScriptPath).
+		ValidateMATLABCodePath(expectedScriptPath).
 		Return(expectedValidatedPath, nil).
 		Once()
 
 	mockCodeAnalyzer.EXPECT().
-		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, expectedValidatedPath).
-		Return(nil, analyzeCodeErr).
+		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{expectedValidatedPath}}).
+		Return(checkmatlabcode.CodeAnalysis{}, analyzeCodeErr).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
@@ -172,16 +329,15 @@ func TestUsecase_Execute_EmptyIssues(t *testing.T) {
 	checkcodeRequest := checkmatlabcode.Args{
 		ScriptPath: expectedScriptPath,
 	}
-	codeIssues := []checkmatlabcode.CodeIssue{}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(expectedScriptPath).
+		ValidateMATLABCodePath(expectedScriptPath).
 		Return(expectedValidatedPath, nil).
 		Once()
 
 	mockCodeAnalyzer.EXPECT().
-		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, expectedValidatedPath).
-		Return(codeIssues, nil).
+		AnalyzeCode(ctx, mockLogger.AsMockArg(), mockClient, checkmatlabcode.CodeAnalysisRequest{Paths: []string{expectedValidatedPath}}).
+		Return(checkmatlabcode.CodeAnalysis{CodeIssues: []checkmatlabcode.CodeIssue{}, AnalyzedFileCount: 1}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator, mockCodeAnalyzer)
@@ -191,5 +347,8 @@ func TestUsecase_Execute_EmptyIssues(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Empty(t, response.CodeIssues, "CodeIssues should be empty")
+	assert.NotNil(t, response.Files, "Files should not be nil")
+	assert.Empty(t, response.Files, "Files should be empty")
+	assert.Empty(t, response.SeverityCounts, "SeverityCounts should be empty")
+	assert.Equal(t, 1, response.AnalyzedFileCount, "AnalyzedFileCount should match")
 }
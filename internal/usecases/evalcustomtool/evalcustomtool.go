@@ -4,9 +4,14 @@ package evalcustomtool
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalcustomtool/functioncall"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
 )
 
 type FunctionCallAssembler interface {
@@ -16,11 +21,40 @@ type FunctionCallAssembler interface {
 type Args struct {
 	Function      string
 	Order         []string
+	NameValue     []string
 	ArgumentTypes map[string]string
 	Arguments     map[string]any
 	CaptureOutput bool
 }
 
+type StructuredResponse struct {
+	Response entities.EvalResponse
+	Output   map[string]any
+}
+
+// structuredOutputMarker delimits the JSON-encoded outputs of a custom tool in the console output.
+const structuredOutputMarker = "<<MCP_STRUCTURED_OUTPUT>>"
+
+// structuredOutputVariable holds the outputs of a custom tool while they are encoded.
+const structuredOutputVariable = "mcpCustomToolOutputs"
+
+var ErrNoStructuredOutput = errors.New("no structured output found in MATLAB response")
+
+// StructuredOutputError is returned when the outputs of a custom tool cannot be read from the console output.
+// Response holds the response of MATLAB as is, so that its console output can still be reported.
+type StructuredOutputError struct {
+	Err      error
+	Response entities.EvalResponse
+}
+
+func (e *StructuredOutputError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StructuredOutputError) Unwrap() error {
+	return e.Err
+}
+
 type Usecase struct {
 	functionCallAssembler FunctionCallAssembler
 }
@@ -35,22 +69,106 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering EvalCustomTool Usecase")
 	defer sessionLogger.Debug("Exiting EvalCustomTool Usecase")
 
-	code, err := u.functionCallAssembler.Assemble(functioncall.Args{
+	code, err := u.assemble(request)
+	if err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	return evaluate(ctx, sessionLogger, client, code, request.CaptureOutput)
+}
+
+// ExecuteWithStructuredOutput calls the MATLAB function with one output argument per output name,
+// and returns the outputs decoded from JSON, keyed by output name.
+// The console output of the call is returned without the encoded outputs.
+// When the outputs cannot be read, a *StructuredOutputError holding the response of MATLAB is returned.
+func (u *Usecase) ExecuteWithStructuredOutput(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args, outputNames []string) (StructuredResponse, error) {
+	sessionLogger.Debug("Entering EvalCustomTool Usecase with structured output")
+	defer sessionLogger.Debug("Exiting EvalCustomTool Usecase with structured output")
+
+	call, err := u.assemble(request)
+	if err != nil {
+		return StructuredResponse{}, err
+	}
+
+	response, err := evaluate(ctx, sessionLogger, client, structuredOutputCode(call, outputNames), request.CaptureOutput)
+	if err != nil {
+		return StructuredResponse{}, err
+	}
+
+	consoleOutput, output, err := extractStructuredOutput(response.ConsoleOutput)
+	if err != nil {
+		return StructuredResponse{}, &StructuredOutputError{
+			Err:      err,
+			Response: response,
+		}
+	}
+	response.ConsoleOutput = consoleOutput
+
+	return StructuredResponse{
+		Response: response,
+		Output:   output,
+	}, nil
+}
+
+func (u *Usecase) assemble(request Args) (string, error) {
+	return u.functionCallAssembler.Assemble(functioncall.Args{
 		Function:      request.Function,
 		Order:         request.Order,
+		NameValue:     request.NameValue,
 		ArgumentTypes: request.ArgumentTypes,
 		Arguments:     request.Arguments,
 	})
-	if err != nil {
-		return entities.EvalResponse{}, err
-	}
+}
 
+func evaluate(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, code string, captureOutput bool) (entities.EvalResponse, error) {
 	evalRequest := entities.EvalRequest{
 		Code: code,
 	}
 
-	if request.CaptureOutput {
+	if captureOutput {
 		return client.EvalWithCapture(ctx, sessionLogger, evalRequest)
 	}
 	return client.Eval(ctx, sessionLogger, evalRequest)
 }
+
+// structuredOutputCode calls the function with one output argument per output name,
+// and prints the outputs as a JSON object between structuredOutputMarker delimiters.
+// The code is self-contained, so that it also runs in MATLAB sessions the server did not start.
+// MATLAB can only collect several outputs in an assignment, so the outputs are held in structuredOutputVariable,
+// which is cleared before and after the call.
+func structuredOutputCode(call string, outputNames []string) string {
+	quotedOutputNames := make([]string, len(outputNames))
+	for i, name := range outputNames {
+		quotedOutputNames[i] = "'" + matlabstring.EscapeSingleQuotes(name) + "'"
+	}
+
+	return fmt.Sprintf(
+		"clear %[1]s; [%[1]s{1:%[2]d}] = %[3]s; disp(['%[4]s' jsonencode(cell2struct(%[1]s, {%[5]s}, 2)) '%[4]s']); clear %[1]s;",
+		structuredOutputVariable,
+		len(outputNames),
+		call,
+		structuredOutputMarker,
+		strings.Join(quotedOutputNames, ", "),
+	)
+}
+
+// extractStructuredOutput returns the console output without the last delimited JSON object,
+// together with the decoded object.
+func extractStructuredOutput(consoleOutput string) (string, map[string]any, error) {
+	end := strings.LastIndex(consoleOutput, structuredOutputMarker)
+	if end < 0 {
+		return "", nil, ErrNoStructuredOutput
+	}
+	start := strings.LastIndex(consoleOutput[:end], structuredOutputMarker)
+	if start < 0 {
+		return "", nil, ErrNoStructuredOutput
+	}
+
+	var output map[string]any
+	if err := json.Unmarshal([]byte(consoleOutput[start+len(structuredOutputMarker):end]), &output); err != nil {
+		return "", nil, fmt.Errorf("failed to decode structured output: %w", err)
+	}
+
+	remaining := consoleOutput[:start] + strings.TrimPrefix(consoleOutput[end+len(structuredOutputMarker):], "\n")
+	return remaining, output, nil
+}
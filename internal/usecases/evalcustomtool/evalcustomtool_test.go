@@ -12,6 +12,7 @@ import (
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	evalcustomtoolmocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/evalcustomtool"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,7 +41,8 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
-		ArgumentTypes: map[string]string{"n": "number"},
+		NameValue:     []string{"Verbose"},
+		ArgumentTypes: map[string]string{"n": "number", "Verbose": "boolean"},
 		Arguments:     map[string]any{"n": float64(5)},
 	}
 	code := "magic(5)"
@@ -66,7 +68,8 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
-		ArgumentTypes: map[string]string{"n": "number"},
+		NameValue:     []string{"Verbose"},
+		ArgumentTypes: map[string]string{"n": "number", "Verbose": "boolean"},
 		Arguments:     map[string]any{"n": float64(5)},
 	})
 
@@ -256,3 +259,201 @@ func TestUsecase_Execute_AssembleError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response)
 }
+
+func TestUsecase_ExecuteWithStructuredOutput_HappyPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		captureOutput bool
+	}{
+		{"capture output", true},
+		{"desktop", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
+			defer mockFunctionCallAssembler.AssertExpectations(t)
+
+			expectedFunctionCallArgs := functioncall.Args{
+				Function:      "stats",
+				Order:         []string{"x"},
+				ArgumentTypes: map[string]string{"x": "array"},
+				Arguments:     map[string]any{"x": []any{float64(1), float64(3)}},
+			}
+			call := "stats([1, 3])"
+			expectedCode := "clear mcpCustomToolOutputs; [mcpCustomToolOutputs{1:2}] = stats([1, 3]); " +
+				"disp(['<<MCP_STRUCTURED_OUTPUT>>' jsonencode(cell2struct(mcpCustomToolOutputs, {'mean', 'std'}, 2)) '<<MCP_STRUCTURED_OUTPUT>>']); " +
+				"clear mcpCustomToolOutputs;"
+			evalResponse := entities.EvalResponse{
+				ConsoleOutput: "Computing stats\n<<MCP_STRUCTURED_OUTPUT>>{\"mean\":2,\"std\":1.4142}<<MCP_STRUCTURED_OUTPUT>>\n",
+				Images:        [][]byte{[]byte("image")},
+			}
+			expectedResponse := evalcustomtool.StructuredResponse{
+				Response: entities.EvalResponse{
+					ConsoleOutput: "Computing stats\n",
+					Images:        [][]byte{[]byte("image")},
+				},
+				Output: map[string]any{"mean": float64(2), "std": 1.4142},
+			}
+
+			ctx := t.Context()
+
+			mockFunctionCallAssembler.EXPECT().
+				Assemble(expectedFunctionCallArgs).
+				Return(call, nil).
+				Once()
+
+			if tt.captureOutput {
+				mockClient.EXPECT().
+					EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: expectedCode}).
+					Return(evalResponse, nil).
+					Once()
+			} else {
+				mockClient.EXPECT().
+					Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: expectedCode}).
+					Return(evalResponse, nil).
+					Once()
+			}
+
+			usecase := evalcustomtool.New(mockFunctionCallAssembler)
+
+			// Act
+			response, err := usecase.ExecuteWithStructuredOutput(ctx, mockLogger, mockClient, evalcustomtool.Args{
+				Function:      "stats",
+				Order:         []string{"x"},
+				ArgumentTypes: map[string]string{"x": "array"},
+				Arguments:     map[string]any{"x": []any{float64(1), float64(3)}},
+				CaptureOutput: tt.captureOutput,
+			}, []string{"mean", "std"})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedResponse, response)
+		})
+	}
+}
+
+func TestUsecase_ExecuteWithStructuredOutput_InvalidResponse_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name          string
+		consoleOutput string
+		expectedError error
+	}{
+		{"no marker", "ans = 2", evalcustomtool.ErrNoStructuredOutput},
+		{"single marker", "<<MCP_STRUCTURED_OUTPUT>>{}", evalcustomtool.ErrNoStructuredOutput},
+		{"invalid JSON", "<<MCP_STRUCTURED_OUTPUT>>[1,2]<<MCP_STRUCTURED_OUTPUT>>", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
+			defer mockFunctionCallAssembler.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockFunctionCallAssembler.EXPECT().
+				Assemble(functioncall.Args{Function: "stats"}).
+				Return("stats()", nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), mock.Anything).
+				Return(entities.EvalResponse{ConsoleOutput: tt.consoleOutput}, nil).
+				Once()
+
+			usecase := evalcustomtool.New(mockFunctionCallAssembler)
+
+			// Act
+			response, err := usecase.ExecuteWithStructuredOutput(ctx, mockLogger, mockClient, evalcustomtool.Args{
+				Function: "stats",
+			}, []string{"mean"})
+
+			// Assert
+			var structuredOutputErr *evalcustomtool.StructuredOutputError
+			require.ErrorAs(t, err, &structuredOutputErr)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			}
+			assert.Equal(t, entities.EvalResponse{ConsoleOutput: tt.consoleOutput}, structuredOutputErr.Response, "The console output should be kept as is")
+			assert.Empty(t, response)
+		})
+	}
+}
+
+func TestUsecase_ExecuteWithStructuredOutput_EscapesQuotesInFunctionCall(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedCode := "clear mcpCustomToolOutputs; [mcpCustomToolOutputs{1:1}] = greet('it''s me'); " +
+		"disp(['<<MCP_STRUCTURED_OUTPUT>>' jsonencode(cell2struct(mcpCustomToolOutputs, {'greeting'}, 2)) '<<MCP_STRUCTURED_OUTPUT>>']); " +
+		"clear mcpCustomToolOutputs;"
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(functioncall.Args{Function: "greet"}).
+		Return(`greet('it''s me')`, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: expectedCode}).
+		Return(entities.EvalResponse{ConsoleOutput: `<<MCP_STRUCTURED_OUTPUT>>{"greeting":"hello"}<<MCP_STRUCTURED_OUTPUT>>`}, nil).
+		Once()
+
+	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+
+	// Act
+	response, err := usecase.ExecuteWithStructuredOutput(ctx, mockLogger, mockClient, evalcustomtool.Args{
+		Function: "greet",
+	}, []string{"greeting"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"greeting": "hello"}, response.Output)
+}
+
+func TestUsecase_ExecuteWithStructuredOutput_AssemblerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(functioncall.Args{Function: "stats"}).
+		Return("", expectedError).
+		Once()
+
+	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+
+	// Act
+	response, err := usecase.ExecuteWithStructuredOutput(t.Context(), mockLogger, mockClient, evalcustomtool.Args{
+		Function: "stats",
+	}, []string{"mean"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
@@ -3,8 +3,10 @@
 package functioncall
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"unicode"
 )
@@ -12,6 +14,7 @@ import (
 type Args struct {
 	Function      string
 	Order         []string
+	NameValue     []string
 	ArgumentTypes map[string]string
 	Arguments     map[string]any
 }
@@ -22,54 +25,76 @@ func NewAssembler() *Assembler {
 	return &Assembler{}
 }
 
+// Assemble builds the MATLAB function call for the given arguments.
+// Positional arguments are passed in order, and may only be omitted from the end of the order.
+// Name-value arguments are passed as Name=value pairs, and omitted ones are left out.
 func (a *Assembler) Assemble(args Args) (string, error) {
-	if err := validateOrderedArgsExist(args); err != nil {
+	if err := validateArgumentTypesExist(args); err != nil {
 		return "", err
 	}
 	if err := validateNoExtraArgs(args); err != nil {
 		return "", err
 	}
 
-	if len(args.Order) == 0 {
-		return args.Function + "()", nil
-	}
+	formattedArgs := make([]string, 0, len(args.Order)+len(args.NameValue))
 
-	formattedArgs := make([]string, 0, len(args.Order))
+	omittedArg := ""
 	for _, paramName := range args.Order {
-		formatted, err := formatArgument(args.Arguments[paramName], args.ArgumentTypes[paramName])
+		value, provided := args.Arguments[paramName]
+		if !provided {
+			if omittedArg == "" {
+				omittedArg = paramName
+			}
+			continue
+		}
+		if omittedArg != "" {
+			return "", fmt.Errorf("argument %q provided after omitted argument %q", paramName, omittedArg)
+		}
+
+		formatted, err := formatArgument(value, args.ArgumentTypes[paramName])
 		if err != nil {
 			return "", fmt.Errorf("failed to format argument %q: %w", paramName, err)
 		}
 		formattedArgs = append(formattedArgs, formatted)
 	}
 
+	for _, paramName := range args.NameValue {
+		value, provided := args.Arguments[paramName]
+		if !provided {
+			continue
+		}
+
+		formatted, err := formatArgument(value, args.ArgumentTypes[paramName])
+		if err != nil {
+			return "", fmt.Errorf("failed to format argument %q: %w", paramName, err)
+		}
+		formattedArgs = append(formattedArgs, paramName+"="+formatted)
+	}
+
 	return args.Function + "(" + strings.Join(formattedArgs, ", ") + ")", nil
 }
 
-func validateOrderedArgsExist(args Args) error {
-	for _, paramName := range args.Order {
+func validateArgumentTypesExist(args Args) error {
+	for _, paramName := range slices.Concat(args.Order, args.NameValue) {
 		if _, exists := args.ArgumentTypes[paramName]; !exists {
 			return fmt.Errorf("argument type for %q not defined", paramName)
 		}
-		if _, exists := args.Arguments[paramName]; !exists {
-			return fmt.Errorf("argument %q not provided", paramName)
-		}
 	}
 	return nil
 }
 
 func validateNoExtraArgs(args Args) error {
-	orderSet := make(map[string]struct{}, len(args.Order))
-	for _, paramName := range args.Order {
-		orderSet[paramName] = struct{}{}
+	paramSet := make(map[string]struct{}, len(args.Order)+len(args.NameValue))
+	for _, paramName := range slices.Concat(args.Order, args.NameValue) {
+		paramSet[paramName] = struct{}{}
 	}
 	for typeName := range args.ArgumentTypes {
-		if _, exists := orderSet[typeName]; !exists {
+		if _, exists := paramSet[typeName]; !exists {
 			return fmt.Errorf("unexpected argument type %q not in order", typeName)
 		}
 	}
 	for argName := range args.Arguments {
-		if _, exists := orderSet[argName]; !exists {
+		if _, exists := paramSet[argName]; !exists {
 			return fmt.Errorf("unexpected argument %q not in order", argName)
 		}
 	}
@@ -110,11 +135,69 @@ func formatArgument(value any, argType string) (string, error) {
 			return "true", nil
 		}
 		return "false", nil
+	case "array":
+		v, ok := value.([]any)
+		if !ok {
+			return "", fmt.Errorf("expected array, got %T", value)
+		}
+		return formatArray(v)
+	case "object":
+		v, ok := value.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("expected object, got %T", value)
+		}
+		return formatJSONDecode(v)
 	default:
 		return "", fmt.Errorf("unsupported type %q", argType)
 	}
 }
 
+// formatArray passes flat arrays of numbers, booleans or strings as MATLAB row vector literals.
+// Any other array, such as a nested or mixed array, is decoded from JSON in MATLAB instead,
+// so nested numeric arrays become matrices and mixed arrays become cell arrays.
+func formatArray(elements []any) (string, error) {
+	if len(elements) == 0 {
+		return "[]", nil
+	}
+
+	elementType := scalarType(elements[0])
+	formattedElements := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if elementType == "" || scalarType(element) != elementType {
+			return formatJSONDecode(elements)
+		}
+		formatted, err := formatArgument(element, elementType)
+		if err != nil {
+			return "", err
+		}
+		formattedElements = append(formattedElements, formatted)
+	}
+
+	return "[" + strings.Join(formattedElements, ", ") + "]", nil
+}
+
+func scalarType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return ""
+	}
+}
+
+func formatJSONDecode(value any) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode value as JSON: %w", err)
+	}
+	escaped := strings.ReplaceAll(string(encoded), "'", "''")
+	return fmt.Sprintf("jsondecode('%s')", escaped), nil
+}
+
 func stripControlCharacters(s string) string {
 	return strings.Map(func(r rune) rune {
 		if unicode.IsControl(r) {
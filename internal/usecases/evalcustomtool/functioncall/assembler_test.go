@@ -34,6 +34,14 @@ func TestAssembler_Assemble_SingleArg_HappyPath(t *testing.T) {
 		{"boolean true", "boolean", true, "myFunc(true)"},
 		{"boolean false", "boolean", false, "myFunc(false)"},
 		{"string", "string", "hello", `myFunc("hello")`},
+		{"empty array", "array", []any{}, "myFunc([])"},
+		{"numeric array", "array", []any{float64(1), float64(-2.5), float64(3)}, "myFunc([1, -2.5, 3])"},
+		{"boolean array", "array", []any{true, false}, "myFunc([true, false])"},
+		{"string array", "array", []any{"a", `say "hi"`}, `myFunc(["a", "say ""hi"""])`},
+		{"nested numeric array", "array", []any{[]any{float64(1), float64(2)}, []any{float64(3), float64(4)}}, "myFunc(jsondecode('[[1,2],[3,4]]'))"},
+		{"mixed array", "array", []any{float64(1), "a"}, `myFunc(jsondecode('[1,"a"]'))`},
+		{"object", "object", map[string]any{"name": "x", "tol": float64(0.1)}, `myFunc(jsondecode('{"name":"x","tol":0.1}'))`},
+		{"empty object", "object", map[string]any{}, "myFunc(jsondecode('{}'))"},
 	}
 
 	for _, tt := range tests {
@@ -174,6 +182,25 @@ func TestAssembler_Assemble_InvalidInteger_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestAssembler_Assemble_JSONDecodeWithSingleQuotes_HappyPath(t *testing.T) {
+	// Arrange
+	assembler := functioncall.NewAssembler()
+
+	args := functioncall.Args{
+		Function:      "myFunc",
+		Order:         []string{"opts"},
+		ArgumentTypes: map[string]string{"opts": "object"},
+		Arguments:     map[string]any{"opts": map[string]any{"label": "it's"}},
+	}
+
+	// Act
+	result, err := assembler.Assemble(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `myFunc(jsondecode('{"label":"it''s"}'))`, result)
+}
+
 func TestAssembler_Assemble_UnsupportedType_ReturnsError(t *testing.T) {
 	// Arrange
 	assembler := functioncall.NewAssembler()
@@ -181,8 +208,8 @@ func TestAssembler_Assemble_UnsupportedType_ReturnsError(t *testing.T) {
 	args := functioncall.Args{
 		Function:      "myFunc",
 		Order:         []string{"data"},
-		ArgumentTypes: map[string]string{"data": "array"},
-		Arguments:     map[string]any{"data": []any{"a", "b"}},
+		ArgumentTypes: map[string]string{"data": "null"},
+		Arguments:     map[string]any{"data": nil},
 	}
 
 	// Act
@@ -203,6 +230,8 @@ func TestAssembler_Assemble_TypeMismatch_ReturnsError(t *testing.T) {
 		{"string passed as number", "number", "hello"},
 		{"string passed as integer", "integer", "hello"},
 		{"string passed as boolean", "boolean", "hello"},
+		{"string passed as array", "array", "hello"},
+		{"array passed as object", "object", []any{"a"}},
 	}
 
 	for _, tt := range tests {
@@ -302,15 +331,88 @@ func TestAssembler_Assemble_UnexpectedArgumentType_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "unexpected argument type \"extra\" not in order")
 }
 
-func TestAssembler_Assemble_MissingArgument_ReturnsError(t *testing.T) {
+func TestAssembler_Assemble_OmittedTrailingArguments_HappyPath(t *testing.T) {
 	// Arrange
 	assembler := functioncall.NewAssembler()
 
 	args := functioncall.Args{
-		Function:      "magic",
-		Order:         []string{"n"},
-		ArgumentTypes: map[string]string{"n": "number"},
-		Arguments:     map[string]any{},
+		Function:      "myFunc",
+		Order:         []string{"x", "y", "z"},
+		ArgumentTypes: map[string]string{"x": "number", "y": "number", "z": "number"},
+		Arguments:     map[string]any{"x": float64(1)},
+	}
+
+	// Act
+	result, err := assembler.Assemble(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "myFunc(1)", result)
+}
+
+func TestAssembler_Assemble_ArgumentAfterOmittedArgument_ReturnsError(t *testing.T) {
+	// Arrange
+	assembler := functioncall.NewAssembler()
+
+	args := functioncall.Args{
+		Function:      "myFunc",
+		Order:         []string{"x", "y", "z"},
+		ArgumentTypes: map[string]string{"x": "number", "y": "number", "z": "number"},
+		Arguments:     map[string]any{"x": float64(1), "z": float64(3)},
+	}
+
+	// Act
+	_, err := assembler.Assemble(args)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `argument "z" provided after omitted argument "y"`)
+}
+
+func TestAssembler_Assemble_NameValueArguments_HappyPath(t *testing.T) {
+	tests := []struct {
+		name           string
+		arguments      map[string]any
+		expectedResult string
+	}{
+		{"all provided", map[string]any{"x": float64(1), "Method": "linear", "Verbose": true}, `myFunc(1, Method="linear", Verbose=true)`},
+		{"some omitted", map[string]any{"x": float64(1), "Verbose": false}, "myFunc(1, Verbose=false)"},
+		{"none provided", map[string]any{"x": float64(1)}, "myFunc(1)"},
+		{"positional omitted", map[string]any{"Method": "linear"}, `myFunc(Method="linear")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			assembler := functioncall.NewAssembler()
+
+			args := functioncall.Args{
+				Function:      "myFunc",
+				Order:         []string{"x"},
+				NameValue:     []string{"Method", "Verbose"},
+				ArgumentTypes: map[string]string{"x": "number", "Method": "string", "Verbose": "boolean"},
+				Arguments:     tt.arguments,
+			}
+
+			// Act
+			result, err := assembler.Assemble(args)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestAssembler_Assemble_NameValueTypeMismatch_ReturnsError(t *testing.T) {
+	// Arrange
+	assembler := functioncall.NewAssembler()
+
+	args := functioncall.Args{
+		Function:      "myFunc",
+		NameValue:     []string{"Verbose"},
+		ArgumentTypes: map[string]string{"Verbose": "boolean"},
+		Arguments:     map[string]any{"Verbose": "yes"},
 	}
 
 	// Act
@@ -318,5 +420,5 @@ func TestAssembler_Assemble_MissingArgument_ReturnsError(t *testing.T) {
 
 	// Assert
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "n")
+	assert.Contains(t, err.Error(), `failed to format argument "Verbose"`)
 }
@@ -5,6 +5,7 @@ package evalmatlabcode
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
@@ -14,6 +15,7 @@ type Args struct {
 	Code          string
 	ProjectPath   string
 	CaptureOutput bool
+	Timeout       time.Duration
 }
 
 type PathValidator interface {
@@ -53,7 +55,8 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	}
 
 	evalRequest := entities.EvalRequest{
-		Code: request.Code,
+		Code:    request.Code,
+		Timeout: request.Timeout,
 	}
 
 	if request.CaptureOutput {
@@ -5,6 +5,7 @@ package evalmatlabcode_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -395,3 +396,53 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Error should be the original error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
+
+func TestUsecase_Execute_Timeout_AppliesOnlyToCode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	projectPath := filepath.Join("some", "path")
+	timeout := 30 * time.Second
+
+	evalRequest := evalmatlabcode.Args{
+		ProjectPath:   projectPath,
+		Code:          "pause(60)",
+		CaptureOutput: true,
+		Timeout:       timeout,
+	}
+
+	expectedError := &entities.EvalTimeoutError{Timeout: timeout}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
+			Code: "cd('" + projectPath + "')",
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: evalRequest.Code, Timeout: timeout}).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ErrCodeFixUnsupported is returned when the MATLAB session is too old to apply Code Analyzer fixes
+var ErrCodeFixUnsupported = errors.New("applying Code Analyzer fixes requires MATLAB R2023a or later, and the connected MATLAB session uses an earlier release")
+
+type Args struct {
+	ScriptPath string
+	// CheckIDs restricts the fixes to issues with these Code Analyzer check IDs. When empty, every auto-fixable issue is fixed.
+	CheckIDs []string
+}
+
+type ReturnArgs struct {
+	// FixedIssueCount is the number of issues selected for fixing that Code Analyzer no longer reports after the fixes.
+	FixedIssueCount int
+	Diff            string
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type CodeFixer interface {
+	FixCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string, checkIDs []string) (int, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codeFixer     CodeFixer
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	codeFixer CodeFixer,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codeFixer:     codeFixer,
+		osLayer:       osLayer,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering FixMATLABCode Usecase")
+	defer sessionLogger.Debug("Exiting FixMATLABCode Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	before, err := u.osLayer.ReadFile(validatedPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to read file before applying fixes: %w", err)
+	}
+
+	fixedIssueCount, err := u.codeFixer.FixCode(ctx, sessionLogger, client, validatedPath, request.CheckIDs)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	after, err := u.osLayer.ReadFile(validatedPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to read file after applying fixes: %w", err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(before)),
+		B:        difflib.SplitLines(string(after)),
+		FromFile: validatedPath,
+		ToFile:   validatedPath,
+		Context:  3,
+	})
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to compute diff: %w", err)
+	}
+
+	return ReturnArgs{
+		FixedIssueCount: fixedIssueCount,
+		Diff:            diff,
+	}, nil
+}
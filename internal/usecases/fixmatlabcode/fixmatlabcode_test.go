@@ -0,0 +1,301 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package fixmatlabcode_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	fixmatlabcodemocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/fixmatlabcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("path", "to", "script.m")
+	validatedPath := filepath.Join("validated", "path", "to", "script.m")
+	checkIDs := []string{"NOPRT"}
+	before := "x = 1\ny = 2;\n"
+	after := "x = 1;\ny = 2;\n"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(validatedPath).
+		Return([]byte(before), nil).
+		Once()
+
+	mockCodeFixer.EXPECT().
+		FixCode(ctx, mockLogger.AsMockArg(), mockClient, validatedPath, checkIDs).
+		Return(1, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(validatedPath).
+		Return([]byte(after), nil).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, fixmatlabcode.Args{
+		ScriptPath: scriptPath,
+		CheckIDs:   checkIDs,
+	})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, 1, response.FixedIssueCount, "FixedIssueCount should match the number of applied fixes")
+	assert.Contains(t, response.Diff, "--- "+validatedPath, "Diff should name the original file")
+	assert.Contains(t, response.Diff, "+++ "+validatedPath, "Diff should name the fixed file")
+	assert.Contains(t, response.Diff, "-x = 1\n", "Diff should contain the removed line")
+	assert.Contains(t, response.Diff, "+x = 1;\n", "Diff should contain the added line")
+	assert.Contains(t, response.Diff, " y = 2;\n", "Diff should contain unchanged context lines")
+}
+
+func TestUsecase_Execute_NoChanges(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("path", "to", "script.m")
+	content := []byte("x = 1;\n")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return(content, nil).
+		Twice()
+
+	mockCodeFixer.EXPECT().
+		FixCode(ctx, mockLogger.AsMockArg(), mockClient, scriptPath, []string(nil)).
+		Return(0, nil).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, fixmatlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, 0, response.FixedIssueCount, "FixedIssueCount should be zero")
+	assert.Empty(t, response.Diff, "Diff should be empty when nothing changed")
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	scriptPath := filepath.Join("path", "to", "script.txt")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, fixmatlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should wrap the path validation error")
+	assert.Empty(t, response, "Response should be empty on error")
+}
+
+func TestUsecase_Execute_ReadBeforeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	scriptPath := filepath.Join("path", "to", "script.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, fixmatlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should wrap the read error")
+	assert.Empty(t, response, "Response should be empty on error")
+}
+
+func TestUsecase_Execute_FixCodeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("path", "to", "script.m")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte("x = 1\n"), nil).
+		Once()
+
+	mockCodeFixer.EXPECT().
+		FixCode(ctx, mockLogger.AsMockArg(), mockClient, scriptPath, []string(nil)).
+		Return(0, fixmatlabcode.ErrCodeFixUnsupported).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, fixmatlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, fixmatlabcode.ErrCodeFixUnsupported, "Error should be the code fixer error")
+	assert.Empty(t, response, "Response should be empty on error")
+}
+
+func TestUsecase_Execute_ReadAfterError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &fixmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeFixer := &fixmatlabcodemocks.MockCodeFixer{}
+	defer mockCodeFixer.AssertExpectations(t)
+
+	mockOSLayer := &fixmatlabcodemocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("path", "to", "script.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte("x = 1\n"), nil).
+		Once()
+
+	mockCodeFixer.EXPECT().
+		FixCode(ctx, mockLogger.AsMockArg(), mockClient, scriptPath, []string(nil)).
+		Return(1, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := fixmatlabcode.New(mockPathValidator, mockCodeFixer, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, fixmatlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should wrap the read error")
+	assert.Empty(t, response, "Response should be empty on error")
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// DefaultMaxLines is the number of lines of MATLAB output returned when the caller does not choose one.
+const DefaultMaxLines = 100
+
+type SessionLogReader interface {
+	GetMATLABSessionLog(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID, maxLines int) (entities.MATLABSessionLog, error)
+}
+
+type Usecase struct {
+	sessionLogReader SessionLogReader
+}
+
+type Args struct {
+	SessionID entities.SessionID
+	// MaxLines is the number of lines of MATLAB output to return. DefaultMaxLines is used when it is not positive.
+	MaxLines int
+}
+
+type ReturnArgs entities.MATLABSessionLog
+
+func New(
+	sessionLogReader SessionLogReader,
+) *Usecase {
+	return &Usecase{
+		sessionLogReader: sessionLogReader,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, args Args) (ReturnArgs, error) {
+	sessionLogger = sessionLogger.With("session_id", args.SessionID)
+	sessionLogger.Debug("Entering GetMATLABSessionLog Usecase")
+	defer sessionLogger.Debug("Exiting GetMATLABSessionLog Usecase")
+
+	maxLines := args.MaxLines
+	if maxLines <= 0 {
+		maxLines = DefaultMaxLines
+	}
+
+	sessionLog, err := u.sessionLogReader.GetMATLABSessionLog(ctx, sessionLogger, args.SessionID, maxLines)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return ReturnArgs(sessionLog), nil
+}
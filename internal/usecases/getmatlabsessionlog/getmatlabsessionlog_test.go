@@ -0,0 +1,104 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabsessionlog_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getmatlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	// Act
+	usecase := getmatlabsessionlog.New(mockSessionLogReader)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	ctx := t.Context()
+	sessionID := entities.SessionID(2)
+	expectedLog := entities.MATLABSessionLog{
+		Stdout: "MATLAB is ready",
+	}
+
+	mockSessionLogReader.EXPECT().
+		GetMATLABSessionLog(ctx, mockLogger.AsMockArg(), sessionID, 20).
+		Return(expectedLog, nil).
+		Once()
+
+	usecase := getmatlabsessionlog.New(mockSessionLogReader)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: sessionID, MaxLines: 20})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabsessionlog.ReturnArgs(expectedLog), result)
+}
+
+func TestUsecase_Execute_UsesDefaultMaxLines(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	ctx := t.Context()
+	sessionID := entities.SessionID(2)
+
+	mockSessionLogReader.EXPECT().
+		GetMATLABSessionLog(ctx, mockLogger.AsMockArg(), sessionID, getmatlabsessionlog.DefaultMaxLines).
+		Return(entities.MATLABSessionLog{}, nil).
+		Once()
+
+	usecase := getmatlabsessionlog.New(mockSessionLogReader)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: sessionID})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestUsecase_Execute_SessionLogReaderError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionLogReader := &mocks.MockSessionLogReader{}
+	defer mockSessionLogReader.AssertExpectations(t)
+
+	ctx := t.Context()
+	sessionID := entities.SessionID(2)
+	expectedError := assert.AnError
+
+	mockSessionLogReader.EXPECT().
+		GetMATLABSessionLog(ctx, mockLogger.AsMockArg(), sessionID, getmatlabsessionlog.DefaultMaxLines).
+		Return(entities.MATLABSessionLog{}, expectedError).
+		Once()
+
+	usecase := getmatlabsessionlog.New(mockSessionLogReader)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, getmatlabsessionlog.Args{SessionID: sessionID})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SessionLister interface {
+	ListMATLABSessions(ctx context.Context, sessionLogger entities.Logger) ([]entities.MATLABSessionInfo, error)
+}
+
+type Usecase struct {
+	sessionLister SessionLister
+}
+
+type ReturnArgs []entities.MATLABSessionInfo
+
+func New(
+	sessionLister SessionLister,
+) *Usecase {
+	return &Usecase{
+		sessionLister: sessionLister,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListMATLABSessions Usecase")
+	defer sessionLogger.Debug("Exiting ListMATLABSessions Usecase")
+
+	sessions, err := u.sessionLister.ListMATLABSessions(ctx, sessionLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/listmatlabsessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSessionLister := &mocks.MockSessionLister{}
+	defer mockSessionLister.AssertExpectations(t)
+
+	// Act
+	usecase := listmatlabsessions.New(mockSessionLister)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionLister := &mocks.MockSessionLister{}
+	defer mockSessionLister.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessions := []entities.MATLABSessionInfo{
+		{SessionID: 1, Version: "R2026a", ProcessID: 1234, StartedAt: time.Unix(1767225600, 0), IsAlive: true},
+		{SessionID: 2, Version: "R2025b", ProcessID: 5678, IsBusy: true, IsAlive: true},
+	}
+
+	mockSessionLister.EXPECT().
+		ListMATLABSessions(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessions, nil).
+		Once()
+
+	usecase := listmatlabsessions.New(mockSessionLister)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatlabsessions.ReturnArgs(expectedSessions), result)
+}
+
+func TestUsecase_Execute_SessionListerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionLister := &mocks.MockSessionLister{}
+	defer mockSessionLister.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockSessionLister.EXPECT().
+		ListMATLABSessions(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := listmatlabsessions.New(mockSessionLister)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
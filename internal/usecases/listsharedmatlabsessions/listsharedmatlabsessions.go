@@ -0,0 +1,34 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listsharedmatlabsessions
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SharedSessionLister interface {
+	ListSharedMATLABSessions(ctx context.Context, sessionLogger entities.Logger) []entities.SharedMATLABSession
+}
+
+type Usecase struct {
+	sharedSessionLister SharedSessionLister
+}
+
+type ReturnArgs []entities.SharedMATLABSession
+
+func New(
+	sharedSessionLister SharedSessionLister,
+) *Usecase {
+	return &Usecase{
+		sharedSessionLister: sharedSessionLister,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) ReturnArgs {
+	sessionLogger.Debug("Entering ListSharedMATLABSessions Usecase")
+	defer sessionLogger.Debug("Exiting ListSharedMATLABSessions Usecase")
+
+	return u.sharedSessionLister.ListSharedMATLABSessions(ctx, sessionLogger)
+}
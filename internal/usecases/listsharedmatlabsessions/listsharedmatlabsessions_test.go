@@ -0,0 +1,52 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listsharedmatlabsessions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsharedmatlabsessions"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/listsharedmatlabsessions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	// Act
+	usecase := listsharedmatlabsessions.New(mockSharedSessionLister)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSharedSessionLister := &mocks.MockSharedSessionLister{}
+	defer mockSharedSessionLister.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessions := []entities.SharedMATLABSession{
+		{ProcessID: 1234, Name: "analysis", Release: "R2026a"},
+		{ProcessID: 5678, Release: "R2025b"},
+	}
+
+	mockSharedSessionLister.EXPECT().
+		ListSharedMATLABSessions(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessions).
+		Once()
+
+	usecase := listsharedmatlabsessions.New(mockSharedSessionLister)
+
+	// Act
+	result := usecase.Execute(ctx, mockLogger)
+
+	// Assert
+	assert.Equal(t, listsharedmatlabsessions.ReturnArgs(expectedSessions), result)
+}
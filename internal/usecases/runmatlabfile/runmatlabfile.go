@@ -5,6 +5,7 @@ package runmatlabfile
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
@@ -14,6 +15,7 @@ import (
 type Args struct {
 	ScriptPath    string
 	CaptureOutput bool
+	Timeout       time.Duration
 }
 
 type PathValidator interface {
@@ -51,7 +53,8 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	}
 
 	runCodeRequest := entities.EvalRequest{
-		Code: scriptName,
+		Code:    scriptName,
+		Timeout: request.Timeout,
 	}
 
 	if request.CaptureOutput {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -379,3 +380,62 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response, "Response should be empty")
 }
+
+func TestUsecase_Execute_Timeout_AppliesOnlyToScript(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	fileName := "file"
+	scriptDir := filepath.Join("some", "path", "to")
+	scriptPath := filepath.Join(scriptDir, fileName+".m")
+	timeout := 30 * time.Second
+
+	usecaseRequest := runmatlabfile.Args{
+		ScriptPath: scriptPath,
+		Timeout:    timeout,
+	}
+
+	expectedCdRequest := entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", scriptDir),
+	}
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code:    fileName,
+		Timeout: timeout,
+	}
+
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "Hello, World!",
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(expectedResponse, nil).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, expectedResponse, response, "Response should match expected value")
+}
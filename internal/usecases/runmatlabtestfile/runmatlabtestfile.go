@@ -4,51 +4,246 @@ package runmatlabtestfile
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
-	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabstring"
 )
 
+type TestStatus string
+
+const (
+	TestStatusPassed     TestStatus = "passed"
+	TestStatusFailed     TestStatus = "failed"
+	TestStatusIncomplete TestStatus = "incomplete"
+)
+
+// TestDiagnostic represents a single failure or qualification event recorded while a test ran
+type TestDiagnostic struct {
+	Event  string
+	Report string
+	File   string
+	Line   int
+}
+
+// TestResult represents the outcome of a single test
+type TestResult struct {
+	Name        string
+	Status      TestStatus
+	Duration    time.Duration
+	Diagnostics []TestDiagnostic
+}
+
+// TestSummary aggregates the outcome of every test in a run
+type TestSummary struct {
+	Total      int
+	Passed     int
+	Failed     int
+	Incomplete int
+	Duration   time.Duration
+}
+
+// FileCoverage represents the code coverage measured for a single source file
+type FileCoverage struct {
+	File              string
+	CoveredStatements int
+	TotalStatements   int
+	CoveredFunctions  int
+	TotalFunctions    int
+}
+
+// TestArtifacts holds the paths of the report files written by a run. Paths are empty when no report was written.
+type TestArtifacts struct {
+	JUnitReport     string
+	CoberturaReport string
+}
+
+const (
+	junitReportFileName     = "junit.xml"
+	coberturaReportFileName = "coverage.xml"
+)
+
+// Args selects the tests to run. Exactly one of ScriptPath, TestFolder and TestSuite must be set.
 type Args struct {
-	ScriptPath string
+	ScriptPath      string
+	TestFolder      string
+	TestSuite       string
+	ProcedureNames  []string
+	IncludeTags     []string
+	ExcludeTags     []string
+	UseParallel     bool
+	Strict          bool
+	CoverageFolders []string
+	ArtifactsFolder string
+	Timeout         time.Duration
+}
+
+type ReturnArgs struct {
+	Summary       TestSummary
+	Tests         []TestResult
+	Coverage      []FileCoverage
+	Artifacts     TestArtifacts
+	ConsoleOutput string
+}
+
+// TestRunRequest describes a single run of the MATLAB testing framework.
+// Exactly one of TestFile, TestFolder and TestSuite is set. Every path has already been validated,
+// and TestSuite is a valid class or namespace name.
+type TestRunRequest struct {
+	TestFile            string
+	TestFolder          string
+	TestSuite           string
+	ProcedureNames      []string
+	IncludeTags         []string
+	ExcludeTags         []string
+	UseParallel         bool
+	Strict              bool
+	CoverageFolders     []string
+	JUnitReportPath     string
+	CoberturaReportPath string
+	Timeout             time.Duration
+}
+
+// TestRun holds the per-test results of a run, together with the console output the run produced
+type TestRun struct {
+	Tests         []TestResult
+	Coverage      []FileCoverage
+	ConsoleOutput string
 }
 
+// testSuiteNamePattern matches a class or namespace name, such as mypackage.tests
+var testSuiteNamePattern = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
+
 type PathValidator interface {
 	ValidateMATLABScript(filePath string) (string, error)
+	ValidateFolderPath(filePath string) (string, error)
+}
+
+type TestRunner interface {
+	RunTests(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request TestRunRequest) (TestRun, error)
 }
 
 type Usecase struct {
 	pathValidator PathValidator
+	testRunner    TestRunner
 }
 
 func New(
 	pathValidator PathValidator,
+	testRunner TestRunner,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		testRunner:    testRunner,
 	}
 }
 
-func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (entities.EvalResponse, error) {
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
 	sessionLogger.Debug("Entering RunMATLABTestFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABTestFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	testRunRequest, err := u.buildTestRunRequest(request)
 	if err != nil {
-		return entities.EvalResponse{}, err
+		return ReturnArgs{}, err
 	}
 
-	runCodeRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("runtests('%s')", matlabstring.EscapeSingleQuotes(validatedPath)),
+	testRun, err := u.testRunner.RunTests(ctx, sessionLogger, client, testRunRequest)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return ReturnArgs{
+		Summary:  summarize(testRun.Tests),
+		Tests:    testRun.Tests,
+		Coverage: testRun.Coverage,
+		Artifacts: TestArtifacts{
+			JUnitReport:     testRunRequest.JUnitReportPath,
+			CoberturaReport: testRunRequest.CoberturaReportPath,
+		},
+		ConsoleOutput: testRun.ConsoleOutput,
+	}, nil
+}
+
+func (u *Usecase) buildTestRunRequest(request Args) (TestRunRequest, error) {
+	testRunRequest := TestRunRequest{
+		TestSuite:      request.TestSuite,
+		ProcedureNames: request.ProcedureNames,
+		IncludeTags:    request.IncludeTags,
+		ExcludeTags:    request.ExcludeTags,
+		UseParallel:    request.UseParallel,
+		Strict:         request.Strict,
+		Timeout:        request.Timeout,
 	}
 
-	response, err := client.EvalWithCapture(ctx, sessionLogger, runCodeRequest)
+	var err error
+
+	switch {
+	case countNonEmpty(request.ScriptPath, request.TestFolder, request.TestSuite) != 1:
+		return TestRunRequest{}, errors.New("exactly one of a test file, a test folder or a test suite must be specified")
+	case request.ScriptPath != "":
+		testRunRequest.TestFile, err = u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	case request.TestFolder != "":
+		testRunRequest.TestFolder, err = u.pathValidator.ValidateFolderPath(request.TestFolder)
+	case !testSuiteNamePattern.MatchString(request.TestSuite):
+		err = fmt.Errorf("%q is not a valid test class or namespace name, such as mypackage.tests or MyClassTest", request.TestSuite)
+	}
 	if err != nil {
-		return entities.EvalResponse{}, err
+		return TestRunRequest{}, err
 	}
 
-	return entities.EvalResponse{
-		ConsoleOutput: response.ConsoleOutput,
-	}, nil
+	for _, coverageFolder := range request.CoverageFolders {
+		validatedFolder, err := u.pathValidator.ValidateFolderPath(coverageFolder)
+		if err != nil {
+			return TestRunRequest{}, fmt.Errorf("invalid coverage folder: %w", err)
+		}
+		testRunRequest.CoverageFolders = append(testRunRequest.CoverageFolders, validatedFolder)
+	}
+
+	if request.ArtifactsFolder != "" {
+		// The artifacts folder is created by MATLAB if it does not exist yet, so it is only checked for being absolute.
+		artifactsFolder := filepath.Clean(request.ArtifactsFolder)
+		if !filepath.IsAbs(artifactsFolder) {
+			return TestRunRequest{}, fmt.Errorf("%s is not a valid absolute path", artifactsFolder)
+		}
+
+		testRunRequest.JUnitReportPath = filepath.Join(artifactsFolder, junitReportFileName)
+		if len(testRunRequest.CoverageFolders) > 0 {
+			testRunRequest.CoberturaReportPath = filepath.Join(artifactsFolder, coberturaReportFileName)
+		}
+	}
+
+	return testRunRequest, nil
+}
+
+func countNonEmpty(values ...string) int {
+	count := 0
+	for _, value := range values {
+		if value != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func summarize(tests []TestResult) TestSummary {
+	summary := TestSummary{
+		Total: len(tests),
+	}
+
+	for _, test := range tests {
+		switch test.Status {
+		case TestStatusPassed:
+			summary.Passed++
+		case TestStatusFailed:
+			summary.Failed++
+		case TestStatusIncomplete:
+			summary.Incomplete++
+		}
+		summary.Duration += test.Duration
+	}
+
+	return summary
 }
@@ -3,9 +3,9 @@
 package runmatlabtestfile_test
 
 import (
-	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -21,8 +21,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
 	// Act
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -35,39 +38,59 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
 	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+	validatedPath := filepath.Join("validated", "path", "to", "testFile.m")
 
 	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("runtests('%s')", scriptPath),
-	}
-
-	expectedResponse := entities.EvalResponse{
-		ConsoleOutput: "Running typeTests\n....\nDone typeTests",
+	tests := []runmatlabtestfile.TestResult{
+		{Name: "testFile/testA", Status: runmatlabtestfile.TestStatusPassed, Duration: 100 * time.Millisecond},
+		{Name: "testFile/testB", Status: runmatlabtestfile.TestStatusPassed, Duration: 200 * time.Millisecond},
+		{
+			Name:     "testFile/testC",
+			Status:   runmatlabtestfile.TestStatusFailed,
+			Duration: 300 * time.Millisecond,
+			Diagnostics: []runmatlabtestfile.TestDiagnostic{
+				{Event: "VerificationFailed", Report: "Verification failed.", File: validatedPath, Line: 7},
+			},
+		},
+		{Name: "testFile/testD", Status: runmatlabtestfile.TestStatusIncomplete, Duration: 400 * time.Millisecond},
 	}
 
-	mockResponse := entities.EvalResponse{
-		ConsoleOutput: "Running typeTests\n....\nDone typeTests",
-		Images:        [][]byte{[]byte("image1"), []byte("image2")},
+	expectedResponse := runmatlabtestfile.ReturnArgs{
+		Summary: runmatlabtestfile.TestSummary{
+			Total:      4,
+			Passed:     2,
+			Failed:     1,
+			Incomplete: 1,
+			Duration:   time.Second,
+		},
+		Tests:         tests,
+		ConsoleOutput: "Running testFile\n....\nDone testFile",
 	}
 
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
 		ValidateMATLABScript(scriptPath).
-		Return(scriptPath, nil).
+		Return(validatedPath, nil).
 		Once()
 
-	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(mockResponse, nil).
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, runmatlabtestfile.TestRunRequest{TestFile: validatedPath}).
+		Return(runmatlabtestfile.TestRun{
+			Tests:         tests,
+			ConsoleOutput: "Running testFile\n....\nDone testFile",
+		}, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -77,53 +100,44 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedResponse, response, "Response should match expected value")
 }
 
-func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
+func TestUsecase_Execute_NoTests(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
-	ctx := t.Context()
-	scriptPath := filepath.Join("Users", "O'Brien", "testFile.m")
-	expectedEscapedPath := "Users" + string(filepath.Separator) + "O''Brien" + string(filepath.Separator) + "testFile.m"
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
 
 	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("runtests('%s')", expectedEscapedPath),
-	}
-
-	expectedResponse := entities.EvalResponse{
-		ConsoleOutput: "Running typeTests\n....\nDone typeTests",
-	}
-
-	mockResponse := entities.EvalResponse{
-		ConsoleOutput: "Running typeTests\n....\nDone typeTests",
-		Images:        [][]byte{[]byte("image1"), []byte("image2")},
-	}
+	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
 		ValidateMATLABScript(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
-	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(mockResponse, nil).
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, runmatlabtestfile.TestRunRequest{TestFile: scriptPath}).
+		Return(runmatlabtestfile.TestRun{Tests: []runmatlabtestfile.TestResult{}}, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, expectedResponse, response, "Response should match expected value")
+	assert.Equal(t, runmatlabtestfile.TestSummary{}, response.Summary, "Summary should be empty")
+	assert.Empty(t, response.Tests, "Tests should be empty")
 }
 
 func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
@@ -133,6 +147,9 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -147,7 +164,7 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -157,13 +174,16 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty")
 }
 
-func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
+func TestUsecase_Execute_RunTestsError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -173,26 +193,341 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 
 	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("runtests('%s')", scriptPath),
-	}
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, runmatlabtestfile.TestRunRequest{TestFile: scriptPath}).
+		Return(runmatlabtestfile.TestRun{}, expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_Timeout(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+	timeout := 2 * time.Minute
+
+	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath, Timeout: timeout}
+
+	expectedError := &entities.EvalTimeoutError{Timeout: timeout}
+
+	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
 		ValidateMATLABScript(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
-	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{}, expectedError).
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, runmatlabtestfile.TestRunRequest{TestFile: scriptPath, Timeout: timeout}).
+		Return(runmatlabtestfile.TestRun{}, expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_TestFolderWithCoverageAndArtifacts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	testFolder := filepath.Join(string(filepath.Separator), "project", "tests")
+	sourceFolder := filepath.Join(string(filepath.Separator), "project", "source")
+	artifactsFolder := filepath.Join(t.TempDir(), "artifacts")
+
+	usecaseRequest := runmatlabtestfile.Args{
+		TestFolder:      testFolder,
+		ProcedureNames:  []string{"testAdd*"},
+		IncludeTags:     []string{"Unit"},
+		ExcludeTags:     []string{"Slow"},
+		UseParallel:     true,
+		Strict:          true,
+		CoverageFolders: []string{sourceFolder},
+		ArtifactsFolder: artifactsFolder,
+	}
+
+	expectedTestRunRequest := runmatlabtestfile.TestRunRequest{
+		TestFolder:          testFolder,
+		ProcedureNames:      []string{"testAdd*"},
+		IncludeTags:         []string{"Unit"},
+		ExcludeTags:         []string{"Slow"},
+		UseParallel:         true,
+		Strict:              true,
+		CoverageFolders:     []string{sourceFolder},
+		JUnitReportPath:     filepath.Join(artifactsFolder, "junit.xml"),
+		CoberturaReportPath: filepath.Join(artifactsFolder, "coverage.xml"),
+	}
+
+	coverage := []runmatlabtestfile.FileCoverage{
+		{File: filepath.Join(sourceFolder, "add.m"), CoveredStatements: 3, TotalStatements: 4, CoveredFunctions: 1, TotalFunctions: 1},
+	}
+
+	expectedArtifacts := runmatlabtestfile.TestArtifacts{
+		JUnitReport:     filepath.Join(artifactsFolder, "junit.xml"),
+		CoberturaReport: filepath.Join(artifactsFolder, "coverage.xml"),
+	}
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(testFolder).
+		Return(testFolder, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(sourceFolder).
+		Return(sourceFolder, nil).
+		Once()
+
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, expectedTestRunRequest).
+		Return(runmatlabtestfile.TestRun{Coverage: coverage}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, coverage, response.Coverage, "Coverage should match expected value")
+	assert.Equal(t, expectedArtifacts, response.Artifacts, "Artifacts should match expected value")
+}
+
+func TestUsecase_Execute_TestSuiteWithArtifactsButNoCoverage(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	artifactsFolder := filepath.Join(t.TempDir(), "artifacts")
+
+	usecaseRequest := runmatlabtestfile.Args{
+		TestSuite:       "mypackage.tests",
+		ArtifactsFolder: artifactsFolder,
+	}
+
+	expectedTestRunRequest := runmatlabtestfile.TestRunRequest{
+		TestSuite:       "mypackage.tests",
+		JUnitReportPath: filepath.Join(artifactsFolder, "junit.xml"),
+	}
+
+	ctx := t.Context()
+
+	mockTestRunner.EXPECT().
+		RunTests(ctx, mockLogger.AsMockArg(), mockClient, expectedTestRunRequest).
+		Return(runmatlabtestfile.TestRun{}, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
 
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, runmatlabtestfile.TestArtifacts{JUnitReport: filepath.Join(artifactsFolder, "junit.xml")}, response.Artifacts)
+}
+
+func TestUsecase_Execute_NoTestSource(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabtestfile.Args{})
+
+	// Assert
+	require.ErrorContains(t, err, "exactly one of a test file, a test folder or a test suite must be specified")
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_MultipleTestSources(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecaseRequest := runmatlabtestfile.Args{
+		ScriptPath: filepath.Join("some", "path", "to", "testFile.m"),
+		TestSuite:  "mypackage.tests",
+	}
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorContains(t, err, "exactly one of a test file, a test folder or a test suite must be specified")
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_InvalidTestSuite(t *testing.T) {
+	testCases := []struct {
+		name      string
+		testSuite string
+	}{
+		{name: "starts with a digit", testSuite: "1tests"},
+		{name: "empty namespace", testSuite: "mypackage..tests"},
+		{name: "trailing dot", testSuite: "mypackage."},
+		{name: "MATLAB code", testSuite: "tests'); delete('file"},
+		{name: "path", testSuite: filepath.Join("some", "tests")},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockTestRunner := &mocks.MockTestRunner{}
+			defer mockTestRunner.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecaseRequest := runmatlabtestfile.Args{
+				TestSuite: testCase.testSuite,
+			}
+
+			usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+			// Act
+			response, err := usecase.Execute(t.Context(), mockLogger, mockClient, usecaseRequest)
+
+			// Assert
+			require.ErrorContains(t, err, "is not a valid test class or namespace name")
+			assert.Empty(t, response, "Response should be empty")
+		})
+	}
+}
+
+func TestUsecase_Execute_InvalidCoverageFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+	coverageFolder := filepath.Join("some", "source")
+
+	usecaseRequest := runmatlabtestfile.Args{
+		TestSuite:       "mypackage.tests",
+		CoverageFolders: []string{coverageFolder},
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(coverageFolder).
+		Return("", expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, usecaseRequest)
+
 	// Assert
 	require.ErrorIs(t, err, expectedError)
+	require.ErrorContains(t, err, "invalid coverage folder")
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_RelativeArtifactsFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockTestRunner := &mocks.MockTestRunner{}
+	defer mockTestRunner.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecaseRequest := runmatlabtestfile.Args{
+		TestSuite:       "mypackage.tests",
+		ArtifactsFolder: "artifacts",
+	}
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockTestRunner)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorContains(t, err, "artifacts is not a valid absolute path")
 	assert.Empty(t, response, "Response should be empty")
 }
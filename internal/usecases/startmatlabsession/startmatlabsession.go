@@ -1,15 +1,63 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package startmatlabsession
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+var (
+	ErrUnsupportedMATLABFlag       = errors.New("unsupported MATLAB flag")
+	ErrConflictingMATLABFlags      = errors.New("conflicting MATLAB flags")
+	ErrInvalidEnvironmentVariable  = errors.New("invalid environment variable name")
+	ErrReservedEnvironmentVariable = errors.New("environment variable is reserved by the server")
+)
+
+// allowedMATLABFlags are the MATLAB command line flags that may be passed when starting a session.
+var allowedMATLABFlags = []string{
+	"-softwareopengl",
+	"-nosoftwareopengl",
+	"-singleCompThread",
+	"-noFigureWindows",
+	"-nosplash",
+}
+
+// conflictingMATLABFlags are pairs of MATLAB command line flags that undo each other.
+var conflictingMATLABFlags = [][2]string{
+	{"-softwareopengl", "-nosoftwareopengl"},
+}
+
+// reservedEnvironmentVariables are set by the server to connect to the MATLAB session.
+var reservedEnvironmentVariables = []string{
+	"MATLAB_LOG_DIR",
+	"MW_MCP_SESSION_DIR",
+	"MW_DIAGNOSTIC_DEST",
+	"MWAPIKEY",
+	"MW_CERTFILE",
+	"MW_PKEYFILE",
+	"MW_CONTEXT_TAGS",
+}
+
+var environmentVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+	ValidateFolderPath(filePath string) (string, error)
+}
+
 type Usecase struct {
 	matlabManager entities.MATLABManager
+	pathValidator PathValidator
+
+	goos string
 }
 
 type ReturnArgs struct {
@@ -20,9 +68,13 @@ type ReturnArgs struct {
 
 func New(
 	matlabManager entities.MATLABManager,
+	pathValidator PathValidator,
 ) *Usecase {
 	return &Usecase{
 		matlabManager: matlabManager,
+		pathValidator: pathValidator,
+
+		goos: runtime.GOOS,
 	}
 }
 
@@ -30,6 +82,14 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, re
 	sessionLogger.Debug("Entering StartMATLABSession Usecase")
 	defer sessionLogger.Debug("Exiting StartMATLABSession Usecase")
 
+	if localSessionDetails, ok := request.(entities.LocalSessionDetails); ok {
+		validatedRequest, err := u.validateLocalSessionDetails(localSessionDetails)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+		request = validatedRequest
+	}
+
 	sessionID, err := u.matlabManager.StartMATLABSession(ctx, sessionLogger, request)
 	if err != nil {
 		return ReturnArgs{}, err
@@ -61,3 +121,56 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, re
 		AddOnsOutput: AddOnsResponse.ConsoleOutput,
 	}, nil
 }
+
+func (u *Usecase) validateLocalSessionDetails(request entities.LocalSessionDetails) (entities.LocalSessionDetails, error) {
+	if request.IsStartingDirectorySet {
+		startingDirectory, err := u.pathValidator.ValidateFolderPath(request.StartingDirectory)
+		if err != nil {
+			return entities.LocalSessionDetails{}, err
+		}
+		request.StartingDirectory = startingDirectory
+	}
+
+	if request.StartupScript != "" {
+		startupScript, err := u.pathValidator.ValidateMATLABScript(request.StartupScript)
+		if err != nil {
+			return entities.LocalSessionDetails{}, err
+		}
+		request.StartupScript = startupScript
+	}
+
+	for name := range request.EnvironmentVariables {
+		if !environmentVariableNamePattern.MatchString(name) {
+			return entities.LocalSessionDetails{}, fmt.Errorf("%w: %q", ErrInvalidEnvironmentVariable, name)
+		}
+		if u.isReservedEnvironmentVariable(name) {
+			return entities.LocalSessionDetails{}, fmt.Errorf("%w: %s", ErrReservedEnvironmentVariable, name)
+		}
+	}
+
+	for _, flag := range request.AdditionalFlags {
+		if !slices.Contains(allowedMATLABFlags, flag) {
+			return entities.LocalSessionDetails{}, fmt.Errorf("%w: %q, allowed flags are %v", ErrUnsupportedMATLABFlag, flag, allowedMATLABFlags)
+		}
+	}
+
+	for _, flags := range conflictingMATLABFlags {
+		if slices.Contains(request.AdditionalFlags, flags[0]) && slices.Contains(request.AdditionalFlags, flags[1]) {
+			return entities.LocalSessionDetails{}, fmt.Errorf("%w: %q and %q", ErrConflictingMATLABFlags, flags[0], flags[1])
+		}
+	}
+
+	return request, nil
+}
+
+// isReservedEnvironmentVariable reports whether the server sets the environment variable.
+// Environment variable names are case-insensitive on Windows.
+func (u *Usecase) isReservedEnvironmentVariable(name string) bool {
+	if u.goos == "windows" {
+		return slices.ContainsFunc(reservedEnvironmentVariables, func(reservedName string) bool {
+			return strings.EqualFold(reservedName, name)
+		})
+	}
+
+	return slices.Contains(reservedEnvironmentVariables, name)
+}
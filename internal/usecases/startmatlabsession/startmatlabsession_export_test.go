@@ -0,0 +1,7 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package startmatlabsession
+
+func (u *Usecase) SetGOOS(goos string) {
+	u.goos = goos
+}
@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package startmatlabsession_test
 
@@ -10,6 +10,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/startmatlabsession"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -24,8 +25,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	// Act
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -38,6 +42,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -71,7 +78,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(entities.EvalResponse{ConsoleOutput: expectedAddOnsOutput}, nil).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
@@ -90,6 +97,9 @@ func TestUsecase_Execute_StartSessionError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	startSessionRequest := entities.LocalSessionDetails{
 		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
 	}
@@ -103,7 +113,7 @@ func TestUsecase_Execute_StartSessionError(t *testing.T) {
 		Return(sessionIDThatShouldBeUnused, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
@@ -121,6 +131,9 @@ func TestUsecase_Execute_GetMATLABSessionClientError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	startSessionRequest := entities.LocalSessionDetails{
 		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
 	}
@@ -139,7 +152,7 @@ func TestUsecase_Execute_GetMATLABSessionClientError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
@@ -157,6 +170,9 @@ func TestUsecase_Execute_VerEvalError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -183,7 +199,7 @@ func TestUsecase_Execute_VerEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
@@ -201,6 +217,9 @@ func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -234,7 +253,7 @@ func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
@@ -244,3 +263,242 @@ func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty when there's an error")
 	assert.ErrorIs(t, err, expectedError, "Error should be the original error")
 }
+
+func TestUsecase_Execute_ValidatesSessionOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const startingDirectory = "work"
+	const startupScript = "startup.m"
+	expectedStartingDirectory := filepath.Join("home", "user", "work")
+	expectedStartupScript := filepath.Join("home", "user", "startup.m")
+
+	startSessionRequest := entities.LocalSessionDetails{
+		MATLABRoot:             filepath.Join("path", "to", "matlab", "R2023a"),
+		IsStartingDirectorySet: true,
+		StartingDirectory:      startingDirectory,
+		StartupScript:          startupScript,
+		EnvironmentVariables:   map[string]string{"MY_VAR": "value"},
+		AdditionalFlags:        []string{"-softwareopengl", "-singleCompThread"},
+	}
+
+	expectedStartSessionRequest := startSessionRequest
+	expectedStartSessionRequest.StartingDirectory = expectedStartingDirectory
+	expectedStartSessionRequest.StartupScript = expectedStartupScript
+
+	ctx := t.Context()
+	const expectedSessionID = entities.SessionID(123)
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(startingDirectory).
+		Return(expectedStartingDirectory, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(startupScript).
+		Return(expectedStartupScript, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedStartSessionRequest).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: addOnsCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, response.SessionID)
+}
+
+func TestUsecase_Execute_InvalidStartingDirectory(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	const startingDirectory = "missing"
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(startingDirectory).
+		Return("", expectedError).
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, entities.LocalSessionDetails{
+		IsStartingDirectorySet: true,
+		StartingDirectory:      startingDirectory,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_InvalidStartupScript(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	const startupScript = "startup.txt"
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(startupScript).
+		Return("", expectedError).
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, entities.LocalSessionDetails{
+		StartupScript: startupScript,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_InvalidSessionOptions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		request       entities.LocalSessionDetails
+		expectedError error
+	}{
+		{
+			name:          "unsupported flag",
+			request:       entities.LocalSessionDetails{AdditionalFlags: []string{"-nodisplay"}},
+			expectedError: startmatlabsession.ErrUnsupportedMATLABFlag,
+		},
+		{
+			name:          "conflicting flags",
+			request:       entities.LocalSessionDetails{AdditionalFlags: []string{"-softwareopengl", "-nosoftwareopengl"}},
+			expectedError: startmatlabsession.ErrConflictingMATLABFlags,
+		},
+		{
+			name:          "invalid environment variable name",
+			request:       entities.LocalSessionDetails{EnvironmentVariables: map[string]string{"MY-VAR": "value"}},
+			expectedError: startmatlabsession.ErrInvalidEnvironmentVariable,
+		},
+		{
+			name:          "reserved environment variable",
+			request:       entities.LocalSessionDetails{EnvironmentVariables: map[string]string{"MWAPIKEY": "value"}},
+			expectedError: startmatlabsession.ErrReservedEnvironmentVariable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
+
+			// Act
+			response, err := usecase.Execute(t.Context(), mockLogger, testCase.request)
+
+			// Assert
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Empty(t, response)
+		})
+	}
+}
+
+func TestUsecase_Execute_ReservedEnvironmentVariableNameCase(t *testing.T) {
+	testCases := []struct {
+		name          string
+		goos          string
+		expectedError error
+	}{
+		{
+			name:          "windows names are case-insensitive",
+			goos:          "windows",
+			expectedError: startmatlabsession.ErrReservedEnvironmentVariable,
+		},
+		{
+			name: "linux names are case-sensitive",
+			goos: "linux",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			request := entities.LocalSessionDetails{EnvironmentVariables: map[string]string{"MwApiKey": "value"}}
+
+			if testCase.expectedError == nil {
+				mockMATLABManager.EXPECT().
+					StartMATLABSession(t.Context(), mockLogger.AsMockArg(), request).
+					Return(0, assert.AnError).
+					Once()
+			}
+
+			usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator)
+			usecase.SetGOOS(testCase.goos)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, request)
+
+			// Assert
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+			require.ErrorIs(t, err, assert.AnError, "the session should be started")
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package pathvalidator
 
@@ -68,6 +68,43 @@ func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
 	return absPath, nil
 }
 
+// ValidateMATLABCodePath accepts either a MATLAB .m file or a folder containing MATLAB code.
+func (v *PathValidator) ValidateMATLABCodePath(filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	resourceInfo, err := v.getResourceInfo(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	if !resourceInfo.IsDir() && !strings.HasSuffix(absPath, ".m") {
+		return "", fmt.Errorf("path must be a MATLAB .m file or a folder: %s", absPath)
+	}
+
+	return absPath, nil
+}
+
+func (v *PathValidator) ValidateFilePath(filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	fileInfo, err := v.getResourceInfo(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	if fileInfo.IsDir() {
+		return "", fmt.Errorf("path is not a file: %s", absPath)
+	}
+
+	return absPath, nil
+}
+
 func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, error) {
 	resourceInfo, err := v.osLayer.Stat(filePath)
 	if err != nil {
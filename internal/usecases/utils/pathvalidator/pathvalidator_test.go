@@ -343,3 +343,178 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 	// Assert
 	require.Error(t, err)
 }
+
+func TestValidator_ValidateMATLABCodePath_AcceptsMATLABFile(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("test.m")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	result, err := validator.ValidateMATLABCodePath(testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateMATLABCodePath_AcceptsFolder(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("./")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	// Act
+	result, err := validator.ValidateMATLABCodePath(testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateMATLABCodePath_RejectsOtherFiles(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("test.txt")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	_, err := validator.ValidateMATLABCodePath(testPath)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestValidator_ValidateMATLABCodePath_StatFails(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	testPath, absErr := filepath.Abs("test.m")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	// Act
+	_, err := validator.ValidateMATLABCodePath(testPath)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestValidator_ValidateFilePath_HappyPath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("codeAnalyzerConfiguration.json")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	result, err := validator.ValidateFilePath(testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateFilePath_FailsForFolder(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("./")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	// Act
+	_, err := validator.ValidateFilePath(testPath)
+
+	// Assert
+	require.Error(t, err)
+}
@@ -20,14 +20,17 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionmanager/matlabstartingdirselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionstate"
 	httpclient "github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/client"
 	httpserver "github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/codeanalyzer"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/matlabrootselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/testrunner"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/addonmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/addonmanager/installationsteps"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/healthmonitor"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	localmatlabsessiondirectory "github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
@@ -39,33 +42,52 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionreaper"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery/appdatadir"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/clientnotifier"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/extensionfilewatcher"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/rootpathresolver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/rootstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/sdk"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	checkmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/checkmatlabcode"
+	detectmatlabtoolboxesmultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/detectmatlabtoolboxes"
 	evalmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
+	fixmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/fixmatlabcode"
+	getmatlabsessionlogmultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/getmatlabsessionlog"
 	listavailablematlabstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessionstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	runmatlabfilemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabfile"
+	runmatlabtestfilemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabtestfile"
 	startmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	attachsharedmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
 	checkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/extensionfiles"
 	customloader "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/loader"
 	customvalidator "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/loader/validator"
 	detectmatlabtoolboxessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	fixmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+	getmatlabsessionlogsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	listsharedmatlabsessionstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
 	runmatlabfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/messagecatalog"
 	osadaptor "github.com/matlab/matlab-mcp-core-server/internal/adaptors/os"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/resourcelimit"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry/otel/instruments"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry/otel/meter/exporter"
@@ -78,13 +100,17 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/registryfacade"
 	unixfacade "github.com/matlab/matlab-mcp-core-server/internal/facades/unix"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/resourcelimit"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachsharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalcustomtool"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalcustomtool/functioncall"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsharedmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
@@ -212,6 +238,7 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(orchestrator.OSSignaler), new(*osadaptor.ProcessManager)),
 		wire.Bind(new(orchestrator.DirectoryFactory), new(*directory.Factory)),
 		wire.Bind(new(orchestrator.ResourceLimitManager), new(*resourcelimit.Manager)),
+		wire.Bind(new(orchestrator.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 
 		// MCP Server
 		server.New,
@@ -219,6 +246,18 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(server.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(server.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(server.MCPServerConfigurator), new(*configurator.Configurator)),
+		wire.Bind(new(server.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(server.HTTPServerFactory), new(*httpserver.Factory)),
+		wire.Bind(new(server.ExtensionFileWatcher), new(*extensionfilewatcher.Watcher)),
+
+		// Extension File Watcher
+		extensionfilewatcher.New,
+		wire.Bind(new(extensionfilewatcher.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(extensionfilewatcher.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(extensionfilewatcher.CustomToolLoader), new(*configurator.Configurator)),
+		wire.Bind(new(extensionfilewatcher.ExtensionFileFinder), new(*extensionfiles.Finder)),
+		wire.Bind(new(extensionfilewatcher.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(extensionfilewatcher.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
 		// RootStore
 		rootstore.New,
@@ -234,12 +273,17 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(sdk.RootStore), new(*rootstore.RootStore)),
 		wire.Bind(new(sdk.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(sdk.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(sdk.ClientNotifier), new(*clientnotifier.ClientNotifier)),
+
+		// Client Notifier
+		clientnotifier.New,
 
 		// MCP Server Configurator
 		configurator.New,
 		wire.Bind(new(configurator.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(configurator.ApplicationDefinition), new(ApplicationDefinition)),
 		wire.Bind(new(configurator.CustomToolFactory), new(*custom.Factory)),
+		wire.Bind(new(configurator.ExtensionFileFinder), new(*extensionfiles.Finder)),
 
 		// Tools
 		wire.Bind(new(basetool.LoggerFactory), new(*logger.Factory)),
@@ -254,12 +298,30 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(startmatlabsessiontool.Usecase), new(*startmatlabsession.Usecase)),
 
 		startmatlabsession.New,
+		wire.Bind(new(startmatlabsession.PathValidator), new(*pathvalidator.PathValidator)),
 
 		stopmatlabsessiontool.New,
 		wire.Bind(new(stopmatlabsessiontool.Usecase), new(*stopmatlabsession.Usecase)),
 
 		stopmatlabsession.New,
 
+		listmatlabsessionstool.New,
+		wire.Bind(new(listmatlabsessionstool.Usecase), new(*listmatlabsessions.Usecase)),
+
+		listmatlabsessions.New,
+		wire.Bind(new(listmatlabsessions.SessionLister), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(custom.MATLABManager), new(*matlabmanager.MATLABManager)),
+
+		getmatlabsessionlogmultisessiontool.New,
+		wire.Bind(new(getmatlabsessionlogmultisessiontool.Usecase), new(*getmatlabsessionlog.Usecase)),
+
+		getmatlabsessionlogsinglesessiontool.New,
+		wire.Bind(new(getmatlabsessionlogsinglesessiontool.Usecase), new(*getmatlabsessionlog.Usecase)),
+		wire.Bind(new(getmatlabsessionlogsinglesessiontool.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+
+		getmatlabsessionlog.New,
+		wire.Bind(new(getmatlabsessionlog.SessionLogReader), new(*matlabmanager.MATLABManager)),
+
 		evalmatlabcodemultisessiontool.New,
 		wire.Bind(new(evalmatlabcodemultisessiontool.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(evalmatlabcodemultisessiontool.Usecase), new(*evalmatlabcode.Usecase)),
@@ -271,6 +333,9 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		evalmatlabcode.New,
 		wire.Bind(new(evalmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
 
+		checkmatlabcodemultisessiontool.New,
+		wire.Bind(new(checkmatlabcodemultisessiontool.Usecase), new(*checkmatlabcode.Usecase)),
+
 		checkmatlabcodesinglesessiontool.New,
 		wire.Bind(new(checkmatlabcodesinglesessiontool.Usecase), new(*checkmatlabcode.Usecase)),
 
@@ -280,11 +345,29 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		codeanalyzer.New,
 
+		fixmatlabcodemultisessiontool.New,
+		wire.Bind(new(fixmatlabcodemultisessiontool.Usecase), new(*fixmatlabcode.Usecase)),
+
+		fixmatlabcodesinglesessiontool.New,
+		wire.Bind(new(fixmatlabcodesinglesessiontool.Usecase), new(*fixmatlabcode.Usecase)),
+
+		fixmatlabcode.New,
+		wire.Bind(new(fixmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(fixmatlabcode.CodeFixer), new(*codeanalyzer.Analyzer)),
+		wire.Bind(new(fixmatlabcode.OSLayer), new(*osfacade.OsFacade)),
+
+		detectmatlabtoolboxesmultisessiontool.New,
+		wire.Bind(new(detectmatlabtoolboxesmultisessiontool.Usecase), new(*detectmatlabtoolboxes.Usecase)),
+
 		detectmatlabtoolboxessinglesessiontool.New,
 		wire.Bind(new(detectmatlabtoolboxessinglesessiontool.Usecase), new(*detectmatlabtoolboxes.Usecase)),
 
 		detectmatlabtoolboxes.New,
 
+		runmatlabfilemultisessiontool.New,
+		wire.Bind(new(runmatlabfilemultisessiontool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(runmatlabfilemultisessiontool.Usecase), new(*runmatlabfile.Usecase)),
+
 		runmatlabfilesinglesessiontool.New,
 		wire.Bind(new(runmatlabfilesinglesessiontool.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(runmatlabfilesinglesessiontool.Usecase), new(*runmatlabfile.Usecase)),
@@ -292,17 +375,43 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		runmatlabfile.New,
 		wire.Bind(new(runmatlabfile.PathValidator), new(*pathvalidator.PathValidator)),
 
+		runmatlabtestfilemultisessiontool.New,
+		wire.Bind(new(runmatlabtestfilemultisessiontool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(runmatlabtestfilemultisessiontool.Usecase), new(*runmatlabtestfile.Usecase)),
+
 		runmatlabtestfilesinglesessiontool.New,
+		wire.Bind(new(runmatlabtestfilesinglesessiontool.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(runmatlabtestfilesinglesessiontool.Usecase), new(*runmatlabtestfile.Usecase)),
 
 		runmatlabtestfile.New,
 		wire.Bind(new(runmatlabtestfile.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabtestfile.TestRunner), new(*testrunner.Runner)),
+
+		testrunner.New,
+
+		listsharedmatlabsessionstool.New,
+		wire.Bind(new(listsharedmatlabsessionstool.Usecase), new(*listsharedmatlabsessions.Usecase)),
+
+		listsharedmatlabsessions.New,
+		wire.Bind(new(listsharedmatlabsessions.SharedSessionLister), new(*matlabmanager.MATLABManager)),
+
+		attachsharedmatlabsessiontool.New,
+		wire.Bind(new(attachsharedmatlabsessiontool.Usecase), new(*attachsharedmatlabsession.Usecase)),
+
+		attachsharedmatlabsession.New,
+		wire.Bind(new(attachsharedmatlabsession.SharedSessionLister), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(attachsharedmatlabsession.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 
 		// Custom Tool Factory
 		custom.NewFactory,
 		wire.Bind(new(custom.Loader), new(*customloader.Loader)),
 		wire.Bind(new(custom.ConfigFactory), new(*config.Factory)),
 
+		// Extension File Finder
+		extensionfiles.New,
+		wire.Bind(new(extensionfiles.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(extensionfiles.OSLayer), new(*osfacade.OsFacade)),
+
 		// Custom Tool Loader
 		customloader.NewLoader,
 		wire.Bind(new(customloader.OSLayer), new(*osfacade.OsFacade)),
@@ -321,6 +430,8 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		codingguidelines.New,
 		plaintextlivecodegeneration.New,
+		matlabsessionlog.New,
+		wire.Bind(new(matlabsessionlog.Usecase), new(*getmatlabsessionlog.Usecase)),
 
 		// Watchdog Client
 		watchdogclient.New,
@@ -345,6 +456,14 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		// Global MATLAB
 		globalmatlab.New,
 		wire.Bind(new(globalmatlab.MATLABManagerAdaptor), new(*sessionmanager.SessionManager)),
+		wire.Bind(new(globalmatlab.SessionStateRecorder), new(*sessionstate.Recorder)),
+
+		// Session State Recorder
+		sessionstate.New,
+		wire.Bind(new(sessionstate.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(sessionstate.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(sessionstate.ApplicationDirectoryFactory), new(*directory.Factory)),
+		wire.Bind(new(sessionstate.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
 		// Session Manager
 		sessionmanager.New,
@@ -376,11 +495,52 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(matlabmanager.MATLABSessionStore), new(*matlabsessionstore.Store)),
 		wire.Bind(new(matlabmanager.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
 		wire.Bind(new(matlabmanager.SessionSelector), new(*sessionselector.SessionSelector)),
+		wire.Bind(new(matlabmanager.SessionReaper), new(*sessionreaper.Reaper)),
+		wire.Bind(new(matlabmanager.SessionPool), new(*sessionpool.Pool)),
+		wire.Bind(new(matlabmanager.SessionLogReader), new(*sessionlog.Reader)),
+
+		// Session Log Reader
+		sessionlog.New,
+		wire.Bind(new(sessionlog.OSLayer), new(*osfacade.OsFacade)),
+
+		// Session Log Keeper
+		sessionlog.NewKeeper,
+		wire.Bind(new(sessionlog.ApplicationDirectoryFactory), new(*directory.Factory)),
+
+		// Session Pool
+		sessionpool.New,
+		wire.Bind(new(sessionpool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(sessionpool.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(sessionpool.MATLABServices), new(*matlabservices.MATLABServices)),
+		wire.Bind(new(sessionpool.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
+		wire.Bind(new(sessionpool.SessionStore), new(*matlabsessionstore.Store)),
+		wire.Bind(new(sessionpool.HealthMonitor), new(*healthmonitor.Monitor)),
+		wire.Bind(new(sessionpool.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		// Health Monitor
+		healthmonitor.New,
+		wire.Bind(new(healthmonitor.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(healthmonitor.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(healthmonitor.SessionStore), new(*matlabsessionstore.Store)),
+		wire.Bind(new(healthmonitor.SessionLogKeeper), new(*sessionlog.Keeper)),
+		wire.Bind(new(healthmonitor.ClientNotifier), new(*clientnotifier.ClientNotifier)),
+		wire.Bind(new(healthmonitor.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(healthmonitor.ProcessFinder), new(*osadaptor.ProcessManager)),
+		wire.Bind(new(healthmonitor.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		// Session Reaper
+		sessionreaper.New,
+		wire.Bind(new(sessionreaper.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(sessionreaper.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(sessionreaper.SessionStore), new(*matlabsessionstore.Store)),
+		wire.Bind(new(sessionreaper.SessionPool), new(*sessionpool.Pool)),
+		wire.Bind(new(sessionreaper.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
 		// Session Selector
 		sessionselector.New,
 		wire.Bind(new(sessionselector.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(sessionselector.SessionDiscoverer), new(*sessiondiscovery.SessionDiscoverer)),
+		wire.Bind(new(sessionselector.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
 
 		// MATLAB Services
 		matlabservices.New,
@@ -408,6 +568,8 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(localmatlabsession.ProcessDetails), new(*processdetails.ProcessDetails)),
 		wire.Bind(new(localmatlabsession.MATLABProcessLauncher), new(*processlauncher.MATLABProcessLauncher)),
 		wire.Bind(new(localmatlabsession.Watchdog), new(*watchdogclient.Watchdog)),
+		wire.Bind(new(localmatlabsession.HealthMonitor), new(*healthmonitor.Monitor)),
+		wire.Bind(new(localmatlabsession.SessionLogKeeper), new(*sessionlog.Keeper)),
 
 		// Local MATLAB Session Directory
 		localmatlabsessiondirectory.NewFactory,
@@ -434,11 +596,13 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		// MATLAB Session Client Factory
 		matlabsessionclient.NewFactory,
 		wire.Bind(new(matlabsessionclient.HttpClientFactory), new(*httpclient.Factory)),
+		wire.Bind(new(matlabsessionclient.OSLayer), new(*osfacade.OsFacade)),
 
 		// Session Discovery
 		sessiondiscovery.New,
 		wire.Bind(new(sessiondiscovery.AppDataDirGetter), new(*appdatadir.Getter)),
 		wire.Bind(new(sessiondiscovery.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(sessiondiscovery.ProcessFinder), new(*osadaptor.ProcessManager)),
 
 		// App Data Dir
 		appdatadir.New,
@@ -21,14 +21,17 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionmanager/matlabstartingdirselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/sessionstate"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/client"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/codeanalyzer"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/matlabrootselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlab/testrunner"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/addonmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/addonmanager/installationsteps"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/healthmonitor"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	directory2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
@@ -40,29 +43,47 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionreaper"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery/appdatadir"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	server3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/clientnotifier"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/extensionfilewatcher"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/rootpathresolver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/rootstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/sdk"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	checkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/checkmatlabcode"
+	detectmatlabtoolboxes2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/detectmatlabtoolboxes"
 	evalmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
+	fixmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/fixmatlabcode"
+	getmatlabsessionlog2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/getmatlabsessionlog"
 	listavailablematlabs2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessions2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	runmatlabfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabfile"
+	runmatlabtestfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/runmatlabtestfile"
 	startmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
-	checkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	attachsharedmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/attachsharedmatlabsession"
+	checkmatlabcode3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/extensionfiles"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/loader"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/custom/loader/validator"
-	detectmatlabtoolboxes2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
+	detectmatlabtoolboxes3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcode3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
-	runmatlabfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
-	runmatlabtestfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	fixmatlabcode3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/fixmatlabcode"
+	getmatlabsessionlog3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabsessionlog"
+	listsharedmatlabsessions2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsharedmatlabsessions"
+	runmatlabfile3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	runmatlabtestfile3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/messagecatalog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/os"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/resourcelimit"
@@ -78,12 +99,17 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/registryfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/unix"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachsharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalcustomtool"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalcustomtool/functioncall"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/fixmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabsessionlog"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsharedmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
@@ -137,51 +163,78 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 	clientFactory := client.NewFactory()
 	factory4 := client2.NewFactory(osFacade, loggerFactory, clientFactory)
 	watchdog3 := watchdog2.New(processFactory, factory4, loggerFactory, socketFactory)
-	starter := localmatlabsession.NewStarter(factory3, processDetails, matlabProcessLauncher, watchdog3)
-	matlabServices := matlabservices.New(matlabLocator, starter)
 	store := matlabsessionstore.New(loggerFactory, lifecycleSignaler)
-	matlabsessionclientFactory := matlabsessionclient.NewFactory(clientFactory)
+	keeper := sessionlog.NewKeeper(directoryFactory, osFacade)
+	clientNotifier := clientnotifier.New()
+	monitor := healthmonitor.New(factory, loggerFactory, store, keeper, clientNotifier, osFacade, processManager, lifecycleSignaler)
+	starter := localmatlabsession.NewStarter(factory3, processDetails, matlabProcessLauncher, watchdog3, monitor, keeper)
+	matlabServices := matlabservices.New(matlabLocator, starter)
+	matlabsessionclientFactory := matlabsessionclient.NewFactory(clientFactory, osFacade)
 	appdatadirGetter := appdatadir.New(osFacade)
-	sessionDiscoverer := sessiondiscovery.New(appdatadirGetter, osFacade)
-	sessionSelector := sessionselector.New(factory, sessionDiscoverer)
-	matlabManager := matlabmanager.New(factory, matlabServices, store, matlabsessionclientFactory, sessionSelector)
+	sessionDiscoverer := sessiondiscovery.New(appdatadirGetter, osFacade, processManager)
+	sessionSelector := sessionselector.New(factory, sessionDiscoverer, matlabsessionclientFactory)
+	pool := sessionpool.New(factory, loggerFactory, matlabServices, matlabsessionclientFactory, store, monitor, lifecycleSignaler)
+	reaper := sessionreaper.New(factory, loggerFactory, store, pool, lifecycleSignaler)
+	reader := sessionlog.New(osFacade)
+	matlabManager := matlabmanager.New(factory, matlabServices, store, matlabsessionclientFactory, sessionSelector, reaper, pool, reader)
 	matlabRootSelector := matlabrootselector.New(factory, matlabManager)
 	rootPathResolver := rootpathresolver.New(osFacade)
 	matlabStartingDirSelector := matlabstartingdirselector.New(factory, osFacade, rootStore, rootPathResolver)
 	sessionManager := sessionmanager.New(matlabManager, factory, matlabRootSelector, matlabStartingDirSelector)
-	globalMATLAB := globalmatlab.New(sessionManager)
-	sdkFactory := sdk.NewFactory(factory, serverDefinition, rootStore, loggerFactory, globalMATLAB)
+	recorder := sessionstate.New(factory, loggerFactory, directoryFactory, lifecycleSignaler)
+	globalMATLAB := globalmatlab.New(sessionManager, recorder)
+	sdkFactory := sdk.NewFactory(factory, serverDefinition, rootStore, loggerFactory, globalMATLAB, clientNotifier)
 	usecase := listavailablematlabs.New(matlabManager)
 	tool := listavailablematlabs2.New(loggerFactory, usecase)
-	startmatlabsessionUsecase := startmatlabsession.New(matlabManager)
+	pathValidator := pathvalidator.New(osFacade)
+	startmatlabsessionUsecase := startmatlabsession.New(matlabManager, pathValidator)
 	startmatlabsessionTool := startmatlabsession2.New(loggerFactory, factory, startmatlabsessionUsecase)
 	stopmatlabsessionUsecase := stopmatlabsession.New(matlabManager)
 	stopmatlabsessionTool := stopmatlabsession2.New(loggerFactory, stopmatlabsessionUsecase)
-	pathValidator := pathvalidator.New(osFacade)
+	listmatlabsessionsUsecase := listmatlabsessions.New(matlabManager)
+	listmatlabsessionsTool := listmatlabsessions2.New(loggerFactory, listmatlabsessionsUsecase)
+	getmatlabsessionlogUsecase := getmatlabsessionlog.New(matlabManager)
+	getmatlabsessionlogTool := getmatlabsessionlog2.New(loggerFactory, getmatlabsessionlogUsecase)
 	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator)
 	evalmatlabcodeTool := evalmatlabcode2.New(loggerFactory, factory, evalmatlabcodeUsecase, matlabManager)
-	tool2 := evalmatlabcode3.New(loggerFactory, factory, evalmatlabcodeUsecase, globalMATLAB)
 	analyzer := codeanalyzer.New()
 	checkmatlabcodeUsecase := checkmatlabcode.New(pathValidator, analyzer)
-	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, checkmatlabcodeUsecase, globalMATLAB)
+	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, checkmatlabcodeUsecase, matlabManager)
+	fixmatlabcodeUsecase := fixmatlabcode.New(pathValidator, analyzer, osFacade)
+	fixmatlabcodeTool := fixmatlabcode2.New(loggerFactory, fixmatlabcodeUsecase, matlabManager)
 	detectmatlabtoolboxesUsecase := detectmatlabtoolboxes.New()
-	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(loggerFactory, detectmatlabtoolboxesUsecase, globalMATLAB)
+	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(loggerFactory, detectmatlabtoolboxesUsecase, matlabManager)
 	runmatlabfileUsecase := runmatlabfile.New(pathValidator)
-	runmatlabfileTool := runmatlabfile2.New(loggerFactory, factory, runmatlabfileUsecase, globalMATLAB)
-	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator)
-	runmatlabtestfileTool := runmatlabtestfile2.New(loggerFactory, runmatlabtestfileUsecase, globalMATLAB)
+	runmatlabfileTool := runmatlabfile2.New(loggerFactory, factory, runmatlabfileUsecase, matlabManager)
+	runner := testrunner.New()
+	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator, runner)
+	runmatlabtestfileTool := runmatlabtestfile2.New(loggerFactory, factory, runmatlabtestfileUsecase, matlabManager)
+	tool2 := evalmatlabcode3.New(loggerFactory, factory, evalmatlabcodeUsecase, globalMATLAB)
+	tool3 := checkmatlabcode3.New(loggerFactory, checkmatlabcodeUsecase, globalMATLAB)
+	tool4 := fixmatlabcode3.New(loggerFactory, fixmatlabcodeUsecase, globalMATLAB)
+	tool5 := detectmatlabtoolboxes3.New(loggerFactory, detectmatlabtoolboxesUsecase, globalMATLAB)
+	tool6 := runmatlabfile3.New(loggerFactory, factory, runmatlabfileUsecase, globalMATLAB)
+	tool7 := runmatlabtestfile3.New(loggerFactory, factory, runmatlabtestfileUsecase, globalMATLAB)
+	tool8 := getmatlabsessionlog3.New(loggerFactory, getmatlabsessionlogUsecase, globalMATLAB)
+	listsharedmatlabsessionsUsecase := listsharedmatlabsessions.New(matlabManager)
+	listsharedmatlabsessionsTool := listsharedmatlabsessions2.New(loggerFactory, listsharedmatlabsessionsUsecase)
+	attachsharedmatlabsessionUsecase := attachsharedmatlabsession.New(matlabManager, globalMATLAB)
+	attachsharedmatlabsessionTool := attachsharedmatlabsession2.New(loggerFactory, attachsharedmatlabsessionUsecase)
 	resource := codingguidelines.New(loggerFactory)
 	plaintextlivecodegenerationResource := plaintextlivecodegeneration.New(loggerFactory)
+	matlabsessionlogResource := matlabsessionlog.New(loggerFactory, getmatlabsessionlogUsecase)
 	validatorValidator := validator.NewValidator()
 	loaderLoader := loader.NewLoader(osFacade, loggerFactory, validatorValidator)
 	assembler := functioncall.NewAssembler()
 	evalcustomtoolUsecase := evalcustomtool.New(assembler)
-	customFactory := custom.NewFactory(loaderLoader, loggerFactory, evalcustomtoolUsecase, globalMATLAB, factory)
-	configuratorConfigurator := configurator.New(factory, serverDefinition, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, resource, plaintextlivecodegenerationResource, customFactory)
-	serverServer := server3.New(sdkFactory, loggerFactory, lifecycleSignaler, configuratorConfigurator)
+	customFactory := custom.NewFactory(loaderLoader, loggerFactory, evalcustomtoolUsecase, globalMATLAB, matlabManager, factory)
+	finder := extensionfiles.New(factory, osFacade)
+	configuratorConfigurator := configurator.New(factory, serverDefinition, tool, startmatlabsessionTool, stopmatlabsessionTool, listmatlabsessionsTool, getmatlabsessionlogTool, evalmatlabcodeTool, checkmatlabcodeTool, fixmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, tool2, tool3, tool4, tool5, tool6, tool7, tool8, listsharedmatlabsessionsTool, attachsharedmatlabsessionTool, resource, plaintextlivecodegenerationResource, matlabsessionlogResource, customFactory, finder)
+	watcher := extensionfilewatcher.New(factory, loggerFactory, configuratorConfigurator, finder, osFacade, lifecycleSignaler)
+	serverServer := server3.New(sdkFactory, loggerFactory, lifecycleSignaler, configuratorConfigurator, factory, serverFactory, watcher)
 	unixFacade := unix.New()
 	manager := resourcelimit.New(loggerFactory, unixFacade)
-	orchestratorOrchestrator := orchestrator.New(messageCatalog, lifecycleSignaler, serverDefinition, factory, serverServer, watchdog3, loggerFactory, processManager, directoryFactory, manager)
+	orchestratorOrchestrator := orchestrator.New(messageCatalog, lifecycleSignaler, serverDefinition, factory, serverServer, watchdog3, loggerFactory, processManager, directoryFactory, manager, globalMATLAB)
 	installationSteps := installationsteps.New()
 	addonManager := addonmanager.New(installationSteps)
 	mode := setupmatlab.New(osFacade, messageCatalog, loggerFactory, directoryFactory, watchdog3, globalMATLAB, addonManager)
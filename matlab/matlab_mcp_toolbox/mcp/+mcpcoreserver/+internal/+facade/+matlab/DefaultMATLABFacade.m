@@ -7,6 +7,18 @@ classdef DefaultMATLABFacade < mcpcoreserver.internal.facade.matlab.MATLABFacade
         function tf = isMATLABReleaseOlderThan(~, release)
             tf = isMATLABReleaseOlderThan(release);
         end
+
+        function t = posixTimeNow(~)
+            t = posixtime(datetime("now", TimeZone="UTC"));
+        end
+
+        function r = release(~)
+            r = matlabRelease().Release;
+        end
+
+        function f = currentFolder(~)
+            f = string(pwd);
+        end
     end
 
 end
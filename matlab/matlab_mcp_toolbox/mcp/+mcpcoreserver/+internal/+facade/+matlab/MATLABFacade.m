@@ -1,12 +1,15 @@
 classdef (Abstract) MATLABFacade
-    %MATLABFacade Abstract facade for MATLAB version detection
+    %MATLABFacade Abstract facade for MATLAB built-ins
     %   This abstract class defines the interface for MATLAB version
-    %   detection operations.
+    %   detection, clock and current folder operations.
 
     % Copyright 2026 The MathWorks, Inc.
 
     methods (Abstract)
         tf = isMATLABReleaseOlderThan(obj, release)
+        t = posixTimeNow(obj)
+        r = release(obj)
+        f = currentFolder(obj)
     end
 
 end
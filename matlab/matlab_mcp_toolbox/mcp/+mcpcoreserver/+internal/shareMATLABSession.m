@@ -1,16 +1,20 @@
 function shareMATLABSession(options)
     %shareMATLABSession Share the current MATLAB session via MCP server
     %   This function enables sharing of the MATLAB session through the
-    %   Model Context Protocol (MCP) server.
+    %   Model Context Protocol (MCP) server. Each shared session writes its
+    %   own details file, named after the MATLAB process ID, so that the
+    %   server can discover every shared session and choose between them.
 
     % Copyright 2026 The MathWorks, Inc.
 
     arguments
+        options.Name(1, 1) string = ""
         options.AppDataLocator(1, 1) mcpcoreserver.internal.appdata.AppDataLocator = mcpcoreserver.internal.appdata.DefaultAppDataLocator()
         options.FSAdaptor(1, 1) mcpcoreserver.internal.fs.FSAdaptor = mcpcoreserver.internal.fs.DefaultFSAdaptor()
         options.FSFacade(1, 1) mcpcoreserver.internal.facade.fs.FSFacade = mcpcoreserver.internal.facade.fs.DefaultFSFacade()
         options.ConnectorAdaptor(1, 1) mcpcoreserver.internal.connector.ConnectorAdaptor = mcpcoreserver.internal.connector.DefaultConnectorAdaptor()
         options.ConnectorFacade(1, 1) mcpcoreserver.internal.facade.connector.ConnectorFacade = mcpcoreserver.internal.facade.connector.DefaultConnectorFacade()
+        options.MATLABFacade(1, 1) mcpcoreserver.internal.facade.matlab.MATLABFacade = mcpcoreserver.internal.facade.matlab.DefaultMATLABFacade()
     end
 
     options.ConnectorFacade.ensureServiceOn()
@@ -21,10 +25,23 @@ function shareMATLABSession(options)
     v1Folder = fullfile(appDataFolder, "v1");
     options.FSAdaptor.ensureSecureFolder(v1Folder);
 
-    sessionDetailsPath = fullfile(v1Folder, "sessionDetails.json");
-    options.FSAdaptor.ensureSecureFile(sessionDetailsPath);
+    sessionsFolder = fullfile(v1Folder, "sessions");
+    options.FSAdaptor.ensureSecureFolder(sessionsFolder);
 
     sessionDetails = options.ConnectorAdaptor.getConnectionDetails();
+    sessionDetails.name = options.Name;
+    sessionDetails.sharedAt = options.MATLABFacade.posixTimeNow();
+    sessionDetails.release = options.MATLABFacade.release();
+    sessionDetails.workingFolder = options.MATLABFacade.currentFolder();
     jsonText = jsonencode(sessionDetails, PrettyPrint=true);
+
+    % Servers that predate per-session files only read sessionDetails.json,
+    % so keep it pointing at the most recently shared session.
+    sessionDetailsPath = fullfile(v1Folder, "sessionDetails.json");
+    options.FSAdaptor.ensureSecureFile(sessionDetailsPath);
     options.FSFacade.writelines(jsonText, sessionDetailsPath);
+
+    perSessionDetailsPath = fullfile(sessionsFolder, string(sessionDetails.pid) + ".json");
+    options.FSAdaptor.ensureSecureFile(perSessionDetailsPath);
+    options.FSFacade.writelines(jsonText, perSessionDetailsPath);
 end
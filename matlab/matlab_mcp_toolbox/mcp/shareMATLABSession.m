@@ -1,9 +1,17 @@
-function shareMATLABSession()
+function shareMATLABSession(options)
     %shareMATLABSession Share the current MATLAB session via MCP server
     %   This function enables sharing of the MATLAB session through the
     %   Model Context Protocol (MCP) server.
+    %
+    %   shareMATLABSession(Name=name) also gives the shared session a name,
+    %   which you can pass to the server with --matlab-session-selector to
+    %   choose this session when several MATLAB sessions are shared.
 
     % Copyright 2026 The MathWorks, Inc.
 
-    mcpcoreserver.internal.shareMATLABSession();
+    arguments
+        options.Name(1, 1) string = ""
+    end
+
+    mcpcoreserver.internal.shareMATLABSession(Name=options.Name);
 end
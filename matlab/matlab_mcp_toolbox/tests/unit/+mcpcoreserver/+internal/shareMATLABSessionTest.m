@@ -31,20 +31,35 @@ classdef shareMATLABSessionTest < matlab.mock.TestCase
                 ?mcpcoreserver.internal.facade.connector.ConnectorFacade, ...
                 Strict=true ...
             );
+            [mockMATLABFacade, matlabFacadeBehavior] = testCase.createMock( ...
+                ?mcpcoreserver.internal.facade.matlab.MATLABFacade, ...
+                Strict=true ...
+            );
 
             expectedAppDataFolder = fullfile("home", "user", ".MathWorks", "MATLABMCPCoreServer");
             expectedV1Folder = fullfile(expectedAppDataFolder, "v1");
+            expectedSessionsFolder = fullfile(expectedV1Folder, "sessions");
             expectedSessionDetailsPath = fullfile(expectedV1Folder, "sessionDetails.json");
+            expectedPerSessionDetailsPath = fullfile(expectedSessionsFolder, "12345.json");
+            expectedName = "analysis";
+            expectedSharedAt = 1767225600;
+            expectedRelease = "R2026a";
+            expectedWorkingFolder = fullfile("home", "user", "work");
             expectedPort = 31415;
             expectedCert = fullfile("home", "user", ".matlab", "connector.pem");
             expectedApiKey = "test-api-key";
             expectedPid = 12345;
-            expectedSessionDetails = struct( ...
+            connectionDetails = struct( ...
                 "port", expectedPort, ...
                 "certificate", expectedCert, ...
                 "apiKey", expectedApiKey, ...
                 "pid", expectedPid ...
             );
+            expectedSessionDetails = connectionDetails;
+            expectedSessionDetails.name = expectedName;
+            expectedSessionDetails.sharedAt = expectedSharedAt;
+            expectedSessionDetails.release = expectedRelease;
+            expectedSessionDetails.workingFolder = expectedWorkingFolder;
             expectedJson = jsonencode(expectedSessionDetails, PrettyPrint=true);
 
             when( ...
@@ -63,9 +78,25 @@ classdef shareMATLABSessionTest < matlab.mock.TestCase
                 FSAdaptorBehavior.ensureSecureFolder(expectedV1Folder), ...
                 DoNothing ...
             );
+            when( ...
+                FSAdaptorBehavior.ensureSecureFolder(expectedSessionsFolder), ...
+                DoNothing ...
+            );
             when( ...
                 connectorBehavior.getConnectionDetails().withAnyInputs(), ...
-                AssignOutputs(expectedSessionDetails) ...
+                AssignOutputs(connectionDetails) ...
+            );
+            when( ...
+                matlabFacadeBehavior.posixTimeNow().withExactInputs(), ...
+                AssignOutputs(expectedSharedAt) ...
+            );
+            when( ...
+                matlabFacadeBehavior.release().withExactInputs(), ...
+                AssignOutputs(expectedRelease) ...
+            );
+            when( ...
+                matlabFacadeBehavior.currentFolder().withExactInputs(), ...
+                AssignOutputs(expectedWorkingFolder) ...
             );
             when( ...
                 FSAdaptorBehavior.ensureSecureFile(expectedSessionDetailsPath), ...
@@ -75,14 +106,24 @@ classdef shareMATLABSessionTest < matlab.mock.TestCase
                 FSFacadeBehavior.writelines(expectedJson, expectedSessionDetailsPath), ...
                 DoNothing ...
             );
+            when( ...
+                FSAdaptorBehavior.ensureSecureFile(expectedPerSessionDetailsPath), ...
+                DoNothing ...
+            );
+            when( ...
+                FSFacadeBehavior.writelines(expectedJson, expectedPerSessionDetailsPath), ...
+                DoNothing ...
+            );
 
             % Act
             mcpcoreserver.internal.shareMATLABSession( ...
+                Name=expectedName, ...
                 AppDataLocator=mockAppData, ...
                 FSAdaptor=mockFSAdaptor, ...
                 FSFacade=mockFSFacade, ...
                 ConnectorAdaptor=mockConnector, ...
-                ConnectorFacade=mockConnectorFacade ...
+                ConnectorFacade=mockConnectorFacade, ...
+                MATLABFacade=mockMATLABFacade ...
             );
 
             % Assert
@@ -106,6 +147,18 @@ classdef shareMATLABSessionTest < matlab.mock.TestCase
                 FSFacadeBehavior.writelines(expectedJson, expectedSessionDetailsPath), ...
                 "writelines should be called to write JSON content" ...
             );
+            testCase.verifyCalled( ...
+                FSAdaptorBehavior.ensureSecureFolder(expectedSessionsFolder), ...
+                "ensureSecureFolder should be called with the sessions folder path" ...
+            );
+            testCase.verifyCalled( ...
+                FSAdaptorBehavior.ensureSecureFile(expectedPerSessionDetailsPath), ...
+                "ensureSecureFile should be called for the per-session details file" ...
+            );
+            testCase.verifyCalled( ...
+                FSFacadeBehavior.writelines(expectedJson, expectedPerSessionDetailsPath), ...
+                "writelines should be called to write the per-session details file" ...
+            );
         end
     end
 
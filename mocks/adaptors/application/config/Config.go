@@ -127,6 +127,50 @@ func (_c *MockConfig_BaseDir_Call) RunAndReturn(run func() string) *MockConfig_B
 	return _c
 }
 
+// DefaultEvalTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) DefaultEvalTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DefaultEvalTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_DefaultEvalTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultEvalTimeout'
+type MockConfig_DefaultEvalTimeout_Call struct {
+	*mock.Call
+}
+
+// DefaultEvalTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DefaultEvalTimeout() *MockConfig_DefaultEvalTimeout_Call {
+	return &MockConfig_DefaultEvalTimeout_Call{Call: _e.mock.On("DefaultEvalTimeout")}
+}
+
+func (_c *MockConfig_DefaultEvalTimeout_Call) Run(run func()) *MockConfig_DefaultEvalTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DefaultEvalTimeout_Call) Return(duration time.Duration) *MockConfig_DefaultEvalTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_DefaultEvalTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_DefaultEvalTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisableTelemetry provides a mock function for the type MockConfig
 func (_mock *MockConfig) DisableTelemetry() bool {
 	ret := _mock.Called()
@@ -259,46 +303,138 @@ func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) RunAndReturn(run func
 	return _c
 }
 
-// ExtensionFile provides a mock function for the type MockConfig
-func (_mock *MockConfig) ExtensionFile() string {
+// EvictIdleMATLABSessions provides a mock function for the type MockConfig
+func (_mock *MockConfig) EvictIdleMATLABSessions() bool {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExtensionFile")
+		panic("no return value specified for EvictIdleMATLABSessions")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(bool)
 	}
 	return r0
 }
 
-// MockConfig_ExtensionFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtensionFile'
-type MockConfig_ExtensionFile_Call struct {
+// MockConfig_EvictIdleMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvictIdleMATLABSessions'
+type MockConfig_EvictIdleMATLABSessions_Call struct {
 	*mock.Call
 }
 
-// ExtensionFile is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) ExtensionFile() *MockConfig_ExtensionFile_Call {
-	return &MockConfig_ExtensionFile_Call{Call: _e.mock.On("ExtensionFile")}
+// EvictIdleMATLABSessions is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) EvictIdleMATLABSessions() *MockConfig_EvictIdleMATLABSessions_Call {
+	return &MockConfig_EvictIdleMATLABSessions_Call{Call: _e.mock.On("EvictIdleMATLABSessions")}
 }
 
-func (_c *MockConfig_ExtensionFile_Call) Run(run func()) *MockConfig_ExtensionFile_Call {
+func (_c *MockConfig_EvictIdleMATLABSessions_Call) Run(run func()) *MockConfig_EvictIdleMATLABSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_ExtensionFile_Call) Return(s string) *MockConfig_ExtensionFile_Call {
-	_c.Call.Return(s)
+func (_c *MockConfig_EvictIdleMATLABSessions_Call) Return(b bool) *MockConfig_EvictIdleMATLABSessions_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_EvictIdleMATLABSessions_Call) RunAndReturn(run func() bool) *MockConfig_EvictIdleMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtensionDirs provides a mock function for the type MockConfig
+func (_mock *MockConfig) ExtensionDirs() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtensionDirs")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_ExtensionDirs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtensionDirs'
+type MockConfig_ExtensionDirs_Call struct {
+	*mock.Call
+}
+
+// ExtensionDirs is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ExtensionDirs() *MockConfig_ExtensionDirs_Call {
+	return &MockConfig_ExtensionDirs_Call{Call: _e.mock.On("ExtensionDirs")}
+}
+
+func (_c *MockConfig_ExtensionDirs_Call) Run(run func()) *MockConfig_ExtensionDirs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ExtensionDirs_Call) Return(strings []string) *MockConfig_ExtensionDirs_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_ExtensionDirs_Call) RunAndReturn(run func() []string) *MockConfig_ExtensionDirs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtensionFiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) ExtensionFiles() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtensionFiles")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_ExtensionFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtensionFiles'
+type MockConfig_ExtensionFiles_Call struct {
+	*mock.Call
+}
+
+// ExtensionFiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ExtensionFiles() *MockConfig_ExtensionFiles_Call {
+	return &MockConfig_ExtensionFiles_Call{Call: _e.mock.On("ExtensionFiles")}
+}
+
+func (_c *MockConfig_ExtensionFiles_Call) Run(run func()) *MockConfig_ExtensionFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ExtensionFiles_Call) Return(strings []string) *MockConfig_ExtensionFiles_Call {
+	_c.Call.Return(strings)
 	return _c
 }
 
-func (_c *MockConfig_ExtensionFile_Call) RunAndReturn(run func() string) *MockConfig_ExtensionFile_Call {
+func (_c *MockConfig_ExtensionFiles_Call) RunAndReturn(run func() []string) *MockConfig_ExtensionFiles_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -367,6 +503,182 @@ func (_c *MockConfig_Get_Call) RunAndReturn(run func(key string) (any, messages.
 	return _c
 }
 
+// HTTPAuthToken provides a mock function for the type MockConfig
+func (_mock *MockConfig) HTTPAuthToken() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPAuthToken")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_HTTPAuthToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPAuthToken'
+type MockConfig_HTTPAuthToken_Call struct {
+	*mock.Call
+}
+
+// HTTPAuthToken is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) HTTPAuthToken() *MockConfig_HTTPAuthToken_Call {
+	return &MockConfig_HTTPAuthToken_Call{Call: _e.mock.On("HTTPAuthToken")}
+}
+
+func (_c *MockConfig_HTTPAuthToken_Call) Run(run func()) *MockConfig_HTTPAuthToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_HTTPAuthToken_Call) Return(s string) *MockConfig_HTTPAuthToken_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_HTTPAuthToken_Call) RunAndReturn(run func() string) *MockConfig_HTTPAuthToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPListenAddress provides a mock function for the type MockConfig
+func (_mock *MockConfig) HTTPListenAddress() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPListenAddress")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_HTTPListenAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPListenAddress'
+type MockConfig_HTTPListenAddress_Call struct {
+	*mock.Call
+}
+
+// HTTPListenAddress is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) HTTPListenAddress() *MockConfig_HTTPListenAddress_Call {
+	return &MockConfig_HTTPListenAddress_Call{Call: _e.mock.On("HTTPListenAddress")}
+}
+
+func (_c *MockConfig_HTTPListenAddress_Call) Run(run func()) *MockConfig_HTTPListenAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_HTTPListenAddress_Call) Return(s string) *MockConfig_HTTPListenAddress_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_HTTPListenAddress_Call) RunAndReturn(run func() string) *MockConfig_HTTPListenAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPTLSCertFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) HTTPTLSCertFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPTLSCertFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_HTTPTLSCertFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPTLSCertFile'
+type MockConfig_HTTPTLSCertFile_Call struct {
+	*mock.Call
+}
+
+// HTTPTLSCertFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) HTTPTLSCertFile() *MockConfig_HTTPTLSCertFile_Call {
+	return &MockConfig_HTTPTLSCertFile_Call{Call: _e.mock.On("HTTPTLSCertFile")}
+}
+
+func (_c *MockConfig_HTTPTLSCertFile_Call) Run(run func()) *MockConfig_HTTPTLSCertFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_HTTPTLSCertFile_Call) Return(s string) *MockConfig_HTTPTLSCertFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_HTTPTLSCertFile_Call) RunAndReturn(run func() string) *MockConfig_HTTPTLSCertFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPTLSKeyFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) HTTPTLSKeyFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPTLSKeyFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_HTTPTLSKeyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPTLSKeyFile'
+type MockConfig_HTTPTLSKeyFile_Call struct {
+	*mock.Call
+}
+
+// HTTPTLSKeyFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) HTTPTLSKeyFile() *MockConfig_HTTPTLSKeyFile_Call {
+	return &MockConfig_HTTPTLSKeyFile_Call{Call: _e.mock.On("HTTPTLSKeyFile")}
+}
+
+func (_c *MockConfig_HTTPTLSKeyFile_Call) Run(run func()) *MockConfig_HTTPTLSKeyFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_HTTPTLSKeyFile_Call) Return(s string) *MockConfig_HTTPTLSKeyFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_HTTPTLSKeyFile_Call) RunAndReturn(run func() string) *MockConfig_HTTPTLSKeyFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HelpMode provides a mock function for the type MockConfig
 func (_mock *MockConfig) HelpMode() bool {
 	ret := _mock.Called()
@@ -499,6 +811,50 @@ func (_c *MockConfig_LogLevel_Call) RunAndReturn(run func() entities.LogLevel) *
 	return _c
 }
 
+// MATLABPoolSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABPoolSize() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABPoolSize")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_MATLABPoolSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABPoolSize'
+type MockConfig_MATLABPoolSize_Call struct {
+	*mock.Call
+}
+
+// MATLABPoolSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABPoolSize() *MockConfig_MATLABPoolSize_Call {
+	return &MockConfig_MATLABPoolSize_Call{Call: _e.mock.On("MATLABPoolSize")}
+}
+
+func (_c *MockConfig_MATLABPoolSize_Call) Run(run func()) *MockConfig_MATLABPoolSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABPoolSize_Call) Return(n int) *MockConfig_MATLABPoolSize_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_MATLABPoolSize_Call) RunAndReturn(run func() int) *MockConfig_MATLABPoolSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MATLABSessionConnectionDetails provides a mock function for the type MockConfig
 func (_mock *MockConfig) MATLABSessionConnectionDetails() string {
 	ret := _mock.Called()
@@ -631,6 +987,50 @@ func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) RunAndReturn(run func()
 	return _c
 }
 
+// MATLABSessionIdleTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionIdleTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionIdleTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionIdleTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionIdleTimeout'
+type MockConfig_MATLABSessionIdleTimeout_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionIdleTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionIdleTimeout() *MockConfig_MATLABSessionIdleTimeout_Call {
+	return &MockConfig_MATLABSessionIdleTimeout_Call{Call: _e.mock.On("MATLABSessionIdleTimeout")}
+}
+
+func (_c *MockConfig_MATLABSessionIdleTimeout_Call) Run(run func()) *MockConfig_MATLABSessionIdleTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionIdleTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABSessionIdleTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionIdleTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABSessionIdleTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MATLABSessionMode provides a mock function for the type MockConfig
 func (_mock *MockConfig) MATLABSessionMode() entities.MATLABSessionMode {
 	ret := _mock.Called()
@@ -675,6 +1075,94 @@ func (_c *MockConfig_MATLABSessionMode_Call) RunAndReturn(run func() entities.MA
 	return _c
 }
 
+// MATLABSessionSelector provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionSelector() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionSelector")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionSelector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionSelector'
+type MockConfig_MATLABSessionSelector_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionSelector is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionSelector() *MockConfig_MATLABSessionSelector_Call {
+	return &MockConfig_MATLABSessionSelector_Call{Call: _e.mock.On("MATLABSessionSelector")}
+}
+
+func (_c *MockConfig_MATLABSessionSelector_Call) Run(run func()) *MockConfig_MATLABSessionSelector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionSelector_Call) Return(s string) *MockConfig_MATLABSessionSelector_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionSelector_Call) RunAndReturn(run func() string) *MockConfig_MATLABSessionSelector_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxMATLABSessions provides a mock function for the type MockConfig
+func (_mock *MockConfig) MaxMATLABSessions() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxMATLABSessions")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_MaxMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxMATLABSessions'
+type MockConfig_MaxMATLABSessions_Call struct {
+	*mock.Call
+}
+
+// MaxMATLABSessions is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MaxMATLABSessions() *MockConfig_MaxMATLABSessions_Call {
+	return &MockConfig_MaxMATLABSessions_Call{Call: _e.mock.On("MaxMATLABSessions")}
+}
+
+func (_c *MockConfig_MaxMATLABSessions_Call) Run(run func()) *MockConfig_MaxMATLABSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MaxMATLABSessions_Call) Return(n int) *MockConfig_MaxMATLABSessions_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_MaxMATLABSessions_Call) RunAndReturn(run func() int) *MockConfig_MaxMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreferredLocalMATLABRoot provides a mock function for the type MockConfig
 func (_mock *MockConfig) PreferredLocalMATLABRoot() string {
 	ret := _mock.Called()
@@ -803,6 +1291,94 @@ func (_c *MockConfig_RecordToLogger_Call) RunAndReturn(run func(logger entities.
 	return _c
 }
 
+// RecoverSessionState provides a mock function for the type MockConfig
+func (_mock *MockConfig) RecoverSessionState() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverSessionState")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_RecoverSessionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverSessionState'
+type MockConfig_RecoverSessionState_Call struct {
+	*mock.Call
+}
+
+// RecoverSessionState is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RecoverSessionState() *MockConfig_RecoverSessionState_Call {
+	return &MockConfig_RecoverSessionState_Call{Call: _e.mock.On("RecoverSessionState")}
+}
+
+func (_c *MockConfig_RecoverSessionState_Call) Run(run func()) *MockConfig_RecoverSessionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RecoverSessionState_Call) Return(b bool) *MockConfig_RecoverSessionState_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_RecoverSessionState_Call) RunAndReturn(run func() bool) *MockConfig_RecoverSessionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoverSessionWorkspace provides a mock function for the type MockConfig
+func (_mock *MockConfig) RecoverSessionWorkspace() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverSessionWorkspace")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_RecoverSessionWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverSessionWorkspace'
+type MockConfig_RecoverSessionWorkspace_Call struct {
+	*mock.Call
+}
+
+// RecoverSessionWorkspace is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RecoverSessionWorkspace() *MockConfig_RecoverSessionWorkspace_Call {
+	return &MockConfig_RecoverSessionWorkspace_Call{Call: _e.mock.On("RecoverSessionWorkspace")}
+}
+
+func (_c *MockConfig_RecoverSessionWorkspace_Call) Run(run func()) *MockConfig_RecoverSessionWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RecoverSessionWorkspace_Call) Return(b bool) *MockConfig_RecoverSessionWorkspace_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_RecoverSessionWorkspace_Call) RunAndReturn(run func() bool) *MockConfig_RecoverSessionWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ServerInstanceID provides a mock function for the type MockConfig
 func (_mock *MockConfig) ServerInstanceID() string {
 	ret := _mock.Called()
@@ -1113,6 +1689,50 @@ func (_c *MockConfig_TelemetryCollectorEndpointInsecure_Call) RunAndReturn(run f
 	return _c
 }
 
+// Transport provides a mock function for the type MockConfig
+func (_mock *MockConfig) Transport() entities.Transport {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transport")
+	}
+
+	var r0 entities.Transport
+	if returnFunc, ok := ret.Get(0).(func() entities.Transport); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.Transport)
+	}
+	return r0
+}
+
+// MockConfig_Transport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transport'
+type MockConfig_Transport_Call struct {
+	*mock.Call
+}
+
+// Transport is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) Transport() *MockConfig_Transport_Call {
+	return &MockConfig_Transport_Call{Call: _e.mock.On("Transport")}
+}
+
+func (_c *MockConfig_Transport_Call) Run(run func()) *MockConfig_Transport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_Transport_Call) Return(transport entities.Transport) *MockConfig_Transport_Call {
+	_c.Call.Return(transport)
+	return _c
+}
+
+func (_c *MockConfig_Transport_Call) RunAndReturn(run func() entities.Transport) *MockConfig_Transport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UseSingleMATLABSession provides a mock function for the type MockConfig
 func (_mock *MockConfig) UseSingleMATLABSession() bool {
 	ret := _mock.Called()
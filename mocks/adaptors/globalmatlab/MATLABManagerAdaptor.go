@@ -39,6 +39,78 @@ func (_m *MockMATLABManagerAdaptor) EXPECT() *MockMATLABManagerAdaptor_Expecter
 	return &MockMATLABManagerAdaptor_Expecter{mock: &_m.Mock}
 }
 
+// AttachToSharedSession provides a mock function for the type MockMATLABManagerAdaptor
+func (_mock *MockMATLABManagerAdaptor) AttachToSharedSession(ctx context.Context, logger entities.Logger, processID int) (entities.SessionID, error) {
+	ret := _mock.Called(ctx, logger, processID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachToSharedSession")
+	}
+
+	var r0 entities.SessionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, int) (entities.SessionID, error)); ok {
+		return returnFunc(ctx, logger, processID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, int) entities.SessionID); ok {
+		r0 = returnFunc(ctx, logger, processID)
+	} else {
+		r0 = ret.Get(0).(entities.SessionID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, int) error); ok {
+		r1 = returnFunc(ctx, logger, processID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManagerAdaptor_AttachToSharedSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachToSharedSession'
+type MockMATLABManagerAdaptor_AttachToSharedSession_Call struct {
+	*mock.Call
+}
+
+// AttachToSharedSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - processID int
+func (_e *MockMATLABManagerAdaptor_Expecter) AttachToSharedSession(ctx interface{}, logger interface{}, processID interface{}) *MockMATLABManagerAdaptor_AttachToSharedSession_Call {
+	return &MockMATLABManagerAdaptor_AttachToSharedSession_Call{Call: _e.mock.On("AttachToSharedSession", ctx, logger, processID)}
+}
+
+func (_c *MockMATLABManagerAdaptor_AttachToSharedSession_Call) Run(run func(ctx context.Context, logger entities.Logger, processID int)) *MockMATLABManagerAdaptor_AttachToSharedSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_AttachToSharedSession_Call) Return(sessionID entities.SessionID, err error) *MockMATLABManagerAdaptor_AttachToSharedSession_Call {
+	_c.Call.Return(sessionID, err)
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_AttachToSharedSession_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, processID int) (entities.SessionID, error)) *MockMATLABManagerAdaptor_AttachToSharedSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMATLABSessionClient provides a mock function for the type MockMATLABManagerAdaptor
 func (_mock *MockMATLABManagerAdaptor) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
 	ret := _mock.Called(ctx, sessionLogger, sessionID)
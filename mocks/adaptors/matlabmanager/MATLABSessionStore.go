@@ -38,16 +38,16 @@ func (_m *MockMATLABSessionStore) EXPECT() *MockMATLABSessionStore_Expecter {
 }
 
 // Add provides a mock function for the type MockMATLABSessionStore
-func (_mock *MockMATLABSessionStore) Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID {
-	ret := _mock.Called(client)
+func (_mock *MockMATLABSessionStore) Add(client matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata) entities.SessionID {
+	ret := _mock.Called(client, metadata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
 	var r0 entities.SessionID
-	if returnFunc, ok := ret.Get(0).(func(matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID); ok {
-		r0 = returnFunc(client)
+	if returnFunc, ok := ret.Get(0).(func(matlabsessionstore.MATLABSessionClientWithCleanup, matlabsessionstore.SessionMetadata) entities.SessionID); ok {
+		r0 = returnFunc(client, metadata)
 	} else {
 		r0 = ret.Get(0).(entities.SessionID)
 	}
@@ -61,18 +61,24 @@ type MockMATLABSessionStore_Add_Call struct {
 
 // Add is a helper method to define mock.On call
 //   - client matlabsessionstore.MATLABSessionClientWithCleanup
-func (_e *MockMATLABSessionStore_Expecter) Add(client interface{}) *MockMATLABSessionStore_Add_Call {
-	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", client)}
+//   - metadata matlabsessionstore.SessionMetadata
+func (_e *MockMATLABSessionStore_Expecter) Add(client interface{}, metadata interface{}) *MockMATLABSessionStore_Add_Call {
+	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", client, metadata)}
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) Run(run func(client matlabsessionstore.MATLABSessionClientWithCleanup)) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) Run(run func(client matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata)) *MockMATLABSessionStore_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 matlabsessionstore.MATLABSessionClientWithCleanup
 		if args[0] != nil {
 			arg0 = args[0].(matlabsessionstore.MATLABSessionClientWithCleanup)
 		}
+		var arg1 matlabsessionstore.SessionMetadata
+		if args[1] != nil {
+			arg1 = args[1].(matlabsessionstore.SessionMetadata)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -83,7 +89,7 @@ func (_c *MockMATLABSessionStore_Add_Call) Return(sessionID entities.SessionID)
 	return _c
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(client matlabsessionstore.MATLABSessionClientWithCleanup, metadata matlabsessionstore.SessionMetadata) entities.SessionID) *MockMATLABSessionStore_Add_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -150,6 +156,112 @@ func (_c *MockMATLABSessionStore_Get_Call) RunAndReturn(run func(sessionID entit
 	return _c
 }
 
+// GetLogDirectory provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) GetLogDirectory(sessionID entities.SessionID) (string, error) {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogDirectory")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) (string, error)); ok {
+		return returnFunc(sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) string); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.SessionID) error); ok {
+		r1 = returnFunc(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABSessionStore_GetLogDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogDirectory'
+type MockMATLABSessionStore_GetLogDirectory_Call struct {
+	*mock.Call
+}
+
+// GetLogDirectory is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockMATLABSessionStore_Expecter) GetLogDirectory(sessionID interface{}) *MockMATLABSessionStore_GetLogDirectory_Call {
+	return &MockMATLABSessionStore_GetLogDirectory_Call{Call: _e.mock.On("GetLogDirectory", sessionID)}
+}
+
+func (_c *MockMATLABSessionStore_GetLogDirectory_Call) Run(run func(sessionID entities.SessionID)) *MockMATLABSessionStore_GetLogDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_GetLogDirectory_Call) Return(s string, err error) *MockMATLABSessionStore_GetLogDirectory_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_GetLogDirectory_Call) RunAndReturn(run func(sessionID entities.SessionID) (string, error)) *MockMATLABSessionStore_GetLogDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) List() []matlabsessionstore.Session {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []matlabsessionstore.Session
+	if returnFunc, ok := ret.Get(0).(func() []matlabsessionstore.Session); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]matlabsessionstore.Session)
+		}
+	}
+	return r0
+}
+
+// MockMATLABSessionStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockMATLABSessionStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+func (_e *MockMATLABSessionStore_Expecter) List() *MockMATLABSessionStore_List_Call {
+	return &MockMATLABSessionStore_List_Call{Call: _e.mock.On("List")}
+}
+
+func (_c *MockMATLABSessionStore_List_Call) Run(run func()) *MockMATLABSessionStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_List_Call) Return(sessions []matlabsessionstore.Session) *MockMATLABSessionStore_List_Call {
+	_c.Call.Return(sessions)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_List_Call) RunAndReturn(run func() []matlabsessionstore.Session) *MockMATLABSessionStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function for the type MockMATLABSessionStore
 func (_mock *MockMATLABSessionStore) Remove(sessionID entities.SessionID) {
 	_mock.Called(sessionID)
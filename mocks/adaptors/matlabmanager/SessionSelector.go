@@ -5,7 +5,10 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,9 +40,134 @@ func (_m *MockSessionSelector) EXPECT() *MockSessionSelector_Expecter {
 	return &MockSessionSelector_Expecter{mock: &_m.Mock}
 }
 
+// DiscoverSharedSessions provides a mock function for the type MockSessionSelector
+func (_mock *MockSessionSelector) DiscoverSharedSessions(ctx context.Context, logger entities.Logger) []sessiondiscovery.Session {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverSharedSessions")
+	}
+
+	var r0 []sessiondiscovery.Session
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) []sessiondiscovery.Session); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessiondiscovery.Session)
+		}
+	}
+	return r0
+}
+
+// MockSessionSelector_DiscoverSharedSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverSharedSessions'
+type MockSessionSelector_DiscoverSharedSessions_Call struct {
+	*mock.Call
+}
+
+// DiscoverSharedSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockSessionSelector_Expecter) DiscoverSharedSessions(ctx interface{}, logger interface{}) *MockSessionSelector_DiscoverSharedSessions_Call {
+	return &MockSessionSelector_DiscoverSharedSessions_Call{Call: _e.mock.On("DiscoverSharedSessions", ctx, logger)}
+}
+
+func (_c *MockSessionSelector_DiscoverSharedSessions_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockSessionSelector_DiscoverSharedSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionSelector_DiscoverSharedSessions_Call) Return(sessions []sessiondiscovery.Session) *MockSessionSelector_DiscoverSharedSessions_Call {
+	_c.Call.Return(sessions)
+	return _c
+}
+
+func (_c *MockSessionSelector_DiscoverSharedSessions_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) []sessiondiscovery.Session) *MockSessionSelector_DiscoverSharedSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSharedSession provides a mock function for the type MockSessionSelector
+func (_mock *MockSessionSelector) FindSharedSession(logger entities.Logger, processID int) (sessiondiscovery.Session, bool) {
+	ret := _mock.Called(logger, processID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSharedSession")
+	}
+
+	var r0 sessiondiscovery.Session
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, int) (sessiondiscovery.Session, bool)); ok {
+		return returnFunc(logger, processID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, int) sessiondiscovery.Session); ok {
+		r0 = returnFunc(logger, processID)
+	} else {
+		r0 = ret.Get(0).(sessiondiscovery.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, int) bool); ok {
+		r1 = returnFunc(logger, processID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockSessionSelector_FindSharedSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSharedSession'
+type MockSessionSelector_FindSharedSession_Call struct {
+	*mock.Call
+}
+
+// FindSharedSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - processID int
+func (_e *MockSessionSelector_Expecter) FindSharedSession(logger interface{}, processID interface{}) *MockSessionSelector_FindSharedSession_Call {
+	return &MockSessionSelector_FindSharedSession_Call{Call: _e.mock.On("FindSharedSession", logger, processID)}
+}
+
+func (_c *MockSessionSelector_FindSharedSession_Call) Run(run func(logger entities.Logger, processID int)) *MockSessionSelector_FindSharedSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionSelector_FindSharedSession_Call) Return(session sessiondiscovery.Session, b bool) *MockSessionSelector_FindSharedSession_Call {
+	_c.Call.Return(session, b)
+	return _c
+}
+
+func (_c *MockSessionSelector_FindSharedSession_Call) RunAndReturn(run func(logger entities.Logger, processID int) (sessiondiscovery.Session, bool)) *MockSessionSelector_FindSharedSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectSessionToAttachTo provides a mock function for the type MockSessionSelector
-func (_mock *MockSessionSelector) SelectSessionToAttachTo(logger entities.Logger) (embeddedconnector.ConnectionDetails, error) {
-	ret := _mock.Called(logger)
+func (_mock *MockSessionSelector) SelectSessionToAttachTo(ctx context.Context, logger entities.Logger, request entities.AttachToExistingSession) (embeddedconnector.ConnectionDetails, error) {
+	ret := _mock.Called(ctx, logger, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SelectSessionToAttachTo")
@@ -47,16 +175,16 @@ func (_mock *MockSessionSelector) SelectSessionToAttachTo(logger entities.Logger
 
 	var r0 embeddedconnector.ConnectionDetails
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger) (embeddedconnector.ConnectionDetails, error)); ok {
-		return returnFunc(logger)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.AttachToExistingSession) (embeddedconnector.ConnectionDetails, error)); ok {
+		return returnFunc(ctx, logger, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger) embeddedconnector.ConnectionDetails); ok {
-		r0 = returnFunc(logger)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.AttachToExistingSession) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(ctx, logger, request)
 	} else {
 		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger) error); ok {
-		r1 = returnFunc(logger)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.AttachToExistingSession) error); ok {
+		r1 = returnFunc(ctx, logger, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,19 +197,31 @@ type MockSessionSelector_SelectSessionToAttachTo_Call struct {
 }
 
 // SelectSessionToAttachTo is a helper method to define mock.On call
+//   - ctx context.Context
 //   - logger entities.Logger
-func (_e *MockSessionSelector_Expecter) SelectSessionToAttachTo(logger interface{}) *MockSessionSelector_SelectSessionToAttachTo_Call {
-	return &MockSessionSelector_SelectSessionToAttachTo_Call{Call: _e.mock.On("SelectSessionToAttachTo", logger)}
+//   - request entities.AttachToExistingSession
+func (_e *MockSessionSelector_Expecter) SelectSessionToAttachTo(ctx interface{}, logger interface{}, request interface{}) *MockSessionSelector_SelectSessionToAttachTo_Call {
+	return &MockSessionSelector_SelectSessionToAttachTo_Call{Call: _e.mock.On("SelectSessionToAttachTo", ctx, logger, request)}
 }
 
-func (_c *MockSessionSelector_SelectSessionToAttachTo_Call) Run(run func(logger entities.Logger)) *MockSessionSelector_SelectSessionToAttachTo_Call {
+func (_c *MockSessionSelector_SelectSessionToAttachTo_Call) Run(run func(ctx context.Context, logger entities.Logger, request entities.AttachToExistingSession)) *MockSessionSelector_SelectSessionToAttachTo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 entities.Logger
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(entities.Logger)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.AttachToExistingSession
+		if args[2] != nil {
+			arg2 = args[2].(entities.AttachToExistingSession)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -92,7 +232,7 @@ func (_c *MockSessionSelector_SelectSessionToAttachTo_Call) Return(connectionDet
 	return _c
 }
 
-func (_c *MockSessionSelector_SelectSessionToAttachTo_Call) RunAndReturn(run func(logger entities.Logger) (embeddedconnector.ConnectionDetails, error)) *MockSessionSelector_SelectSessionToAttachTo_Call {
+func (_c *MockSessionSelector_SelectSessionToAttachTo_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, request entities.AttachToExistingSession) (embeddedconnector.ConnectionDetails, error)) *MockSessionSelector_SelectSessionToAttachTo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,7 +39,7 @@ func (_m *MockMATLABProcessLauncher) EXPECT() *MockMATLABProcessLauncher_Expecte
 }
 
 // Launch provides a mock function for the type MockMATLABProcessLauncher
-func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error) {
+func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan int, error) {
 	ret := _mock.Called(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
 
 	if len(ret) == 0 {
@@ -48,9 +48,9 @@ func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entit
 
 	var r0 int
 	var r1 func()
-	var r2 <-chan struct{}
+	var r2 <-chan int
 	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string) (int, func(), <-chan struct{}, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string) (int, func(), <-chan int, error)); ok {
 		return returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string) int); ok {
@@ -65,11 +65,11 @@ func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entit
 			r1 = ret.Get(1).(func())
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, string, string, string, []string, []string) <-chan struct{}); ok {
+	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, string, string, string, []string, []string) <-chan int); ok {
 		r2 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
 	} else {
 		if ret.Get(2) != nil {
-			r2 = ret.Get(2).(<-chan struct{})
+			r2 = ret.Get(2).(<-chan int)
 		}
 	}
 	if returnFunc, ok := ret.Get(3).(func(context.Context, entities.Logger, string, string, string, []string, []string) error); ok {
@@ -140,12 +140,12 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(), valCh <-chan struct{}, err error) *MockMATLABProcessLauncher_Launch_Call {
-	_c.Call.Return(n, fn, valCh, err)
+func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(), intCh <-chan int, err error) *MockMATLABProcessLauncher_Launch_Call {
+	_c.Call.Return(n, fn, intCh, err)
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan int, error)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Return(run)
 	return _c
 }
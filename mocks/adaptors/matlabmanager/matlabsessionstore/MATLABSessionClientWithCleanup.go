@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -367,3 +368,47 @@ func (_c *MockMATLABSessionClientWithCleanup_StopSession_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// Usage provides a mock function for the type MockMATLABSessionClientWithCleanup
+func (_mock *MockMATLABSessionClientWithCleanup) Usage() matlabsessionstore.Usage {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 matlabsessionstore.Usage
+	if returnFunc, ok := ret.Get(0).(func() matlabsessionstore.Usage); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(matlabsessionstore.Usage)
+	}
+	return r0
+}
+
+// MockMATLABSessionClientWithCleanup_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type MockMATLABSessionClientWithCleanup_Usage_Call struct {
+	*mock.Call
+}
+
+// Usage is a helper method to define mock.On call
+func (_e *MockMATLABSessionClientWithCleanup_Expecter) Usage() *MockMATLABSessionClientWithCleanup_Usage_Call {
+	return &MockMATLABSessionClientWithCleanup_Usage_Call{Call: _e.mock.On("Usage")}
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Usage_Call) Run(run func()) *MockMATLABSessionClientWithCleanup_Usage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Usage_Call) Return(usage matlabsessionstore.Usage) *MockMATLABSessionClientWithCleanup_Usage_Call {
+	_c.Call.Return(usage)
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Usage_Call) RunAndReturn(run func() matlabsessionstore.Usage) *MockMATLABSessionClientWithCleanup_Usage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,19 +39,19 @@ func (_m *MockSessionDiscoverer) EXPECT() *MockSessionDiscoverer_Expecter {
 }
 
 // DiscoverSessions provides a mock function for the type MockSessionDiscoverer
-func (_mock *MockSessionDiscoverer) DiscoverSessions(logger entities.Logger) []embeddedconnector.ConnectionDetails {
+func (_mock *MockSessionDiscoverer) DiscoverSessions(logger entities.Logger) []sessiondiscovery.Session {
 	ret := _mock.Called(logger)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DiscoverSessions")
 	}
 
-	var r0 []embeddedconnector.ConnectionDetails
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger) []embeddedconnector.ConnectionDetails); ok {
+	var r0 []sessiondiscovery.Session
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) []sessiondiscovery.Session); ok {
 		r0 = returnFunc(logger)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]embeddedconnector.ConnectionDetails)
+			r0 = ret.Get(0).([]sessiondiscovery.Session)
 		}
 	}
 	return r0
@@ -80,12 +81,12 @@ func (_c *MockSessionDiscoverer_DiscoverSessions_Call) Run(run func(logger entit
 	return _c
 }
 
-func (_c *MockSessionDiscoverer_DiscoverSessions_Call) Return(connectionDetailss []embeddedconnector.ConnectionDetails) *MockSessionDiscoverer_DiscoverSessions_Call {
-	_c.Call.Return(connectionDetailss)
+func (_c *MockSessionDiscoverer_DiscoverSessions_Call) Return(sessions []sessiondiscovery.Session) *MockSessionDiscoverer_DiscoverSessions_Call {
+	_c.Call.Return(sessions)
 	return _c
 }
 
-func (_c *MockSessionDiscoverer_DiscoverSessions_Call) RunAndReturn(run func(logger entities.Logger) []embeddedconnector.ConnectionDetails) *MockSessionDiscoverer_DiscoverSessions_Call {
+func (_c *MockSessionDiscoverer_DiscoverSessions_Call) RunAndReturn(run func(logger entities.Logger) []sessiondiscovery.Session) *MockSessionDiscoverer_DiscoverSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -155,3 +156,49 @@ func (_c *MockSessionDiscoverer_FromSessionDetails_Call) RunAndReturn(run func(l
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveSession provides a mock function for the type MockSessionDiscoverer
+func (_mock *MockSessionDiscoverer) RemoveSession(logger entities.Logger, session sessiondiscovery.Session) {
+	_mock.Called(logger, session)
+	return
+}
+
+// MockSessionDiscoverer_RemoveSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSession'
+type MockSessionDiscoverer_RemoveSession_Call struct {
+	*mock.Call
+}
+
+// RemoveSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - session sessiondiscovery.Session
+func (_e *MockSessionDiscoverer_Expecter) RemoveSession(logger interface{}, session interface{}) *MockSessionDiscoverer_RemoveSession_Call {
+	return &MockSessionDiscoverer_RemoveSession_Call{Call: _e.mock.On("RemoveSession", logger, session)}
+}
+
+func (_c *MockSessionDiscoverer_RemoveSession_Call) Run(run func(logger entities.Logger, session sessiondiscovery.Session)) *MockSessionDiscoverer_RemoveSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 sessiondiscovery.Session
+		if args[1] != nil {
+			arg1 = args[1].(sessiondiscovery.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_RemoveSession_Call) Return() *MockSessionDiscoverer_RemoveSession_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_RemoveSession_Call) RunAndReturn(run func(logger entities.Logger, session sessiondiscovery.Session)) *MockSessionDiscoverer_RemoveSession_Call {
+	_c.Run(run)
+	return _c
+}
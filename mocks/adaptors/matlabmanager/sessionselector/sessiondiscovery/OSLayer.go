@@ -35,6 +35,68 @@ func (_m *MockOSLayer) EXPECT() *MockOSLayer_Expecter {
 	return &MockOSLayer_Expecter{mock: &_m.Mock}
 }
 
+// Glob provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) Glob(pattern string) ([]string, error) {
+	ret := _mock.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Glob")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(pattern)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_Glob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Glob'
+type MockOSLayer_Glob_Call struct {
+	*mock.Call
+}
+
+// Glob is a helper method to define mock.On call
+//   - pattern string
+func (_e *MockOSLayer_Expecter) Glob(pattern interface{}) *MockOSLayer_Glob_Call {
+	return &MockOSLayer_Glob_Call{Call: _e.mock.On("Glob", pattern)}
+}
+
+func (_c *MockOSLayer_Glob_Call) Run(run func(pattern string)) *MockOSLayer_Glob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_Glob_Call) Return(strings []string, err error) *MockOSLayer_Glob_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockOSLayer_Glob_Call) RunAndReturn(run func(pattern string) ([]string, error)) *MockOSLayer_Glob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadFile provides a mock function for the type MockOSLayer
 func (_mock *MockOSLayer) ReadFile(filePath string) ([]byte, error) {
 	ret := _mock.Called(filePath)
@@ -96,3 +158,54 @@ func (_c *MockOSLayer_ReadFile_Call) RunAndReturn(run func(filePath string) ([]b
 	_c.Call.Return(run)
 	return _c
 }
+
+// Remove provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) Remove(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockOSLayer_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - name string
+func (_e *MockOSLayer_Expecter) Remove(name interface{}) *MockOSLayer_Remove_Call {
+	return &MockOSLayer_Remove_Call{Call: _e.mock.On("Remove", name)}
+}
+
+func (_c *MockOSLayer_Remove_Call) Run(run func(name string)) *MockOSLayer_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_Remove_Call) Return(err error) *MockOSLayer_Remove_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_Remove_Call) RunAndReturn(run func(name string) error) *MockOSLayer_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -37,6 +37,61 @@ func (_m *MockMCPServerConfigurator) EXPECT() *MockMCPServerConfigurator_Expecte
 	return &MockMCPServerConfigurator_Expecter{mock: &_m.Mock}
 }
 
+// GetCustomToolsToAdd provides a mock function for the type MockMCPServerConfigurator
+func (_mock *MockMCPServerConfigurator) GetCustomToolsToAdd() ([]tools.Tool, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomToolsToAdd")
+	}
+
+	var r0 []tools.Tool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]tools.Tool, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []tools.Tool); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tools.Tool)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMCPServerConfigurator_GetCustomToolsToAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomToolsToAdd'
+type MockMCPServerConfigurator_GetCustomToolsToAdd_Call struct {
+	*mock.Call
+}
+
+// GetCustomToolsToAdd is a helper method to define mock.On call
+func (_e *MockMCPServerConfigurator_Expecter) GetCustomToolsToAdd() *MockMCPServerConfigurator_GetCustomToolsToAdd_Call {
+	return &MockMCPServerConfigurator_GetCustomToolsToAdd_Call{Call: _e.mock.On("GetCustomToolsToAdd")}
+}
+
+func (_c *MockMCPServerConfigurator_GetCustomToolsToAdd_Call) Run(run func()) *MockMCPServerConfigurator_GetCustomToolsToAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetCustomToolsToAdd_Call) Return(tools1 []tools.Tool, err error) *MockMCPServerConfigurator_GetCustomToolsToAdd_Call {
+	_c.Call.Return(tools1, err)
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetCustomToolsToAdd_Call) RunAndReturn(run func() ([]tools.Tool, error)) *MockMCPServerConfigurator_GetCustomToolsToAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResourcesToAdd provides a mock function for the type MockMCPServerConfigurator
 func (_mock *MockMCPServerConfigurator) GetResourcesToAdd() []resources.Resource {
 	ret := _mock.Called()
@@ -37,6 +37,70 @@ func (_m *MockCustomToolFactory) EXPECT() *MockCustomToolFactory_Expecter {
 	return &MockCustomToolFactory_Expecter{mock: &_m.Mock}
 }
 
+// LoadMultiSessionTools provides a mock function for the type MockCustomToolFactory
+func (_mock *MockCustomToolFactory) LoadMultiSessionTools(filePath string) ([]tools.Tool, messages.Error) {
+	ret := _mock.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadMultiSessionTools")
+	}
+
+	var r0 []tools.Tool
+	var r1 messages.Error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]tools.Tool, messages.Error)); ok {
+		return returnFunc(filePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []tools.Tool); ok {
+		r0 = returnFunc(filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tools.Tool)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) messages.Error); ok {
+		r1 = returnFunc(filePath)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(messages.Error)
+		}
+	}
+	return r0, r1
+}
+
+// MockCustomToolFactory_LoadMultiSessionTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadMultiSessionTools'
+type MockCustomToolFactory_LoadMultiSessionTools_Call struct {
+	*mock.Call
+}
+
+// LoadMultiSessionTools is a helper method to define mock.On call
+//   - filePath string
+func (_e *MockCustomToolFactory_Expecter) LoadMultiSessionTools(filePath interface{}) *MockCustomToolFactory_LoadMultiSessionTools_Call {
+	return &MockCustomToolFactory_LoadMultiSessionTools_Call{Call: _e.mock.On("LoadMultiSessionTools", filePath)}
+}
+
+func (_c *MockCustomToolFactory_LoadMultiSessionTools_Call) Run(run func(filePath string)) *MockCustomToolFactory_LoadMultiSessionTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomToolFactory_LoadMultiSessionTools_Call) Return(tools1 []tools.Tool, error messages.Error) *MockCustomToolFactory_LoadMultiSessionTools_Call {
+	_c.Call.Return(tools1, error)
+	return _c
+}
+
+func (_c *MockCustomToolFactory_LoadMultiSessionTools_Call) RunAndReturn(run func(filePath string) ([]tools.Tool, messages.Error)) *MockCustomToolFactory_LoadMultiSessionTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadTools provides a mock function for the type MockCustomToolFactory
 func (_mock *MockCustomToolFactory) LoadTools(filePath string) ([]tools.Tool, messages.Error) {
 	ret := _mock.Called(filePath)
@@ -116,3 +116,87 @@ func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, s
 	_c.Call.Return(run)
 	return _c
 }
+
+// ExecuteWithStructuredOutput provides a mock function for the type MockUsecase
+func (_mock *MockUsecase) ExecuteWithStructuredOutput(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request evalcustomtool.Args, outputNames []string) (evalcustomtool.StructuredResponse, error) {
+	ret := _mock.Called(ctx, sessionLogger, client, request, outputNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteWithStructuredOutput")
+	}
+
+	var r0 evalcustomtool.StructuredResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, evalcustomtool.Args, []string) (evalcustomtool.StructuredResponse, error)); ok {
+		return returnFunc(ctx, sessionLogger, client, request, outputNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, evalcustomtool.Args, []string) evalcustomtool.StructuredResponse); ok {
+		r0 = returnFunc(ctx, sessionLogger, client, request, outputNames)
+	} else {
+		r0 = ret.Get(0).(evalcustomtool.StructuredResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, evalcustomtool.Args, []string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, client, request, outputNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsecase_ExecuteWithStructuredOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteWithStructuredOutput'
+type MockUsecase_ExecuteWithStructuredOutput_Call struct {
+	*mock.Call
+}
+
+// ExecuteWithStructuredOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - request evalcustomtool.Args
+//   - outputNames []string
+func (_e *MockUsecase_Expecter) ExecuteWithStructuredOutput(ctx interface{}, sessionLogger interface{}, client interface{}, request interface{}, outputNames interface{}) *MockUsecase_ExecuteWithStructuredOutput_Call {
+	return &MockUsecase_ExecuteWithStructuredOutput_Call{Call: _e.mock.On("ExecuteWithStructuredOutput", ctx, sessionLogger, client, request, outputNames)}
+}
+
+func (_c *MockUsecase_ExecuteWithStructuredOutput_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request evalcustomtool.Args, outputNames []string)) *MockUsecase_ExecuteWithStructuredOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 evalcustomtool.Args
+		if args[3] != nil {
+			arg3 = args[3].(evalcustomtool.Args)
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsecase_ExecuteWithStructuredOutput_Call) Return(structuredResponse evalcustomtool.StructuredResponse, err error) *MockUsecase_ExecuteWithStructuredOutput_Call {
+	_c.Call.Return(structuredResponse, err)
+	return _c
+}
+
+func (_c *MockUsecase_ExecuteWithStructuredOutput_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request evalcustomtool.Args, outputNames []string) (evalcustomtool.StructuredResponse, error)) *MockUsecase_ExecuteWithStructuredOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
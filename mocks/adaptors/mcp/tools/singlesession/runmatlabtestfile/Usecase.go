@@ -40,22 +40,22 @@ func (_m *MockUsecase) EXPECT() *MockUsecase_Expecter {
 }
 
 // Execute provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (entities.EvalResponse, error) {
+func (_mock *MockUsecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (runmatlabtestfile.ReturnArgs, error) {
 	ret := _mock.Called(ctx, sessionLogger, client, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 entities.EvalResponse
+	var r0 runmatlabtestfile.ReturnArgs
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, runmatlabtestfile.Args) (entities.EvalResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, runmatlabtestfile.Args) (runmatlabtestfile.ReturnArgs, error)); ok {
 		return returnFunc(ctx, sessionLogger, client, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, runmatlabtestfile.Args) entities.EvalResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, runmatlabtestfile.Args) runmatlabtestfile.ReturnArgs); ok {
 		r0 = returnFunc(ctx, sessionLogger, client, request)
 	} else {
-		r0 = ret.Get(0).(entities.EvalResponse)
+		r0 = ret.Get(0).(runmatlabtestfile.ReturnArgs)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, runmatlabtestfile.Args) error); ok {
 		r1 = returnFunc(ctx, sessionLogger, client, request)
@@ -107,12 +107,12 @@ func (_c *MockUsecase_Execute_Call) Run(run func(ctx context.Context, sessionLog
 	return _c
 }
 
-func (_c *MockUsecase_Execute_Call) Return(evalResponse entities.EvalResponse, err error) *MockUsecase_Execute_Call {
-	_c.Call.Return(evalResponse, err)
+func (_c *MockUsecase_Execute_Call) Return(returnArgs runmatlabtestfile.ReturnArgs, err error) *MockUsecase_Execute_Call {
+	_c.Call.Return(returnArgs, err)
 	return _c
 }
 
-func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (entities.EvalResponse, error)) *MockUsecase_Execute_Call {
+func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtestfile.Args) (runmatlabtestfile.ReturnArgs, error)) *MockUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
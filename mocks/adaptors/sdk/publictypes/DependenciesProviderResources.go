@@ -128,6 +128,52 @@ func (_c *MockDependenciesProviderResources_Logger_Call) RunAndReturn(run func()
 	return _c
 }
 
+// MATLAB provides a mock function for the type MockDependenciesProviderResources
+func (_mock *MockDependenciesProviderResources) MATLAB() publictypes.MATLAB {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLAB")
+	}
+
+	var r0 publictypes.MATLAB
+	if returnFunc, ok := ret.Get(0).(func() publictypes.MATLAB); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.MATLAB)
+		}
+	}
+	return r0
+}
+
+// MockDependenciesProviderResources_MATLAB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLAB'
+type MockDependenciesProviderResources_MATLAB_Call struct {
+	*mock.Call
+}
+
+// MATLAB is a helper method to define mock.On call
+func (_e *MockDependenciesProviderResources_Expecter) MATLAB() *MockDependenciesProviderResources_MATLAB_Call {
+	return &MockDependenciesProviderResources_MATLAB_Call{Call: _e.mock.On("MATLAB")}
+}
+
+func (_c *MockDependenciesProviderResources_MATLAB_Call) Run(run func()) *MockDependenciesProviderResources_MATLAB_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDependenciesProviderResources_MATLAB_Call) Return(mATLAB publictypes.MATLAB) *MockDependenciesProviderResources_MATLAB_Call {
+	_c.Call.Return(mATLAB)
+	return _c
+}
+
+func (_c *MockDependenciesProviderResources_MATLAB_Call) RunAndReturn(run func() publictypes.MATLAB) *MockDependenciesProviderResources_MATLAB_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Watchdog provides a mock function for the type MockDependenciesProviderResources
 func (_mock *MockDependenciesProviderResources) Watchdog() publictypes.Watchdog {
 	ret := _mock.Called()
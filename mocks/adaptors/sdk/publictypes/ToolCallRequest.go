@@ -127,3 +127,49 @@ func (_c *MockToolCallRequest_Logger_Call) RunAndReturn(run func() publictypes.L
 	_c.Call.Return(run)
 	return _c
 }
+
+// MATLAB provides a mock function for the type MockToolCallRequest
+func (_mock *MockToolCallRequest) MATLAB() publictypes.MATLAB {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLAB")
+	}
+
+	var r0 publictypes.MATLAB
+	if returnFunc, ok := ret.Get(0).(func() publictypes.MATLAB); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.MATLAB)
+		}
+	}
+	return r0
+}
+
+// MockToolCallRequest_MATLAB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLAB'
+type MockToolCallRequest_MATLAB_Call struct {
+	*mock.Call
+}
+
+// MATLAB is a helper method to define mock.On call
+func (_e *MockToolCallRequest_Expecter) MATLAB() *MockToolCallRequest_MATLAB_Call {
+	return &MockToolCallRequest_MATLAB_Call{Call: _e.mock.On("MATLAB")}
+}
+
+func (_c *MockToolCallRequest_MATLAB_Call) Run(run func()) *MockToolCallRequest_MATLAB_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolCallRequest_MATLAB_Call) Return(mATLAB publictypes.MATLAB) *MockToolCallRequest_MATLAB_Call {
+	_c.Call.Return(mATLAB)
+	return _c
+}
+
+func (_c *MockToolCallRequest_MATLAB_Call) RunAndReturn(run func() publictypes.MATLAB) *MockToolCallRequest_MATLAB_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -40,16 +40,16 @@ func (_m *MockToolCallRequestFactory) EXPECT() *MockToolCallRequestFactory_Expec
 }
 
 // New provides a mock function for the type MockToolCallRequestFactory
-func (_mock *MockToolCallRequestFactory) New(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog) publictypes.ToolCallRequest {
-	ret := _mock.Called(internalLogger, internalConfig, internalMessageCatalog)
+func (_mock *MockToolCallRequestFactory) New(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog, internalGlobalMATLAB definition.GlobalMATLAB) publictypes.ToolCallRequest {
+	ret := _mock.Called(internalLogger, internalConfig, internalMessageCatalog, internalGlobalMATLAB)
 
 	if len(ret) == 0 {
 		panic("no return value specified for New")
 	}
 
 	var r0 publictypes.ToolCallRequest
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, config.GenericConfig, definition.MessageCatalog) publictypes.ToolCallRequest); ok {
-		r0 = returnFunc(internalLogger, internalConfig, internalMessageCatalog)
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, config.GenericConfig, definition.MessageCatalog, definition.GlobalMATLAB) publictypes.ToolCallRequest); ok {
+		r0 = returnFunc(internalLogger, internalConfig, internalMessageCatalog, internalGlobalMATLAB)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(publictypes.ToolCallRequest)
@@ -67,11 +67,12 @@ type MockToolCallRequestFactory_New_Call struct {
 //   - internalLogger entities.Logger
 //   - internalConfig config.GenericConfig
 //   - internalMessageCatalog definition.MessageCatalog
-func (_e *MockToolCallRequestFactory_Expecter) New(internalLogger interface{}, internalConfig interface{}, internalMessageCatalog interface{}) *MockToolCallRequestFactory_New_Call {
-	return &MockToolCallRequestFactory_New_Call{Call: _e.mock.On("New", internalLogger, internalConfig, internalMessageCatalog)}
+//   - internalGlobalMATLAB definition.GlobalMATLAB
+func (_e *MockToolCallRequestFactory_Expecter) New(internalLogger interface{}, internalConfig interface{}, internalMessageCatalog interface{}, internalGlobalMATLAB interface{}) *MockToolCallRequestFactory_New_Call {
+	return &MockToolCallRequestFactory_New_Call{Call: _e.mock.On("New", internalLogger, internalConfig, internalMessageCatalog, internalGlobalMATLAB)}
 }
 
-func (_c *MockToolCallRequestFactory_New_Call) Run(run func(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog)) *MockToolCallRequestFactory_New_Call {
+func (_c *MockToolCallRequestFactory_New_Call) Run(run func(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog, internalGlobalMATLAB definition.GlobalMATLAB)) *MockToolCallRequestFactory_New_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 entities.Logger
 		if args[0] != nil {
@@ -85,10 +86,15 @@ func (_c *MockToolCallRequestFactory_New_Call) Run(run func(internalLogger entit
 		if args[2] != nil {
 			arg2 = args[2].(definition.MessageCatalog)
 		}
+		var arg3 definition.GlobalMATLAB
+		if args[3] != nil {
+			arg3 = args[3].(definition.GlobalMATLAB)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -99,7 +105,7 @@ func (_c *MockToolCallRequestFactory_New_Call) Return(toolCallRequest publictype
 	return _c
 }
 
-func (_c *MockToolCallRequestFactory_New_Call) RunAndReturn(run func(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog) publictypes.ToolCallRequest) *MockToolCallRequestFactory_New_Call {
+func (_c *MockToolCallRequestFactory_New_Call) RunAndReturn(run func(internalLogger entities.Logger, internalConfig config.GenericConfig, internalMessageCatalog definition.MessageCatalog, internalGlobalMATLAB definition.GlobalMATLAB) publictypes.ToolCallRequest) *MockToolCallRequestFactory_New_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -40,27 +40,25 @@ func (_m *MockCodeAnalyzer) EXPECT() *MockCodeAnalyzer_Expecter {
 }
 
 // AnalyzeCode provides a mock function for the type MockCodeAnalyzer
-func (_mock *MockCodeAnalyzer) AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string) ([]checkmatlabcode.CodeIssue, error) {
-	ret := _mock.Called(ctx, logger, client, scriptPath)
+func (_mock *MockCodeAnalyzer) AnalyzeCode(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request checkmatlabcode.CodeAnalysisRequest) (checkmatlabcode.CodeAnalysis, error) {
+	ret := _mock.Called(ctx, logger, client, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AnalyzeCode")
 	}
 
-	var r0 []checkmatlabcode.CodeIssue
+	var r0 checkmatlabcode.CodeAnalysis
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) ([]checkmatlabcode.CodeIssue, error)); ok {
-		return returnFunc(ctx, logger, client, scriptPath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, checkmatlabcode.CodeAnalysisRequest) (checkmatlabcode.CodeAnalysis, error)); ok {
+		return returnFunc(ctx, logger, client, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) []checkmatlabcode.CodeIssue); ok {
-		r0 = returnFunc(ctx, logger, client, scriptPath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, checkmatlabcode.CodeAnalysisRequest) checkmatlabcode.CodeAnalysis); ok {
+		r0 = returnFunc(ctx, logger, client, request)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]checkmatlabcode.CodeIssue)
-		}
+		r0 = ret.Get(0).(checkmatlabcode.CodeAnalysis)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) error); ok {
-		r1 = returnFunc(ctx, logger, client, scriptPath)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, checkmatlabcode.CodeAnalysisRequest) error); ok {
+		r1 = returnFunc(ctx, logger, client, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -76,12 +74,12 @@ type MockCodeAnalyzer_AnalyzeCode_Call struct {
 //   - ctx context.Context
 //   - logger entities.Logger
 //   - client entities.MATLABSessionClient
-//   - scriptPath string
-func (_e *MockCodeAnalyzer_Expecter) AnalyzeCode(ctx interface{}, logger interface{}, client interface{}, scriptPath interface{}) *MockCodeAnalyzer_AnalyzeCode_Call {
-	return &MockCodeAnalyzer_AnalyzeCode_Call{Call: _e.mock.On("AnalyzeCode", ctx, logger, client, scriptPath)}
+//   - request checkmatlabcode.CodeAnalysisRequest
+func (_e *MockCodeAnalyzer_Expecter) AnalyzeCode(ctx interface{}, logger interface{}, client interface{}, request interface{}) *MockCodeAnalyzer_AnalyzeCode_Call {
+	return &MockCodeAnalyzer_AnalyzeCode_Call{Call: _e.mock.On("AnalyzeCode", ctx, logger, client, request)}
 }
 
-func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string)) *MockCodeAnalyzer_AnalyzeCode_Call {
+func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request checkmatlabcode.CodeAnalysisRequest)) *MockCodeAnalyzer_AnalyzeCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -95,9 +93,9 @@ func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Run(run func(ctx context.Context, l
 		if args[2] != nil {
 			arg2 = args[2].(entities.MATLABSessionClient)
 		}
-		var arg3 string
+		var arg3 checkmatlabcode.CodeAnalysisRequest
 		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg3 = args[3].(checkmatlabcode.CodeAnalysisRequest)
 		}
 		run(
 			arg0,
@@ -109,12 +107,12 @@ func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Run(run func(ctx context.Context, l
 	return _c
 }
 
-func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Return(codeIssues []checkmatlabcode.CodeIssue, err error) *MockCodeAnalyzer_AnalyzeCode_Call {
-	_c.Call.Return(codeIssues, err)
+func (_c *MockCodeAnalyzer_AnalyzeCode_Call) Return(codeAnalysis checkmatlabcode.CodeAnalysis, err error) *MockCodeAnalyzer_AnalyzeCode_Call {
+	_c.Call.Return(codeAnalysis, err)
 	return _c
 }
 
-func (_c *MockCodeAnalyzer_AnalyzeCode_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, scriptPath string) ([]checkmatlabcode.CodeIssue, error)) *MockCodeAnalyzer_AnalyzeCode_Call {
+func (_c *MockCodeAnalyzer_AnalyzeCode_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request checkmatlabcode.CodeAnalysisRequest) (checkmatlabcode.CodeAnalysis, error)) *MockCodeAnalyzer_AnalyzeCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
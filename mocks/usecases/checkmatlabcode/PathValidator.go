@@ -35,12 +35,12 @@ func (_m *MockPathValidator) EXPECT() *MockPathValidator_Expecter {
 	return &MockPathValidator_Expecter{mock: &_m.Mock}
 }
 
-// ValidateMATLABScript provides a mock function for the type MockPathValidator
-func (_mock *MockPathValidator) ValidateMATLABScript(filePath string) (string, error) {
+// ValidateFilePath provides a mock function for the type MockPathValidator
+func (_mock *MockPathValidator) ValidateFilePath(filePath string) (string, error) {
 	ret := _mock.Called(filePath)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ValidateMATLABScript")
+		panic("no return value specified for ValidateFilePath")
 	}
 
 	var r0 string
@@ -61,18 +61,18 @@ func (_mock *MockPathValidator) ValidateMATLABScript(filePath string) (string, e
 	return r0, r1
 }
 
-// MockPathValidator_ValidateMATLABScript_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateMATLABScript'
-type MockPathValidator_ValidateMATLABScript_Call struct {
+// MockPathValidator_ValidateFilePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateFilePath'
+type MockPathValidator_ValidateFilePath_Call struct {
 	*mock.Call
 }
 
-// ValidateMATLABScript is a helper method to define mock.On call
+// ValidateFilePath is a helper method to define mock.On call
 //   - filePath string
-func (_e *MockPathValidator_Expecter) ValidateMATLABScript(filePath interface{}) *MockPathValidator_ValidateMATLABScript_Call {
-	return &MockPathValidator_ValidateMATLABScript_Call{Call: _e.mock.On("ValidateMATLABScript", filePath)}
+func (_e *MockPathValidator_Expecter) ValidateFilePath(filePath interface{}) *MockPathValidator_ValidateFilePath_Call {
+	return &MockPathValidator_ValidateFilePath_Call{Call: _e.mock.On("ValidateFilePath", filePath)}
 }
 
-func (_c *MockPathValidator_ValidateMATLABScript_Call) Run(run func(filePath string)) *MockPathValidator_ValidateMATLABScript_Call {
+func (_c *MockPathValidator_ValidateFilePath_Call) Run(run func(filePath string)) *MockPathValidator_ValidateFilePath_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -85,12 +85,72 @@ func (_c *MockPathValidator_ValidateMATLABScript_Call) Run(run func(filePath str
 	return _c
 }
 
-func (_c *MockPathValidator_ValidateMATLABScript_Call) Return(s string, err error) *MockPathValidator_ValidateMATLABScript_Call {
+func (_c *MockPathValidator_ValidateFilePath_Call) Return(s string, err error) *MockPathValidator_ValidateFilePath_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockPathValidator_ValidateMATLABScript_Call) RunAndReturn(run func(filePath string) (string, error)) *MockPathValidator_ValidateMATLABScript_Call {
+func (_c *MockPathValidator_ValidateFilePath_Call) RunAndReturn(run func(filePath string) (string, error)) *MockPathValidator_ValidateFilePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateMATLABCodePath provides a mock function for the type MockPathValidator
+func (_mock *MockPathValidator) ValidateMATLABCodePath(filePath string) (string, error) {
+	ret := _mock.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateMATLABCodePath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(filePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(filePath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPathValidator_ValidateMATLABCodePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateMATLABCodePath'
+type MockPathValidator_ValidateMATLABCodePath_Call struct {
+	*mock.Call
+}
+
+// ValidateMATLABCodePath is a helper method to define mock.On call
+//   - filePath string
+func (_e *MockPathValidator_Expecter) ValidateMATLABCodePath(filePath interface{}) *MockPathValidator_ValidateMATLABCodePath_Call {
+	return &MockPathValidator_ValidateMATLABCodePath_Call{Call: _e.mock.On("ValidateMATLABCodePath", filePath)}
+}
+
+func (_c *MockPathValidator_ValidateMATLABCodePath_Call) Run(run func(filePath string)) *MockPathValidator_ValidateMATLABCodePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPathValidator_ValidateMATLABCodePath_Call) Return(s string, err error) *MockPathValidator_ValidateMATLABCodePath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockPathValidator_ValidateMATLABCodePath_Call) RunAndReturn(run func(filePath string) (string, error)) *MockPathValidator_ValidateMATLABCodePath_Call {
 	_c.Call.Return(run)
 	return _c
 }
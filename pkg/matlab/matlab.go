@@ -0,0 +1,15 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlab
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/sdk/publictypes"
+
+type MATLAB = publictypes.MATLAB
+
+type EvalRequest = publictypes.EvalRequest
+
+type EvalResponse = publictypes.EvalResponse
+
+type FEvalRequest = publictypes.FEvalRequest
+
+type FEvalResponse = publictypes.FEvalResponse
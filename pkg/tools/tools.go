@@ -22,6 +22,21 @@ type CallRequest = publictypes.ToolCallRequest
 
 type RichContent = publictypes.RichContent
 
+type ImageContent = publictypes.ImageContent
+
+type ImageMIMEType = publictypes.ImageMIMEType
+
+const (
+	ImageMIMETypePNG  = publictypes.ImageMIMETypePNG
+	ImageMIMETypeJPEG = publictypes.ImageMIMETypeJPEG
+)
+
+type AudioContent = publictypes.AudioContent
+
+type EmbeddedResource = publictypes.EmbeddedResource
+
+type ResourceLink = publictypes.ResourceLink
+
 type HandlerForToolWithUnstructuredContentOutput[ToolInput any] func(ctx context.Context, request CallRequest, inputs ToolInput) (RichContent, i18n.Error)
 
 func NewToolWithUnstructuredContentOutput[ToolInput any](definition Definition, handler HandlerForToolWithUnstructuredContentOutput[ToolInput]) Tool {
@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/tests/testutils/mockmatlab"
@@ -24,6 +25,9 @@ var (
 
 	//go:embed testdata/customtools/malformed.json
 	malformedJSON string
+
+	//go:embed testdata/customtools/structured_output_tool.json
+	structuredOutputToolJSON string
 )
 
 // CustomToolsTestSuite tests that using an extensions file with custom MATLAB tools works as expected.
@@ -116,6 +120,47 @@ func (s *CustomToolsTestSuite) TestErrorPath_ToolNameConflict_ServerFails() {
 	s.Require().Error(err, "session creation should fail when custom tool name conflicts with built-in tool")
 }
 
+func (s *CustomToolsTestSuite) TestHappyPath_ExtensionDirWithPrefixedExtensionFile() {
+	extensionDir := s.T().TempDir()
+	writeExtensionFileToDir(s.T(), extensionDir, "math.json", singleToolJSON)
+	writeExtensionFileToDir(s.T(), extensionDir, "prefixed.json", strings.Replace(singleToolJSON, "{", `{"prefix": "prefixed",`, 1))
+
+	session, err := s.CreateSession(mockmatlab.HappyConfig(), "--extension-dir="+extensionDir)
+	s.Require().NoError(err)
+	defer s.CleanupSession(session, true)
+
+	ctx := s.T().Context()
+	result, err := session.ListTools(ctx, nil)
+	s.Require().NoError(err, "should list tools")
+
+	toolNames := make(map[string]bool)
+	for _, tool := range result.Tools {
+		toolNames[tool.Name] = true
+	}
+	s.True(toolNames["generate_magic_square"], "generate_magic_square should appear in tools list")
+	s.True(toolNames["prefixed_generate_magic_square"], "prefixed_generate_magic_square should appear in tools list")
+
+	callResult, err := session.CallTool(ctx, "prefixed_generate_magic_square", map[string]any{"n": float64(5)})
+	s.Require().NoError(err, "should call prefixed custom tool")
+
+	text, err := session.GetTextContent(callResult)
+	s.Require().NoError(err, "should get text content")
+	s.Contains(text, "magic(5)", "response should contain the assembled MATLAB function call")
+}
+
+func (s *CustomToolsTestSuite) TestErrorPath_DuplicateToolNameAcrossExtensionFiles_ServerFails() {
+	extensionFile := writeExtensionFile(s.T(), singleToolJSON)
+	otherExtensionFile := writeExtensionFile(s.T(), singleToolJSON)
+
+	_, err := s.CreateSession(mockmatlab.HappyConfig(), "--extension-file="+extensionFile, "--extension-file="+otherExtensionFile)
+	s.Require().Error(err, "session creation should fail when two extension files define the same tool name")
+}
+
+func (s *CustomToolsTestSuite) TestErrorPath_MissingExtensionDir_ServerFails() {
+	_, err := s.CreateSession(mockmatlab.HappyConfig(), "--extension-dir=/nonexistent/path/tools")
+	s.Require().Error(err, "session creation should fail when extension folder does not exist")
+}
+
 func writeExtensionFile(t *testing.T, content string) string {
 	t.Helper()
 	dir := t.TempDir()
@@ -125,3 +170,10 @@ func writeExtensionFile(t *testing.T, content string) string {
 	}
 	return path
 }
+
+func writeExtensionFileToDir(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write extension file: %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -81,15 +82,20 @@ func (s *ExistingExplicitSessionTestSuite) TestMCPCapabilities_MatchLocalMode()
 	s.Require().NoError(err)
 	s.Require().NotNil(localToolsResult)
 
+	// Existing mode adds tools to list and attach to shared MATLAB sessions.
+	existingOnlyToolNames := []string{"list_shared_matlab_sessions", "attach_to_shared_matlab_session"}
 	existingToolNames := make([]string, 0, len(existingToolsResult.Tools))
 	for _, tool := range existingToolsResult.Tools {
+		if slices.Contains(existingOnlyToolNames, tool.Name) {
+			continue
+		}
 		existingToolNames = append(existingToolNames, tool.Name)
 	}
 	localToolNames := make([]string, 0, len(localToolsResult.Tools))
 	for _, tool := range localToolsResult.Tools {
 		localToolNames = append(localToolNames, tool.Name)
 	}
-	s.ElementsMatch(localToolNames, existingToolNames, "existing mode should expose the same tools as local mode")
+	s.ElementsMatch(localToolNames, existingToolNames, "existing mode should expose the same tools as local mode, besides its shared session tools")
 
 	existingResourcesResult, err := session.ListResources(ctx, nil)
 	s.Require().NoError(err)
@@ -119,6 +125,30 @@ func (s *ExistingExplicitSessionTestSuite) TestMCPCapabilities_MatchLocalMode()
 		"existing mode should report the same server version as local mode")
 }
 
+func (s *ExistingExplicitSessionTestSuite) TestCustomToolWithOutput_DoesNotRequireServerMATLABFiles() {
+	ctx := s.T().Context()
+
+	mockSession := s.startMockMATLAB()
+	extensionFile := writeExtensionFile(s.T(), structuredOutputToolJSON)
+	detailsJSON, err := mockSession.ToSessionDetailsJSON()
+	s.Require().NoError(err, "should serialize session details")
+	session := s.createSession("--matlab-session-connection-details="+detailsJSON, "--extension-file="+extensionFile)
+	defer s.CleanupSession(session, true)
+
+	// Mock MATLAB echoes the code instead of printing the outputs, so the tool reports that it cannot read them.
+	_, err = session.CallTool(ctx, "sum_of_magic_square", map[string]any{"n": float64(3)})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "magicSum(3)", "the error result should include the console output")
+
+	s.assertMockReceivedEval(mockSession, "[mcpCustomToolOutputs{1:1}] = magicSum(3);")
+
+	evals, err := mockSession.ReceivedEvals()
+	s.Require().NoError(err, "should read mock MATLAB request log")
+	for _, eval := range evals {
+		s.NotContains(eval.Code, "matlab_mcp.", "existing sessions do not have the MATLAB files of the server on their path")
+	}
+}
+
 func (s *ExistingExplicitSessionTestSuite) TestSessionDies_ReturnsErrorWithoutDiscoveryFallback() {
 	ctx := s.T().Context()
 
@@ -39,7 +39,7 @@ func (s *ServerWithMATLABFeatureTestSuite) TestSDK_MATLABFeature_HappyPath() {
 
 	// Assert
 	s.Require().NotNil(listToolsResponse)
-	s.Len(listToolsResponse.Tools, 5)
+	s.Len(listToolsResponse.Tools, 7)
 
 	s.Require().NotNil(listResourcesResponse)
 	s.Len(listResourcesResponse.Resources, 2)
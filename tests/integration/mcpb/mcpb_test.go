@@ -142,7 +142,7 @@ func assertManifestStaged(t *testing.T, stagingDir string) {
 
 	toolsRaw, ok := manifest["tools"].([]any)
 	require.True(t, ok)
-	assert.Len(t, toolsRaw, 5)
+	assert.Len(t, toolsRaw, 6)
 
 	for _, raw := range toolsRaw {
 		tool, ok := raw.(map[string]any)
@@ -2,6 +2,8 @@
 
 package testdata
 
+import "github.com/matlab/matlab-mcp-core-server/tests/testutils/mcpclient"
+
 // Expectation defines expected output patterns for a test file.
 type Expectation struct {
 	Contains    []string // Output should contain these strings
@@ -15,8 +17,9 @@ var TestScript = Expectation{
 }
 
 // TestMathFunctions expectations for test_math_functions.m
-var TestMathFunctions = Expectation{
-	Contains: []string{"7 Passed", "0 Failed"},
+var TestMathFunctions = mcpclient.TestSummary{
+	Total:  7,
+	Passed: 7,
 }
 
 // CheckCode expectations
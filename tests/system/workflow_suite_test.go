@@ -141,9 +141,9 @@ func (s *WorkflowTestSuite) TestInteractiveDevelopmentWorkflow() {
 			testdata.TestScript.Assert(s.T(), scriptOutput)
 
 			// Step 7: Test execution - run test suite (TDD workflow)
-			testOutput, err := session.RunTestFile(ctx, s.testMathFunctionsPath())
+			testSummary, err := session.RunTestFile(ctx, s.testMathFunctionsPath())
 			s.Require().NoError(err, "should execute test suite without error")
-			testdata.TestMathFunctions.Assert(s.T(), testOutput)
+			s.Equal(testdata.TestMathFunctions, testSummary, "test summary should match")
 		})
 	}
 }
@@ -257,13 +257,19 @@ func (s *MCPClientSession) CheckCode(ctx context.Context, scriptPath string) ([]
 		return nil, err
 	}
 	var output struct {
-		CodeIssues []CodeIssue `json:"code_issues"`
+		Files []struct {
+			CodeIssues []CodeIssue `json:"code_issues"`
+		} `json:"files"`
 	}
 	err = s.UnmarshalStructuredContent(result, &output)
 	if err != nil {
 		return nil, err
 	}
-	return output.CodeIssues, nil
+	issues := []CodeIssue{}
+	for _, file := range output.Files {
+		issues = append(issues, file.CodeIssues...)
+	}
+	return issues, nil
 }
 
 // RunFile runs a MATLAB file
@@ -277,15 +283,30 @@ func (s *MCPClientSession) RunFile(ctx context.Context, scriptPath string) (stri
 	return s.GetTextContent(result)
 }
 
+// TestSummary represents the totals returned by run_matlab_test_file.
+type TestSummary struct {
+	Total      int `json:"total"`
+	Passed     int `json:"passed"`
+	Failed     int `json:"failed"`
+	Incomplete int `json:"incomplete"`
+}
+
 // RunTestFile runs a MATLAB test file
-func (s *MCPClientSession) RunTestFile(ctx context.Context, scriptPath string) (string, error) {
+func (s *MCPClientSession) RunTestFile(ctx context.Context, scriptPath string) (TestSummary, error) {
 	result, err := s.CallTool(ctx, "run_matlab_test_file", map[string]any{
 		"script_path": scriptPath,
 	})
 	if err != nil {
-		return "", err
+		return TestSummary{}, err
 	}
-	return s.GetTextContent(result)
+	var output struct {
+		Summary TestSummary `json:"summary"`
+	}
+	err = s.UnmarshalStructuredContent(result, &output)
+	if err != nil {
+		return TestSummary{}, err
+	}
+	return output.Summary, nil
 }
 
 // DetectToolboxes detects installed MATLAB toolboxes
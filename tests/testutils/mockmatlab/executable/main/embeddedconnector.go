@@ -140,15 +140,29 @@ func closeListenerWithLog(listener net.Listener) {
 	}
 }
 
-func handleState(w http.ResponseWriter, _ *http.Request) {
-	response := embeddedconnector.ConnectorPayload{
-		Messages: embeddedconnector.ConnectorMessage{
-			PingResponse: []embeddedconnector.PingResponseMessage{
-				{
-					MessageFaults: nil,
-				},
+func handleState(w http.ResponseWriter, r *http.Request) {
+	var request embeddedconnector.ConnectorPayload
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	var response embeddedconnector.ConnectorPayload
+
+	if len(request.Messages.Ping) > 0 {
+		response.Messages.PingResponse = []embeddedconnector.PingResponseMessage{
+			{
+				MessageFaults: nil,
 			},
-		},
+		}
+	}
+
+	if len(request.Messages.Interrupt) > 0 {
+		response.Messages.InterruptResponse = []embeddedconnector.InterruptResponseMessage{
+			{
+				MessageFaults: nil,
+			},
+		}
 	}
 
 	w.Header().Set("Content-Type", "application/json")